@@ -0,0 +1,150 @@
+package accounts
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/common"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/helper/keystore"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// keyFileSeparator separates the creation time and the address in the key file names
+const keyFileSeparator = "--"
+
+// KeystoreBackend stores the account keys as encrypted key files in the directory,
+// named UTC--<creation time>--<address> as the other Ethereum clients do
+type KeystoreBackend struct {
+	dir     string
+	scryptN int
+}
+
+// NewKeystoreBackend creates the backend storing the keys in the given directory
+func NewKeystoreBackend(dir string) (*KeystoreBackend, error) {
+	if err := common.CreateDirSafe(dir, 0700); err != nil {
+		return nil, fmt.Errorf("unable to create keystore directory %s, %w", dir, err)
+	}
+
+	return &KeystoreBackend{
+		dir:     dir,
+		scryptN: keystore.StandardScryptN,
+	}, nil
+}
+
+// Accounts returns the addresses of the key files in the directory
+func (k *KeystoreBackend) Accounts() ([]types.Address, error) {
+	files, err := k.keyFiles()
+	if err != nil {
+		return nil, err
+	}
+
+	addrs := make([]types.Address, 0, len(files))
+	for addr := range files {
+		addrs = append(addrs, addr)
+	}
+
+	return addrs, nil
+}
+
+// NewAccount generates a new key and stores it encrypted by the password
+func (k *KeystoreBackend) NewAccount(password string) (types.Address, error) {
+	key, err := crypto.GenerateECDSAKey()
+	if err != nil {
+		return types.ZeroAddress, err
+	}
+
+	raw, err := crypto.MarshalECDSAPrivateKey(key)
+	if err != nil {
+		return types.ZeroAddress, err
+	}
+
+	encrypted, err := keystore.EncryptKey(raw, password, k.scryptN)
+	if err != nil {
+		return types.ZeroAddress, err
+	}
+
+	addr := crypto.PubKeyToAddress(&key.PublicKey)
+
+	if err := common.SaveFileSafe(filepath.Join(k.dir, keyFileName(addr, time.Now())), encrypted, 0600); err != nil {
+		return types.ZeroAddress, fmt.Errorf("unable to write key file, %w", err)
+	}
+
+	return addr, nil
+}
+
+// Key decrypts the key file of the account
+func (k *KeystoreBackend) Key(addr types.Address, password string) (*ecdsa.PrivateKey, error) {
+	files, err := k.keyFiles()
+	if err != nil {
+		return nil, err
+	}
+
+	path, ok := files[addr]
+	if !ok {
+		return nil, ErrUnknownAccount
+	}
+
+	encrypted, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read key file, %w", err)
+	}
+
+	raw, err := keystore.DecryptKey(encrypted, password)
+	if err != nil {
+		return nil, err
+	}
+
+	return crypto.ParseECDSAPrivateKey(raw)
+}
+
+// keyFiles maps the accounts to the paths of their key files
+func (k *KeystoreBackend) keyFiles() (map[types.Address]string, error) {
+	entries, err := os.ReadDir(k.dir)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read keystore directory, %w", err)
+	}
+
+	files := make(map[types.Address]string, len(entries))
+
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		addr, ok := keyFileAddress(entry.Name())
+		if !ok {
+			continue
+		}
+
+		files[addr] = filepath.Join(k.dir, entry.Name())
+	}
+
+	return files, nil
+}
+
+// keyFileName returns the name of the key file of the account
+func keyFileName(addr types.Address, created time.Time) string {
+	return "UTC" + keyFileSeparator + created.UTC().Format("2006-01-02T15-04-05.000000000Z") +
+		keyFileSeparator + hex.EncodeToString(addr.Bytes())
+}
+
+// keyFileAddress parses the account address from the key file name
+func keyFileAddress(name string) (types.Address, bool) {
+	idx := strings.LastIndex(name, keyFileSeparator)
+	if idx < 0 {
+		return types.ZeroAddress, false
+	}
+
+	raw, err := hex.DecodeHex(name[idx+len(keyFileSeparator):])
+	if err != nil || len(raw) != types.AddressLength {
+		return types.ZeroAddress, false
+	}
+
+	return types.BytesToAddress(raw), true
+}
@@ -0,0 +1,201 @@
+package accounts
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// DefaultUnlockDuration is the time the account stays unlocked if no duration is requested
+const DefaultUnlockDuration = 300 * time.Second
+
+var (
+	ErrUnknownAccount          = errors.New("unknown account")
+	ErrInvalidPassword         = errors.New("invalid account password")
+	ErrAccountLocked           = errors.New("account is locked")
+	ErrNewAccountNotSupported  = errors.New("account creation is not supported by the backend")
+	ErrInvalidUnlockDuration   = errors.New("unlock duration must be positive")
+	ErrUnlockDurationTooLong   = errors.New("unlock duration exceeds the allowed maximum")
+	errUnlockedKeyAddrMismatch = errors.New("decrypted key doesn't match the account address")
+)
+
+// Backend is the storage of the account keys
+type Backend interface {
+	// Accounts returns the addresses of the stored accounts
+	Accounts() ([]types.Address, error)
+
+	// NewAccount generates a new key, stores it protected by the password and returns its address
+	NewAccount(password string) (types.Address, error)
+
+	// Key returns the private key of the account, unlocked by the password
+	Key(addr types.Address, password string) (*ecdsa.PrivateKey, error)
+}
+
+// unlockedAccount holds the key of the unlocked account until its timer locks it again
+type unlockedAccount struct {
+	key   *ecdsa.PrivateKey
+	timer *time.Timer
+}
+
+// Manager manages the node-held accounts, keeping the unlocked keys in memory
+// for a limited time only
+type Manager struct {
+	logger  hclog.Logger
+	backend Backend
+
+	// maxUnlockDuration caps the requested unlock durations, no cap if 0
+	maxUnlockDuration time.Duration
+
+	unlocked map[types.Address]*unlockedAccount
+	lock     sync.Mutex
+}
+
+// NewManager creates the account manager on top of the backend
+func NewManager(logger hclog.Logger, backend Backend, maxUnlockDuration time.Duration) *Manager {
+	return &Manager{
+		logger:            logger.Named("accounts"),
+		backend:           backend,
+		maxUnlockDuration: maxUnlockDuration,
+		unlocked:          make(map[types.Address]*unlockedAccount),
+	}
+}
+
+// Accounts returns the addresses of the managed accounts
+func (m *Manager) Accounts() ([]types.Address, error) {
+	return m.backend.Accounts()
+}
+
+// NewAccount creates a new account protected by the password
+func (m *Manager) NewAccount(password string) (types.Address, error) {
+	addr, err := m.backend.NewAccount(password)
+	if err != nil {
+		return types.ZeroAddress, err
+	}
+
+	m.logger.Info("account created", "address", addr)
+
+	return addr, nil
+}
+
+// Unlock decrypts the key of the account and keeps it in memory for the given duration,
+// or for DefaultUnlockDuration if the duration is nil
+func (m *Manager) Unlock(addr types.Address, password string, duration *time.Duration) error {
+	timeout := DefaultUnlockDuration
+
+	if duration != nil {
+		timeout = *duration
+	}
+
+	if timeout <= 0 {
+		return ErrInvalidUnlockDuration
+	}
+
+	if m.maxUnlockDuration > 0 && timeout > m.maxUnlockDuration {
+		return ErrUnlockDurationTooLong
+	}
+
+	key, err := m.backend.Key(addr, password)
+	if err != nil {
+		return err
+	}
+
+	if crypto.PubKeyToAddress(&key.PublicKey) != addr {
+		return errUnlockedKeyAddrMismatch
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if prev, ok := m.unlocked[addr]; ok {
+		prev.timer.Stop()
+	}
+
+	account := &unlockedAccount{key: key}
+	account.timer = time.AfterFunc(timeout, func() {
+		m.lock.Lock()
+		defer m.lock.Unlock()
+
+		// the account could have been unlocked again in the meantime
+		if m.unlocked[addr] == account {
+			delete(m.unlocked, addr)
+		}
+	})
+
+	m.unlocked[addr] = account
+
+	m.logger.Debug("account unlocked", "address", addr, "duration", timeout)
+
+	return nil
+}
+
+// Lock removes the key of the account from memory
+func (m *Manager) Lock(addr types.Address) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	account, ok := m.unlocked[addr]
+	if !ok {
+		return nil
+	}
+
+	account.timer.Stop()
+	delete(m.unlocked, addr)
+
+	m.logger.Debug("account locked", "address", addr)
+
+	return nil
+}
+
+// IsUnlocked returns true if the key of the account is held in memory
+func (m *Manager) IsUnlocked(addr types.Address) bool {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	_, ok := m.unlocked[addr]
+
+	return ok
+}
+
+// unlockedKey returns the key of the unlocked account
+func (m *Manager) unlockedKey(addr types.Address) (*ecdsa.PrivateKey, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	account, ok := m.unlocked[addr]
+	if !ok {
+		return nil, ErrAccountLocked
+	}
+
+	return account.key, nil
+}
+
+// SignHash signs the hash with the key of the unlocked account.
+// The signature is in the [R || S || V] format, where V is 0 or 1
+func (m *Manager) SignHash(addr types.Address, hash []byte) ([]byte, error) {
+	key, err := m.unlockedKey(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return crypto.Sign(key, hash)
+}
+
+// SignTx signs the transaction with the key of the unlocked account
+func (m *Manager) SignTx(
+	addr types.Address,
+	tx *types.Transaction,
+	signer crypto.TxSigner,
+) (*types.Transaction, error) {
+	key, err := m.unlockedKey(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return signer.SignTx(tx, key)
+}
@@ -0,0 +1,154 @@
+package accounts
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/keystore"
+	"github.com/0xPolygon/polygon-edge/secrets"
+	"github.com/0xPolygon/polygon-edge/secrets/local"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+func newTestManager(t *testing.T, maxUnlockDuration time.Duration) *Manager {
+	t.Helper()
+
+	backend, err := NewKeystoreBackend(t.TempDir())
+	require.NoError(t, err)
+
+	backend.scryptN = keystore.LightScryptN
+
+	return NewManager(hclog.NewNullLogger(), backend, maxUnlockDuration)
+}
+
+func TestManager_NewAccount(t *testing.T) {
+	t.Parallel()
+
+	manager := newTestManager(t, 0)
+
+	addr, err := manager.NewAccount("secret")
+	require.NoError(t, err)
+
+	accounts, err := manager.Accounts()
+	require.NoError(t, err)
+	assert.Equal(t, []types.Address{addr}, accounts)
+
+	// wrong password
+	assert.ErrorIs(t, manager.Unlock(addr, "wrong", nil), keystore.ErrDecryptionFailed)
+
+	// unknown account
+	assert.ErrorIs(t, manager.Unlock(types.StringToAddress("1"), "secret", nil), ErrUnknownAccount)
+
+	require.NoError(t, manager.Unlock(addr, "secret", nil))
+	assert.True(t, manager.IsUnlocked(addr))
+}
+
+func TestManager_SignAndLock(t *testing.T) {
+	t.Parallel()
+
+	manager := newTestManager(t, 0)
+
+	addr, err := manager.NewAccount("secret")
+	require.NoError(t, err)
+
+	hash := TextHash([]byte("hello"))
+
+	_, err = manager.SignHash(addr, hash)
+	assert.ErrorIs(t, err, ErrAccountLocked)
+
+	require.NoError(t, manager.Unlock(addr, "secret", nil))
+
+	sig, err := manager.SignHash(addr, hash)
+	require.NoError(t, err)
+
+	pub, err := crypto.RecoverPubkey(sig, hash)
+	require.NoError(t, err)
+	assert.Equal(t, addr, crypto.PubKeyToAddress(pub))
+
+	require.NoError(t, manager.Lock(addr))
+	assert.False(t, manager.IsUnlocked(addr))
+
+	_, err = manager.SignHash(addr, hash)
+	assert.ErrorIs(t, err, ErrAccountLocked)
+}
+
+func TestManager_UnlockDuration(t *testing.T) {
+	t.Parallel()
+
+	manager := newTestManager(t, time.Minute)
+
+	addr, err := manager.NewAccount("secret")
+	require.NoError(t, err)
+
+	duration := func(d time.Duration) *time.Duration {
+		return &d
+	}
+
+	assert.ErrorIs(t, manager.Unlock(addr, "secret", duration(0)), ErrInvalidUnlockDuration)
+	assert.ErrorIs(t, manager.Unlock(addr, "secret", duration(time.Hour)), ErrUnlockDurationTooLong)
+
+	require.NoError(t, manager.Unlock(addr, "secret", duration(50*time.Millisecond)))
+	assert.True(t, manager.IsUnlocked(addr))
+
+	assert.Eventually(t, func() bool {
+		return !manager.IsUnlocked(addr)
+	}, time.Second, 10*time.Millisecond)
+
+	// unlocking again extends the duration instead of keeping the earlier timer
+	require.NoError(t, manager.Unlock(addr, "secret", duration(50*time.Millisecond)))
+	require.NoError(t, manager.Unlock(addr, "secret", duration(time.Minute)))
+
+	time.Sleep(100 * time.Millisecond)
+	assert.True(t, manager.IsUnlocked(addr))
+}
+
+func TestKeystoreBackend_KeyFileName(t *testing.T) {
+	t.Parallel()
+
+	addr := types.StringToAddress("0x7ef5a6135f1fd6a02593eedc869c6d41d934aef8")
+	name := keyFileName(addr, time.Date(2023, 1, 2, 3, 4, 5, 6, time.UTC))
+
+	assert.Equal(t, "UTC--2023-01-02T03-04-05.000000006Z--7ef5a6135f1fd6a02593eedc869c6d41d934aef8", name)
+
+	parsed, ok := keyFileAddress(name)
+	assert.True(t, ok)
+	assert.Equal(t, addr, parsed)
+
+	_, ok = keyFileAddress("README.md")
+	assert.False(t, ok)
+}
+
+func TestSecretsBackend_Password(t *testing.T) {
+	t.Parallel()
+
+	manager, err := local.SecretsManagerFactory(nil, &secrets.SecretsManagerParams{
+		Logger: hclog.NewNullLogger(),
+		Extra:  map[string]interface{}{secrets.Path: t.TempDir()},
+	})
+	require.NoError(t, err)
+
+	key, encodedKey, err := crypto.GenerateAndEncodeECDSAPrivateKey()
+	require.NoError(t, err)
+	require.NoError(t, manager.SetSecret(secrets.ValidatorKey, encodedKey))
+
+	addr := crypto.PubKeyToAddress(&key.PublicKey)
+
+	// the validator key is unlocked only by the configured password
+	backend := NewSecretsBackend(manager, "secret")
+
+	_, err = backend.Key(addr, "wrong")
+	assert.ErrorIs(t, err, ErrInvalidPassword)
+
+	unlocked, err := backend.Key(addr, "secret")
+	require.NoError(t, err)
+	assert.Equal(t, key.D, unlocked.D)
+
+	// no password is accepted if none is configured
+	_, err = NewSecretsBackend(manager, "").Key(addr, "")
+	assert.ErrorIs(t, err, ErrInvalidPassword)
+}
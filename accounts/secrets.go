@@ -0,0 +1,67 @@
+package accounts
+
+import (
+	"crypto/ecdsa"
+	"crypto/subtle"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/secrets"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// SecretsBackend exposes the validator key held by the secrets manager as the single account.
+// The validator key isn't encrypted by a password of its own, so the account is unlocked
+// by the password configured for the backend
+type SecretsBackend struct {
+	manager  secrets.SecretsManager
+	password []byte
+}
+
+// NewSecretsBackend creates the backend on top of the secrets manager, unlocked by the given password
+func NewSecretsBackend(manager secrets.SecretsManager, password string) *SecretsBackend {
+	return &SecretsBackend{
+		manager:  manager,
+		password: []byte(password),
+	}
+}
+
+// Accounts returns the address of the validator key, if the secrets manager holds it
+func (s *SecretsBackend) Accounts() ([]types.Address, error) {
+	if !s.manager.HasSecret(secrets.ValidatorKey) {
+		return []types.Address{}, nil
+	}
+
+	key, err := crypto.ReadConsensusKey(s.manager)
+	if err != nil {
+		return nil, err
+	}
+
+	return []types.Address{crypto.PubKeyToAddress(&key.PublicKey)}, nil
+}
+
+// NewAccount is not supported, the validator key is generated by the secrets init command
+func (s *SecretsBackend) NewAccount(_ string) (types.Address, error) {
+	return types.ZeroAddress, ErrNewAccountNotSupported
+}
+
+// Key returns the validator key if it belongs to the account and the password matches the configured one
+func (s *SecretsBackend) Key(addr types.Address, password string) (*ecdsa.PrivateKey, error) {
+	if !s.manager.HasSecret(secrets.ValidatorKey) {
+		return nil, ErrUnknownAccount
+	}
+
+	if len(s.password) == 0 || subtle.ConstantTimeCompare(s.password, []byte(password)) != 1 {
+		return nil, ErrInvalidPassword
+	}
+
+	key, err := crypto.ReadConsensusKey(s.manager)
+	if err != nil {
+		return nil, err
+	}
+
+	if crypto.PubKeyToAddress(&key.PublicKey) != addr {
+		return nil, ErrUnknownAccount
+	}
+
+	return key, nil
+}
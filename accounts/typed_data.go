@@ -0,0 +1,369 @@
+package accounts
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// domainType is the name of the EIP-712 domain type
+const domainType = "EIP712Domain"
+
+var (
+	ErrDomainTypeMissing = errors.New("typed data domain type is missing")
+
+	// atomicIntegerType matches the uint<M> and int<M> type names
+	atomicIntegerType = regexp.MustCompile(`^(u?)int(\d*)$`)
+
+	// atomicBytesType matches the bytes<M> type names
+	atomicBytesType = regexp.MustCompile(`^bytes(\d+)$`)
+)
+
+// TextHash returns the hash of the message prefixed as defined by EIP-191,
+// so that it can't be confused with a transaction
+func TextHash(data []byte) []byte {
+	return crypto.Keccak256([]byte(fmt.Sprintf("\x19Ethereum Signed Message:\n%d", len(data))), data)
+}
+
+// TypedDataField is a member of the EIP-712 struct type
+type TypedDataField struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// TypedData is the EIP-712 structured data to be signed
+type TypedData struct {
+	Types       map[string][]TypedDataField `json:"types"`
+	PrimaryType string                      `json:"primaryType"`
+	Domain      map[string]interface{}      `json:"domain"`
+	Message     map[string]interface{}      `json:"message"`
+}
+
+// UnmarshalJSON decodes the typed data, keeping the numbers precise.
+// The typed data can be passed as the JSON object or as the string holding it
+func (t *TypedData) UnmarshalJSON(data []byte) error {
+	type typedData TypedData
+
+	if bytes.HasPrefix(data, []byte(`"`)) {
+		var raw string
+		if err := json.Unmarshal(data, &raw); err != nil {
+			return err
+		}
+
+		data = []byte(raw)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	return decoder.Decode((*typedData)(t))
+}
+
+// Hash returns the EIP-712 hash of the typed data, which is the one to be signed
+func (t *TypedData) Hash() (types.Hash, error) {
+	if _, ok := t.Types[domainType]; !ok {
+		return types.ZeroHash, ErrDomainTypeMissing
+	}
+
+	domainHash, err := t.hashStruct(domainType, t.Domain)
+	if err != nil {
+		return types.ZeroHash, fmt.Errorf("invalid domain: %w", err)
+	}
+
+	// the message is omitted if the domain is the primary type
+	if t.PrimaryType == domainType {
+		return crypto.Keccak256Hash([]byte{0x19, 0x01}, domainHash), nil
+	}
+
+	messageHash, err := t.hashStruct(t.PrimaryType, t.Message)
+	if err != nil {
+		return types.ZeroHash, fmt.Errorf("invalid message: %w", err)
+	}
+
+	return crypto.Keccak256Hash([]byte{0x19, 0x01}, domainHash, messageHash), nil
+}
+
+// hashStruct returns the hash of the encoded struct value
+func (t *TypedData) hashStruct(typeName string, data map[string]interface{}) ([]byte, error) {
+	encoded, err := t.encodeData(typeName, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return crypto.Keccak256(encoded), nil
+}
+
+// typeHash returns the hash of the encoded type
+func (t *TypedData) typeHash(typeName string) []byte {
+	return crypto.Keccak256([]byte(t.encodeType(typeName)))
+}
+
+// encodeType encodes the type followed by the alphabetically sorted struct types it references,
+// e.g. Mail(Person from,Person to,string contents)Person(string name,address wallet)
+func (t *TypedData) encodeType(typeName string) string {
+	deps := t.dependencies(typeName, map[string]struct{}{})
+	delete(deps, typeName)
+
+	sorted := make([]string, 0, len(deps))
+	for dep := range deps {
+		sorted = append(sorted, dep)
+	}
+
+	sort.Strings(sorted)
+
+	var buf strings.Builder
+
+	for _, name := range append([]string{typeName}, sorted...) {
+		fields := make([]string, len(t.Types[name]))
+		for i, field := range t.Types[name] {
+			fields[i] = field.Type + " " + field.Name
+		}
+
+		buf.WriteString(name + "(" + strings.Join(fields, ",") + ")")
+	}
+
+	return buf.String()
+}
+
+// dependencies collects the struct types referenced by the type, including itself
+func (t *TypedData) dependencies(typeName string, found map[string]struct{}) map[string]struct{} {
+	typeName = baseType(typeName)
+
+	if _, ok := found[typeName]; ok {
+		return found
+	}
+
+	if _, ok := t.Types[typeName]; !ok {
+		return found
+	}
+
+	found[typeName] = struct{}{}
+
+	for _, field := range t.Types[typeName] {
+		t.dependencies(field.Type, found)
+	}
+
+	return found
+}
+
+// encodeData encodes the struct value as the type hash followed by the encoded members
+func (t *TypedData) encodeData(typeName string, data map[string]interface{}) ([]byte, error) {
+	fields, ok := t.Types[typeName]
+	if !ok {
+		return nil, fmt.Errorf("unknown type %s", typeName)
+	}
+
+	encoded := t.typeHash(typeName)
+
+	for _, field := range fields {
+		value, ok := data[field.Name]
+		if !ok {
+			return nil, fmt.Errorf("missing value of %s.%s", typeName, field.Name)
+		}
+
+		word, err := t.encodeValue(field.Type, value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value of %s.%s: %w", typeName, field.Name, err)
+		}
+
+		encoded = append(encoded, word...)
+	}
+
+	return encoded, nil
+}
+
+// encodeValue encodes the value of the member into a 32 byte word
+func (t *TypedData) encodeValue(typeName string, value interface{}) ([]byte, error) {
+	// arrays are encoded as the hash of the concatenated encodings of the items
+	if strings.HasSuffix(typeName, "]") {
+		items, ok := value.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("expected array, got %T", value)
+		}
+
+		itemType := typeName[:strings.LastIndex(typeName, "[")]
+		length := typeName[len(itemType)+1 : len(typeName)-1]
+
+		if length != "" && length != strconv.Itoa(len(items)) {
+			return nil, fmt.Errorf("expected %s items, got %d", length, len(items))
+		}
+
+		encoded := make([]byte, 0, len(items)*types.HashLength)
+
+		for _, item := range items {
+			word, err := t.encodeValue(itemType, item)
+			if err != nil {
+				return nil, err
+			}
+
+			encoded = append(encoded, word...)
+		}
+
+		return crypto.Keccak256(encoded), nil
+	}
+
+	// struct values are encoded as their hashes
+	if _, ok := t.Types[typeName]; ok {
+		data, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("expected struct, got %T", value)
+		}
+
+		return t.hashStruct(typeName, data)
+	}
+
+	return encodeAtomicValue(typeName, value)
+}
+
+// encodeAtomicValue encodes the value of the atomic or the dynamic (string, bytes) type
+func encodeAtomicValue(typeName string, value interface{}) ([]byte, error) {
+	switch typeName {
+	case "string":
+		str, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected string, got %T", value)
+		}
+
+		return crypto.Keccak256([]byte(str)), nil
+
+	case "bytes":
+		buf, err := decodeBytes(value)
+		if err != nil {
+			return nil, err
+		}
+
+		return crypto.Keccak256(buf), nil
+
+	case "bool":
+		flag, ok := value.(bool)
+		if !ok {
+			return nil, fmt.Errorf("expected bool, got %T", value)
+		}
+
+		word := make([]byte, types.HashLength)
+		if flag {
+			word[types.HashLength-1] = 1
+		}
+
+		return word, nil
+
+	case "address":
+		buf, err := decodeBytes(value)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(buf) != types.AddressLength {
+			return nil, fmt.Errorf("invalid address length %d", len(buf))
+		}
+
+		return types.BytesToHash(buf).Bytes(), nil
+	}
+
+	if match := atomicBytesType.FindStringSubmatch(typeName); match != nil {
+		size, _ := strconv.Atoi(match[1])
+
+		buf, err := decodeBytes(value)
+		if err != nil {
+			return nil, err
+		}
+
+		if size == 0 || size > types.HashLength || len(buf) > size {
+			return nil, fmt.Errorf("invalid %s value length %d", typeName, len(buf))
+		}
+
+		// fixed size bytes are right padded
+		word := make([]byte, types.HashLength)
+		copy(word, buf)
+
+		return word, nil
+	}
+
+	if match := atomicIntegerType.FindStringSubmatch(typeName); match != nil {
+		return encodeInteger(typeName, match[1] == "u", match[2], value)
+	}
+
+	return nil, fmt.Errorf("unknown type %s", typeName)
+}
+
+// encodeInteger encodes the integer as the big endian 256 bit word, in two's complement if negative
+func encodeInteger(typeName string, unsigned bool, rawBits string, value interface{}) ([]byte, error) {
+	bits := 256
+
+	if rawBits != "" {
+		bits, _ = strconv.Atoi(rawBits)
+		if bits == 0 || bits > 256 || bits%8 != 0 {
+			return nil, fmt.Errorf("unknown type %s", typeName)
+		}
+	}
+
+	var (
+		num = new(big.Int)
+		ok  bool
+	)
+
+	switch v := value.(type) {
+	case json.Number:
+		_, ok = num.SetString(string(v), 10)
+	case string:
+		if digits := strings.Replace(v, "0x", "", 1); digits != v {
+			_, ok = num.SetString(digits, 16)
+		} else {
+			_, ok = num.SetString(v, 10)
+		}
+	case float64:
+		_, ok = num.SetString(strconv.FormatFloat(v, 'f', -1, 64), 10)
+	}
+
+	if !ok {
+		return nil, fmt.Errorf("invalid %s value %v", typeName, value)
+	}
+
+	// check the value fits into the type
+	limit := new(big.Int).Lsh(big.NewInt(1), uint(bits))
+	if unsigned {
+		if num.Sign() < 0 || num.Cmp(limit) >= 0 {
+			return nil, fmt.Errorf("value %s overflows %s", num, typeName)
+		}
+	} else {
+		half := new(big.Int).Rsh(limit, 1)
+		if num.Cmp(half) >= 0 || num.Cmp(new(big.Int).Neg(half)) < 0 {
+			return nil, fmt.Errorf("value %s overflows %s", num, typeName)
+		}
+	}
+
+	if num.Sign() < 0 {
+		num.Add(num, new(big.Int).Lsh(big.NewInt(1), 256))
+	}
+
+	return types.BytesToHash(num.Bytes()).Bytes(), nil
+}
+
+// decodeBytes decodes the hex encoded bytes value
+func decodeBytes(value interface{}) ([]byte, error) {
+	str, ok := value.(string)
+	if !ok {
+		return nil, fmt.Errorf("expected hex string, got %T", value)
+	}
+
+	return hex.DecodeHex(str)
+}
+
+// baseType strips the array suffixes of the type name
+func baseType(typeName string) string {
+	if idx := strings.Index(typeName, "["); idx >= 0 {
+		return typeName[:idx]
+	}
+
+	return typeName
+}
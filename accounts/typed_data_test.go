@@ -0,0 +1,115 @@
+package accounts
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+)
+
+// mailTypedData is the example of the EIP-712 specification
+const mailTypedData = `{
+	"types": {
+		"EIP712Domain": [
+			{"name": "name", "type": "string"},
+			{"name": "version", "type": "string"},
+			{"name": "chainId", "type": "uint256"},
+			{"name": "verifyingContract", "type": "address"}
+		],
+		"Person": [
+			{"name": "name", "type": "string"},
+			{"name": "wallet", "type": "address"}
+		],
+		"Mail": [
+			{"name": "from", "type": "Person"},
+			{"name": "to", "type": "Person"},
+			{"name": "contents", "type": "string"}
+		]
+	},
+	"primaryType": "Mail",
+	"domain": {
+		"name": "Ether Mail",
+		"version": "1",
+		"chainId": 1,
+		"verifyingContract": "0xCcCCccccCCCCcCCCCCCcCcCccCcCCCcCcccccccC"
+	},
+	"message": {
+		"from": {"name": "Cow", "wallet": "0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826"},
+		"to": {"name": "Bob", "wallet": "0xbBbBBBBbbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB"},
+		"contents": "Hello, Bob!"
+	}
+}`
+
+func TestTypedData_Hash(t *testing.T) {
+	t.Parallel()
+
+	var typedData TypedData
+	require.NoError(t, json.Unmarshal([]byte(mailTypedData), &typedData))
+
+	assert.Equal(t,
+		"Mail(Person from,Person to,string contents)Person(string name,address wallet)",
+		typedData.encodeType("Mail"),
+	)
+
+	domainHash, err := typedData.hashStruct(domainType, typedData.Domain)
+	require.NoError(t, err)
+	assert.Equal(t, "0xf2cee375fa42b42143804025fc449deafd50cc031ca257e0b194a650a912090f", hex.EncodeToHex(domainHash))
+
+	messageHash, err := typedData.hashStruct(typedData.PrimaryType, typedData.Message)
+	require.NoError(t, err)
+	assert.Equal(t, "0xc52c0ee5d84264471806290a3f2c4cecfc5490626bf912d01f240d7a274b371e", hex.EncodeToHex(messageHash))
+
+	hash, err := typedData.Hash()
+	require.NoError(t, err)
+	assert.Equal(t, "0xbe609aee343fb3c4b28e1df9e632fca64fcfaede20f02e86244efddf30957bd2", hash.String())
+
+	// the typed data passed as the JSON string
+	encoded, err := json.Marshal(mailTypedData)
+	require.NoError(t, err)
+
+	var fromString TypedData
+	require.NoError(t, json.Unmarshal(encoded, &fromString))
+
+	hash, err = fromString.Hash()
+	require.NoError(t, err)
+	assert.Equal(t, "0xbe609aee343fb3c4b28e1df9e632fca64fcfaede20f02e86244efddf30957bd2", hash.String())
+}
+
+func TestTypedData_EncodeAtomicValue(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		typeName string
+		value    interface{}
+		expected string
+		err      bool
+	}{
+		{"uint8", json.Number("255"), "0x00000000000000000000000000000000000000000000000000000000000000ff", false},
+		{"uint8", json.Number("256"), "", true},
+		{"int8", json.Number("-1"), "0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff", false},
+		{"int8", json.Number("-129"), "", true},
+		{"uint256", "0x10", "0x0000000000000000000000000000000000000000000000000000000000000010", false},
+		{"uint256", "0xzz", "", true},
+		{"bytes4", "0x01020304", "0x0102030400000000000000000000000000000000000000000000000000000000", false},
+		{"bytes4", "0x0102030405", "", true},
+		{"bool", true, "0x0000000000000000000000000000000000000000000000000000000000000001", false},
+		{"address", "0x01", "", true},
+		{"uint7", json.Number("1"), "", true},
+		{"unknown", "0x01", "", true},
+	}
+
+	for _, c := range cases {
+		encoded, err := encodeAtomicValue(c.typeName, c.value)
+		if c.err {
+			assert.Error(t, err, c.typeName)
+
+			continue
+		}
+
+		require.NoError(t, err, c.typeName)
+		assert.Equal(t, c.expected, hex.EncodeToHex(encoded), c.typeName)
+	}
+}
@@ -24,6 +24,7 @@ import (
 const (
 	BlockGasTargetDivisor uint64 = 1024 // The bound divisor of the gas limit, used in update calculations
 	defaultCacheSize      int    = 100  // The default size for Blockchain LRU cache structures
+	badBlocksCacheSize    int    = 10   // The number of the recently rejected blocks kept for inspection
 )
 
 var (
@@ -38,6 +39,7 @@ var (
 	ErrInvalidStateRoot     = errors.New("invalid block state root")
 	ErrInvalidGasUsed       = errors.New("invalid block gas used")
 	ErrInvalidReceiptsRoot  = errors.New("invalid block receipts root")
+	ErrInvalidRewind        = errors.New("invalid rewind block number")
 )
 
 // Blockchain is a blockchain reference
@@ -66,6 +68,8 @@ type Blockchain struct {
 	// any new fields from being added
 	receiptsCache *lru.Cache // LRU cache for the block receipts
 
+	badBlocks *lru.Cache // LRU cache for the recently rejected blocks
+
 	currentHeader     atomic.Value // The current header
 	currentDifficulty atomic.Value // The current difficulty of the chain (total difficulty)
 
@@ -255,6 +259,11 @@ func (b *Blockchain) initCaches(size int) error {
 		return fmt.Errorf("unable to create receipts cache, %w", err)
 	}
 
+	b.badBlocks, err = lru.New(badBlocksCacheSize)
+	if err != nil {
+		return fmt.Errorf("unable to create bad blocks cache, %w", err)
+	}
+
 	return nil
 }
 
@@ -680,6 +689,9 @@ func (b *Blockchain) WriteHeadersWithBodies(headers []*types.Header) error {
 func (b *Blockchain) VerifyPotentialBlock(block *types.Block) error {
 	// Do just the initial block verification
 	_, err := b.verifyBlock(block)
+	if err != nil {
+		b.addBadBlock(block, err)
+	}
 
 	return err
 }
@@ -689,12 +701,17 @@ func (b *Blockchain) VerifyPotentialBlock(block *types.Block) error {
 func (b *Blockchain) VerifyFinalizedBlock(block *types.Block) (*types.FullBlock, error) {
 	// Make sure the consensus layer verifies this block header
 	if err := b.consensus.VerifyHeader(block.Header); err != nil {
-		return nil, fmt.Errorf("failed to verify the header: %w", err)
+		err = fmt.Errorf("failed to verify the header: %w", err)
+		b.addBadBlock(block, err)
+
+		return nil, err
 	}
 
 	// Do the initial block verification
 	receipts, err := b.verifyBlock(block)
 	if err != nil {
+		b.addBadBlock(block, err)
+
 		return nil, err
 	}
 
@@ -723,6 +740,35 @@ func (b *Blockchain) verifyBlock(block *types.Block) ([]*types.Receipt, error) {
 	return receipts, nil
 }
 
+// BadBlock is the block rejected by the verification, along with the reason of the rejection
+type BadBlock struct {
+	Block *types.Block
+	Err   error
+}
+
+// addBadBlock keeps the rejected block, evicting the oldest one if there are too many
+func (b *Blockchain) addBadBlock(block *types.Block, err error) {
+	if block == nil || block.Header == nil {
+		return
+	}
+
+	b.badBlocks.Add(block.Hash(), &BadBlock{Block: block, Err: err})
+}
+
+// GetBadBlocks returns the recently rejected blocks, from the oldest to the newest
+func (b *Blockchain) GetBadBlocks() []*BadBlock {
+	keys := b.badBlocks.Keys()
+	badBlocks := make([]*BadBlock, 0, len(keys))
+
+	for _, key := range keys {
+		if badBlock, ok := b.badBlocks.Peek(key); ok {
+			badBlocks = append(badBlocks, badBlock.(*BadBlock)) //nolint:forcetypeassert
+		}
+	}
+
+	return badBlocks
+}
+
 // verifyBlockParent makes sure that the child block is in line
 // with the locally saved parent block. This means checking:
 // - The parent exists
@@ -1206,6 +1252,55 @@ func (b *Blockchain) GetHashByNumber(blockNumber uint64) types.Hash {
 	return block.Hash()
 }
 
+// RewindHead moves the head of the chain back to the canonical block with the given number,
+// so the blocks above it are no longer canonical and the next block is built on top of it.
+// It is meant for the dev chains only, which are reverted to the test snapshots
+func (b *Blockchain) RewindHead(number uint64) error {
+	b.writeLock.Lock()
+	defer b.writeLock.Unlock()
+
+	head := b.Header()
+	if number > head.Number {
+		return fmt.Errorf("%w: %d is above the head %d", ErrInvalidRewind, number, head.Number)
+	}
+
+	if number == head.Number {
+		return nil
+	}
+
+	header, ok := b.GetHeaderByNumber(number)
+	if !ok {
+		return fmt.Errorf("%w: header %d not found", ErrInvalidRewind, number)
+	}
+
+	evnt := &Event{Source: "rewind"}
+
+	for n := number + 1; n <= head.Number; n++ {
+		if removed, ok := b.GetHeaderByNumber(n); ok {
+			evnt.AddOldHeader(removed)
+		}
+
+		if err := b.db.WriteCanonicalHash(n, types.ZeroHash); err != nil {
+			return err
+		}
+	}
+
+	diff, err := b.advanceHead(header)
+	if err != nil {
+		return err
+	}
+
+	evnt.AddNewHeader(header)
+	evnt.Type = EventReorg
+	evnt.SetDifficulty(diff)
+
+	b.dispatchEvent(evnt)
+
+	b.logger.Info("rewound head", "from", head.Number, "to", number)
+
+	return nil
+}
+
 // dispatchEvent pushes a new event to the stream
 func (b *Blockchain) dispatchEvent(evnt *Event) {
 	b.stream.push(evnt)
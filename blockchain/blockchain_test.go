@@ -1335,3 +1335,71 @@ func TestBlockchain_VerifyBlockBody(t *testing.T) {
 		assert.ErrorIs(t, err, errUnableToExecute)
 	})
 }
+
+func TestBlockchain_BadBlocks(t *testing.T) {
+	t.Parallel()
+
+	blockchain, err := NewMockBlockchain(map[TestCallbackType]interface{}{
+		StorageCallback: func(storage *storage.MockStorage) {
+			storage.HookReadHeader(func(hash types.Hash) (*types.Header, error) {
+				return nil, errors.New("not found")
+			})
+		},
+	})
+	if err != nil {
+		t.Fatalf("unable to instantiate new blockchain, %v", err)
+	}
+
+	// the blocks with the unknown parent are rejected
+	for i := 0; i < badBlocksCacheSize+2; i++ {
+		header := &types.Header{Number: uint64(i + 1)}
+		header.ComputeHash()
+
+		_, err := blockchain.VerifyFinalizedBlock(&types.Block{Header: header})
+		assert.ErrorIs(t, err, ErrParentNotFound)
+	}
+
+	assert.ErrorIs(t, blockchain.VerifyPotentialBlock(nil), ErrNoBlock)
+
+	// only the newest rejected blocks are kept, from the oldest to the newest
+	badBlocks := blockchain.GetBadBlocks()
+	assert.Len(t, badBlocks, badBlocksCacheSize)
+	assert.Equal(t, uint64(3), badBlocks[0].Block.Number())
+	assert.Equal(t, uint64(badBlocksCacheSize+2), badBlocks[badBlocksCacheSize-1].Block.Number())
+	assert.ErrorIs(t, badBlocks[0].Err, ErrParentNotFound)
+}
+
+func TestBlockchain_RewindHead(t *testing.T) {
+	t.Parallel()
+
+	headers := NewTestHeaders(5)
+	b := NewTestBlockchain(t, headers)
+
+	assert.ErrorIs(t, b.RewindHead(5), ErrInvalidRewind)
+
+	sub := b.SubscribeEvents()
+	defer sub.Close()
+
+	assert.NoError(t, b.RewindHead(2))
+	assert.Equal(t, headers[2].Hash, b.Header().Hash)
+
+	evnt := sub.GetEvent()
+	assert.Equal(t, EventReorg, evnt.Type)
+	assert.Len(t, evnt.OldChain, 2)
+	assert.Equal(t, headers[3].Hash, evnt.OldChain[0].Hash)
+	assert.Equal(t, headers[4].Hash, evnt.OldChain[1].Hash)
+	assert.Equal(t, headers[2].Hash, evnt.Header().Hash)
+
+	// the rewound blocks are no longer canonical
+	_, ok := b.GetHeaderByNumber(3)
+	assert.False(t, ok)
+
+	header, ok := b.GetHeaderByNumber(2)
+	assert.True(t, ok)
+	assert.Equal(t, headers[2].Hash, header.Hash)
+
+	// the next block is built on top of the new head
+	next := AppendNewTestheadersWithSeed(headers[:3], 1, 100)
+	assert.NoError(t, b.WriteHeaders(next[3:]))
+	assert.Equal(t, next[3].Hash, b.Header().Hash)
+}
@@ -12,6 +12,8 @@ type Params struct {
 	ChainID        int64                  `json:"chainID"`
 	Engine         map[string]interface{} `json:"engine"`
 	Whitelists     *Whitelists            `json:"whitelists,omitempty"`
+	TxPolicy       *TxPolicy              `json:"txPolicy,omitempty"`
+	TxOrdering     *TxOrdering            `json:"txOrdering,omitempty"`
 	BlockGasTarget uint64                 `json:"blockGasTarget"`
 }
 
@@ -29,6 +31,33 @@ type Whitelists struct {
 	Deployment []types.Address `json:"deployment,omitempty"`
 }
 
+// TxPolicy specifies the transaction admission policy of permissioned chains
+type TxPolicy struct {
+	// SenderAllowList are the only senders allowed to send transactions, if not empty
+	SenderAllowList []types.Address `json:"senderAllowList,omitempty"`
+
+	// SenderDenyList are the senders which are not allowed to send transactions
+	SenderDenyList []types.Address `json:"senderDenyList,omitempty"`
+
+	// RecipientAllowList are the only recipients (accounts or contracts) transactions can be sent to, if not empty
+	RecipientAllowList []types.Address `json:"recipientAllowList,omitempty"`
+
+	// MethodDenyList are the hex encoded 4-byte method selectors transactions are not allowed to call
+	MethodDenyList []string `json:"methodDenyList,omitempty"`
+
+	// Contract is the address of the policy contract the lists can be changed through at runtime
+	Contract *types.Address `json:"contract,omitempty"`
+}
+
+// TxOrdering specifies the order the transactions are picked from the pool for the block building in
+type TxOrdering struct {
+	// Policy is the name of the ordering policy, the price priority is used if empty
+	Policy string `json:"policy"`
+
+	// SenderCap is the max number of a single sender's transactions in a block (round-robin policy only)
+	SenderCap uint64 `json:"senderCap,omitempty"`
+}
+
 // Forks specifies when each fork is activated
 type Forks struct {
 	Homestead      *Fork `json:"homestead,omitempty"`
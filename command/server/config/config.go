@@ -13,25 +13,41 @@ import (
 
 // Config defines the server configuration params
 type Config struct {
-	GenesisPath              string     `json:"chain_config" yaml:"chain_config"`
-	SecretsConfigPath        string     `json:"secrets_config" yaml:"secrets_config"`
-	DataDir                  string     `json:"data_dir" yaml:"data_dir"`
-	BlockGasTarget           string     `json:"block_gas_target" yaml:"block_gas_target"`
-	GRPCAddr                 string     `json:"grpc_addr" yaml:"grpc_addr"`
-	JSONRPCAddr              string     `json:"jsonrpc_addr" yaml:"jsonrpc_addr"`
-	Telemetry                *Telemetry `json:"telemetry" yaml:"telemetry"`
-	Network                  *Network   `json:"network" yaml:"network"`
-	ShouldSeal               bool       `json:"seal" yaml:"seal"`
-	TxPool                   *TxPool    `json:"tx_pool" yaml:"tx_pool"`
-	LogLevel                 string     `json:"log_level" yaml:"log_level"`
-	RestoreFile              string     `json:"restore_file" yaml:"restore_file"`
-	BlockTime                uint64     `json:"block_time_s" yaml:"block_time_s"`
-	Headers                  *Headers   `json:"headers" yaml:"headers"`
-	LogFilePath              string     `json:"log_to" yaml:"log_to"`
-	JSONRPCBatchRequestLimit uint64     `json:"json_rpc_batch_request_limit" yaml:"json_rpc_batch_request_limit"`
-	JSONRPCBlockRangeLimit   uint64     `json:"json_rpc_block_range_limit" yaml:"json_rpc_block_range_limit"`
-	JSONLogFormat            bool       `json:"json_log_format" yaml:"json_log_format"`
-	Relayer                  bool       `json:"relayer" yaml:"relayer"`
+	GenesisPath                 string           `json:"chain_config" yaml:"chain_config"`
+	SecretsConfigPath           string           `json:"secrets_config" yaml:"secrets_config"`
+	DataDir                     string           `json:"data_dir" yaml:"data_dir"`
+	BlockGasTarget              string           `json:"block_gas_target" yaml:"block_gas_target"`
+	GRPCAddr                    string           `json:"grpc_addr" yaml:"grpc_addr"`
+	JSONRPCAddr                 string           `json:"jsonrpc_addr" yaml:"jsonrpc_addr"`
+	Telemetry                   *Telemetry       `json:"telemetry" yaml:"telemetry"`
+	Network                     *Network         `json:"network" yaml:"network"`
+	ShouldSeal                  bool             `json:"seal" yaml:"seal"`
+	TxPool                      *TxPool          `json:"tx_pool" yaml:"tx_pool"`
+	LogLevel                    string           `json:"log_level" yaml:"log_level"`
+	RestoreFile                 string           `json:"restore_file" yaml:"restore_file"`
+	BlockTime                   uint64           `json:"block_time_s" yaml:"block_time_s"`
+	Headers                     *Headers         `json:"headers" yaml:"headers"`
+	LogFilePath                 string           `json:"log_to" yaml:"log_to"`
+	JSONRPCBatchRequestLimit    uint64           `json:"json_rpc_batch_request_limit" yaml:"json_rpc_batch_request_limit"`
+	JSONRPCBlockRangeLimit      uint64           `json:"json_rpc_block_range_limit" yaml:"json_rpc_block_range_limit"`
+	JSONRPCNamespaces           []string         `json:"json_rpc_namespaces" yaml:"json_rpc_namespaces"`
+	JSONRPCJWTSecretPath        string           `json:"json_rpc_jwt_secret_path" yaml:"json_rpc_jwt_secret_path"`
+	JSONRPCPrivate              *JSONRPCListener `json:"json_rpc_private" yaml:"json_rpc_private"`
+	JSONRPCRateLimit            *RateLimit       `json:"json_rpc_rate_limit" yaml:"json_rpc_rate_limit"`
+	JSONRPCIPCDisable           bool             `json:"json_rpc_ipc_disable" yaml:"json_rpc_ipc_disable"`
+	JSONRPCIPCPath              string           `json:"json_rpc_ipc_path" yaml:"json_rpc_ipc_path"`
+	JSONRPCIPCFileMode          string           `json:"json_rpc_ipc_file_mode" yaml:"json_rpc_ipc_file_mode"`
+	JSONRPCAccounts             *JSONRPCAccounts `json:"json_rpc_accounts" yaml:"json_rpc_accounts"`
+	JSONRPCGraphQL              bool             `json:"json_rpc_graphql" yaml:"json_rpc_graphql"`
+	JSONRPCGraphiQL             bool             `json:"json_rpc_graphiql" yaml:"json_rpc_graphiql"`
+	JSONRPCSimulateGasCap       uint64           `json:"json_rpc_simulate_gas_cap" yaml:"json_rpc_simulate_gas_cap"`
+	JSONRPCSimulateTimeout      uint64           `json:"json_rpc_simulate_timeout" yaml:"json_rpc_simulate_timeout"`
+	JSONRPCCheckpointFinality   bool             `json:"json_rpc_checkpoint_finality" yaml:"json_rpc_checkpoint_finality"`
+	JSONRPCResponseCacheSize    uint64           `json:"json_rpc_response_cache_size" yaml:"json_rpc_response_cache_size"`
+	JSONRPCSlowRequestThreshold uint64           `json:"json_rpc_slow_request_threshold" yaml:"json_rpc_slow_request_threshold"`
+	JSONLogFormat               bool             `json:"json_log_format" yaml:"json_log_format"`
+	Relayer                     bool             `json:"relayer" yaml:"relayer"`
+	StoreRevertReasons          bool             `json:"store_revert_reasons" yaml:"store_revert_reasons"`
 }
 
 // Telemetry holds the config details for metric services.
@@ -52,9 +68,43 @@ type Network struct {
 
 // TxPool defines the TxPool configuration params
 type TxPool struct {
-	PriceLimit         uint64 `json:"price_limit" yaml:"price_limit"`
-	MaxSlots           uint64 `json:"max_slots" yaml:"max_slots"`
-	MaxAccountEnqueued uint64 `json:"max_account_enqueued" yaml:"max_account_enqueued"`
+	PriceLimit         uint64   `json:"price_limit" yaml:"price_limit"`
+	MaxSlots           uint64   `json:"max_slots" yaml:"max_slots"`
+	MaxAccountEnqueued uint64   `json:"max_account_enqueued" yaml:"max_account_enqueued"`
+	PriceBump          uint64   `json:"price_bump" yaml:"price_bump"`
+	JournalDisable     bool     `json:"journal_disable" yaml:"journal_disable"`
+	JournalPath        string   `json:"journal_path" yaml:"journal_path"`
+	RejournalInterval  uint64   `json:"rejournal_interval_s" yaml:"rejournal_interval_s"`
+	Lifetime           uint64   `json:"lifetime_s" yaml:"lifetime_s"`
+	LifetimePromoted   bool     `json:"lifetime_promoted" yaml:"lifetime_promoted"`
+	LifetimeLocals     bool     `json:"lifetime_locals" yaml:"lifetime_locals"`
+	PrivateTxMaxBlocks uint64   `json:"private_tx_max_blocks" yaml:"private_tx_max_blocks"`
+	PrivateTxPeers     []string `json:"private_tx_peers" yaml:"private_tx_peers"`
+}
+
+// JSONRPCListener defines the configuration params of an additional JSON-RPC listener
+type JSONRPCListener struct {
+	Addr          string   `json:"addr" yaml:"addr"`
+	Namespaces    []string `json:"namespaces" yaml:"namespaces"`
+	JWTSecretPath string   `json:"jwt_secret_path" yaml:"jwt_secret_path"`
+}
+
+// RateLimit defines the configuration params of the per-client JSON-RPC rate limiting
+type RateLimit struct {
+	Rate              float64        `json:"rate" yaml:"rate"`
+	Burst             int            `json:"burst" yaml:"burst"`
+	MethodCosts       map[string]int `json:"method_costs" yaml:"method_costs"`
+	MethodConcurrency map[string]int `json:"method_concurrency" yaml:"method_concurrency"`
+	ClientKeyHeader   string         `json:"client_key_header" yaml:"client_key_header"`
+	ClientKeys        []string       `json:"client_keys" yaml:"client_keys"`
+}
+
+// JSONRPCAccounts defines the configuration params of the node-managed accounts
+type JSONRPCAccounts struct {
+	Backend           string `json:"backend" yaml:"backend"`
+	KeystoreDir       string `json:"keystore_dir" yaml:"keystore_dir"`
+	PasswordFile      string `json:"password_file" yaml:"password_file"`
+	MaxUnlockDuration uint64 `json:"max_unlock_duration" yaml:"max_unlock_duration"`
 }
 
 // Headers defines the HTTP response headers required to enable CORS.
@@ -76,6 +126,61 @@ const (
 	// DefaultJSONRPCBlockRangeLimit maximum block range allowed for json_rpc
 	// requests with fromBlock/toBlock values (e.g. eth_getLogs)
 	DefaultJSONRPCBlockRangeLimit uint64 = 1000
+
+	// DefaultJSONRPCRateLimitBurst is the default capacity of the per-client
+	// json_rpc request buckets, in method cost units
+	DefaultJSONRPCRateLimitBurst int = 100
+
+	// DefaultJSONRPCIPCFileName is the name of the json_rpc IPC socket in the data directory
+	DefaultJSONRPCIPCFileName = "edge.ipc"
+
+	// DefaultJSONRPCIPCFileMode is the access permission of the json_rpc IPC socket file
+	DefaultJSONRPCIPCFileMode = "0600"
+
+	// DefaultJSONRPCKeystoreDir is the directory of the encrypted account key files in the data directory
+	DefaultJSONRPCKeystoreDir = "keystore"
+
+	// DefaultJSONRPCMaxUnlockDuration is the max time in seconds a node-managed account can stay unlocked
+	DefaultJSONRPCMaxUnlockDuration uint64 = 3600
+
+	// DefaultJSONRPCSimulateGasCap is the gas budget of a single eth_simulateV1 request
+	DefaultJSONRPCSimulateGasCap uint64 = 50_000_000
+
+	// DefaultJSONRPCSimulateTimeout is the time budget in seconds of a single eth_simulateV1 request
+	DefaultJSONRPCSimulateTimeout uint64 = 5
+
+	// DefaultPriceBump is the minimum gas price increase (%) required to replace a pending transaction
+	DefaultPriceBump uint64 = 10
+
+	// DefaultTxPoolJournalFileName is the name of the local transactions journal in the data directory
+	DefaultTxPoolJournalFileName = "transactions.rlp"
+
+	// DefaultRejournalInterval is the interval (in seconds) of the local transactions journal regeneration
+	DefaultRejournalInterval uint64 = 3600
+
+	// DefaultTxLifetime is the max time (in seconds) the enqueued transactions stay in the pool for
+	DefaultTxLifetime uint64 = 3 * 3600
+
+	// DefaultPrivateTxMaxBlocks is the number of blocks a private transaction without a max block can be included in
+	DefaultPrivateTxMaxBlocks uint64 = 25
+
+	// DefaultJSONRPCResponseCacheSize is the number of the cached responses of the finalized historical queries
+	DefaultJSONRPCResponseCacheSize uint64 = 1024
+)
+
+// DefaultJSONRPCNamespaces are the namespaces exposed on the public json_rpc listener
+var DefaultJSONRPCNamespaces = []string{"eth", "net", "web3", "txpool", "bridge", "polybft"}
+
+// DefaultJSONRPCPrivateNamespaces are the namespaces exposed on the private json_rpc listener.
+// The debug namespace re-executes the blocks, so it's exposed only on the private listener by default
+var DefaultJSONRPCPrivateNamespaces = []string{"eth", "net", "web3", "txpool", "bridge", "polybft", "debug"}
+
+// DefaultJSONRPCAuthenticatedPrivateNamespaces are the namespaces exposed on the private json_rpc listener
+// by default once it requires the jwt authentication. The admin namespace manages the node,
+// so it's exposed only to the authenticated requests
+var DefaultJSONRPCAuthenticatedPrivateNamespaces = append(
+	append([]string{}, DefaultJSONRPCPrivateNamespaces...),
+	"admin",
 )
 
 // DefaultConfig returns the default server configuration
@@ -102,6 +207,11 @@ func DefaultConfig() *Config {
 			PriceLimit:         0,
 			MaxSlots:           4096,
 			MaxAccountEnqueued: 128,
+			PriceBump:          DefaultPriceBump,
+			RejournalInterval:  DefaultRejournalInterval,
+			Lifetime:           DefaultTxLifetime,
+			PrivateTxMaxBlocks: DefaultPrivateTxMaxBlocks,
+			PrivateTxPeers:     []string{},
 		},
 		LogLevel:    "INFO",
 		RestoreFile: "",
@@ -112,7 +222,22 @@ func DefaultConfig() *Config {
 		LogFilePath:              "",
 		JSONRPCBatchRequestLimit: DefaultJSONRPCBatchRequestLimit,
 		JSONRPCBlockRangeLimit:   DefaultJSONRPCBlockRangeLimit,
+		JSONRPCNamespaces:        DefaultJSONRPCNamespaces,
+		JSONRPCPrivate: &JSONRPCListener{
+			Namespaces: DefaultJSONRPCPrivateNamespaces,
+		},
+		JSONRPCRateLimit: &RateLimit{
+			Burst: DefaultJSONRPCRateLimitBurst,
+		},
+		JSONRPCIPCFileMode: DefaultJSONRPCIPCFileMode,
+		JSONRPCAccounts: &JSONRPCAccounts{
+			MaxUnlockDuration: DefaultJSONRPCMaxUnlockDuration,
+		},
+		JSONRPCSimulateGasCap:    DefaultJSONRPCSimulateGasCap,
+		JSONRPCSimulateTimeout:   DefaultJSONRPCSimulateTimeout,
+		JSONRPCResponseCacheSize: DefaultJSONRPCResponseCacheSize,
 		Relayer:                  false,
+		StoreRevertReasons:       false,
 	}
 }
 
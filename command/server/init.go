@@ -5,6 +5,10 @@ import (
 	"fmt"
 	"math"
 	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
 
 	"github.com/0xPolygon/polygon-edge/command/server/config"
 
@@ -12,10 +16,14 @@ import (
 
 	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft"
+	"github.com/0xPolygon/polygon-edge/helper/ipc"
+	"github.com/0xPolygon/polygon-edge/jsonrpc"
 	"github.com/0xPolygon/polygon-edge/network"
 	"github.com/0xPolygon/polygon-edge/secrets"
 	"github.com/0xPolygon/polygon-edge/server"
 	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/libp2p/go-libp2p/core/peer"
 )
 
 var (
@@ -63,6 +71,30 @@ func (p *serverParams) initRawParams() error {
 
 	p.relayer = p.rawConfig.Relayer
 
+	if err := p.initJSONRPCSecrets(); err != nil {
+		return err
+	}
+
+	p.initJSONRPCPrivateNamespaces()
+
+	if err := p.initJSONRPCIPC(); err != nil {
+		return err
+	}
+
+	if err := p.initJSONRPCCheckpointFinality(); err != nil {
+		return err
+	}
+
+	if err := p.initJSONRPCAccounts(); err != nil {
+		return err
+	}
+
+	p.initTxPoolJournal()
+
+	if err := p.initPrivateTxPeers(); err != nil {
+		return err
+	}
+
 	return p.initAddresses()
 }
 
@@ -230,6 +262,10 @@ func (p *serverParams) initAddresses() error {
 		return err
 	}
 
+	if err := p.initJSONRPCPrivateAddress(); err != nil {
+		return err
+	}
+
 	return p.initGRPCAddress()
 }
 
@@ -306,6 +342,195 @@ func (p *serverParams) initJSONRPCAddress() error {
 	return nil
 }
 
+func (p *serverParams) initJSONRPCPrivateAddress() error {
+	if !p.isJSONRPCPrivateAddressSet() {
+		return nil
+	}
+
+	var parseErr error
+
+	if p.jsonRPCPrivateAddress, parseErr = helper.ResolveAddr(
+		p.rawConfig.JSONRPCPrivate.Addr,
+		helper.LocalHostBinding,
+	); parseErr != nil {
+		return parseErr
+	}
+
+	return nil
+}
+
+func (p *serverParams) initJSONRPCSecrets() error {
+	var err error
+
+	if p.jsonRPCJWTSecret, err = readJWTSecret(p.rawConfig.JSONRPCJWTSecretPath); err != nil {
+		return err
+	}
+
+	if p.rawConfig.JSONRPCPrivate == nil {
+		return nil
+	}
+
+	p.jsonRPCPrivateJWTSecret, err = readJWTSecret(p.rawConfig.JSONRPCPrivate.JWTSecretPath)
+
+	return err
+}
+
+// initJSONRPCPrivateNamespaces adds the admin namespace to the default namespaces of the private listener
+// if it requires the jwt authentication, the explicitly set namespaces are kept as they are
+func (p *serverParams) initJSONRPCPrivateNamespaces() {
+	private := p.rawConfig.JSONRPCPrivate
+	if private == nil || len(p.jsonRPCPrivateJWTSecret) == 0 ||
+		!equalNamespaces(private.Namespaces, config.DefaultJSONRPCPrivateNamespaces) {
+		return
+	}
+
+	private.Namespaces = config.DefaultJSONRPCAuthenticatedPrivateNamespaces
+}
+
+// equalNamespaces checks if the namespaces are the same, in the same order
+func equalNamespaces(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (p *serverParams) initJSONRPCIPC() error {
+	if p.rawConfig.JSONRPCIPCDisable {
+		return nil
+	}
+
+	fileMode, err := strconv.ParseUint(p.rawConfig.JSONRPCIPCFileMode, 8, 32)
+	if err != nil {
+		return fmt.Errorf("invalid json-rpc ipc file mode: %w", err)
+	}
+
+	p.jsonRPCIPCFileMode = os.FileMode(fileMode)
+
+	p.jsonRPCIPCPath = p.rawConfig.JSONRPCIPCPath
+	if p.jsonRPCIPCPath == "" {
+		p.jsonRPCIPCPath = ipc.Path(p.rawConfig.DataDir, config.DefaultJSONRPCIPCFileName)
+	}
+
+	return nil
+}
+
+// initJSONRPCCheckpointFinality checks the checkpoint finality can be served by the chain,
+// the blocks are checkpointed only by the polybft consensus with the rootchain bridge enabled
+func (p *serverParams) initJSONRPCCheckpointFinality() error {
+	if !p.rawConfig.JSONRPCCheckpointFinality {
+		return nil
+	}
+
+	if _, ok := p.genesisConfig.Params.Engine[string(server.PolyBFTConsensus)]; !ok {
+		return errors.New("json-rpc checkpoint finality requires the polybft consensus")
+	}
+
+	polyBFTConfig, err := polybft.GetPolyBFTConfig(p.genesisConfig)
+	if err != nil {
+		return fmt.Errorf("invalid polybft config: %w", err)
+	}
+
+	if !polyBFTConfig.IsBridgeEnabled() {
+		return errors.New("json-rpc checkpoint finality requires the rootchain bridge to be enabled")
+	}
+
+	return nil
+}
+
+func (p *serverParams) initTxPoolJournal() {
+	if p.rawConfig.TxPool.JournalDisable {
+		return
+	}
+
+	p.txPoolJournalPath = p.rawConfig.TxPool.JournalPath
+	if p.txPoolJournalPath == "" {
+		p.txPoolJournalPath = filepath.Join(p.rawConfig.DataDir, config.DefaultTxPoolJournalFileName)
+	}
+}
+
+func (p *serverParams) initPrivateTxPeers() error {
+	p.privateTxPeers = make([]peer.ID, 0, len(p.rawConfig.TxPool.PrivateTxPeers))
+
+	for _, raw := range p.rawConfig.TxPool.PrivateTxPeers {
+		peerID, err := peer.Decode(raw)
+		if err != nil {
+			return fmt.Errorf("invalid private transaction peer %s: %w", raw, err)
+		}
+
+		p.privateTxPeers = append(p.privateTxPeers, peerID)
+	}
+
+	return nil
+}
+
+func (p *serverParams) initJSONRPCAccounts() error {
+	accounts := p.rawConfig.JSONRPCAccounts
+	if accounts == nil {
+		return nil
+	}
+
+	switch server.AccountsBackend(accounts.Backend) {
+	case "":
+		return nil
+	case server.SecretsAccountsBackend:
+		return p.initJSONRPCAccountsPassword()
+	case server.KeystoreAccountsBackend:
+	default:
+		return fmt.Errorf("unknown json-rpc accounts backend: %s", accounts.Backend)
+	}
+
+	p.jsonRPCKeystoreDir = accounts.KeystoreDir
+	if p.jsonRPCKeystoreDir == "" {
+		p.jsonRPCKeystoreDir = filepath.Join(p.rawConfig.DataDir, config.DefaultJSONRPCKeystoreDir)
+	}
+
+	return nil
+}
+
+// initJSONRPCAccountsPassword reads the password of the secrets accounts backend,
+// as the validator key is not encrypted by a password of its own
+func (p *serverParams) initJSONRPCAccountsPassword() error {
+	path := p.rawConfig.JSONRPCAccounts.PasswordFile
+	if path == "" {
+		return fmt.Errorf("the %s json-rpc accounts backend requires the --%s",
+			server.SecretsAccountsBackend, jsonRPCAccountsPasswordFlag)
+	}
+
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("unable to read json-rpc accounts password file: %w", err)
+	}
+
+	p.jsonRPCAccountsPassword = strings.TrimRight(string(raw), "\r\n")
+	if p.jsonRPCAccountsPassword == "" {
+		return errors.New("json-rpc accounts password file is empty")
+	}
+
+	return nil
+}
+
+// readJWTSecret reads the hex encoded JWT secret from the file at the given path
+func readJWTSecret(path string) ([]byte, error) {
+	if path == "" {
+		return nil, nil
+	}
+
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read jwt secret file: %w", err)
+	}
+
+	return jsonrpc.ParseJWTSecret(string(raw))
+}
+
 func (p *serverParams) initGRPCAddress() error {
 	var parseErr error
 
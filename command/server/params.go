@@ -3,13 +3,17 @@ package server
 import (
 	"errors"
 	"net"
+	"os"
+	"time"
 
 	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/command/server/config"
+	"github.com/0xPolygon/polygon-edge/jsonrpc"
 	"github.com/0xPolygon/polygon-edge/network"
 	"github.com/0xPolygon/polygon-edge/secrets"
 	"github.com/0xPolygon/polygon-edge/server"
 	"github.com/hashicorp/go-hclog"
+	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/multiformats/go-multiaddr"
 )
 
@@ -28,8 +32,42 @@ const (
 	priceLimitFlag               = "price-limit"
 	jsonRPCBatchRequestLimitFlag = "json-rpc-batch-request-limit"
 	jsonRPCBlockRangeLimitFlag   = "json-rpc-block-range-limit"
+	jsonRPCNamespacesFlag        = "json-rpc-namespaces"
+	jsonRPCJWTSecretFlag         = "json-rpc-jwt-secret-path"
+	jsonRPCPrivateAddrFlag       = "json-rpc-private"
+	jsonRPCPrivateNamespacesFlag = "json-rpc-private-namespaces"
+	jsonRPCPrivateJWTSecretFlag  = "json-rpc-private-jwt-secret-path"
+	jsonRPCRateLimitFlag         = "json-rpc-rate-limit"
+	jsonRPCRateLimitBurstFlag    = "json-rpc-rate-limit-burst"
+	jsonRPCMethodCostFlag        = "json-rpc-method-cost"
+	jsonRPCMethodConcurrencyFlag = "json-rpc-method-concurrency"
+	jsonRPCClientKeyHeaderFlag   = "json-rpc-client-key-header"
+	jsonRPCClientKeysFlag        = "json-rpc-client-keys"
+	jsonRPCIPCDisableFlag        = "json-rpc-ipc-disable"
+	jsonRPCIPCPathFlag           = "json-rpc-ipc-path"
+	jsonRPCIPCFileModeFlag       = "json-rpc-ipc-file-mode"
+	jsonRPCAccountsFlag          = "json-rpc-accounts"
+	jsonRPCKeystoreDirFlag       = "json-rpc-accounts-keystore"
+	jsonRPCAccountsPasswordFlag  = "json-rpc-accounts-password-file"
+	jsonRPCMaxUnlockFlag         = "json-rpc-accounts-max-unlock"
+	jsonRPCGraphQLFlag           = "json-rpc-graphql"
+	jsonRPCGraphiQLFlag          = "json-rpc-graphiql"
+	jsonRPCSimulateGasCapFlag    = "json-rpc-simulate-gas-cap"
+	jsonRPCSimulateTimeoutFlag   = "json-rpc-simulate-timeout"
+	jsonRPCCheckpointFinalFlag   = "json-rpc-checkpoint-finality"
+	jsonRPCResponseCacheFlag     = "json-rpc-response-cache-size"
+	jsonRPCSlowRequestFlag       = "json-rpc-slow-request-threshold"
 	maxSlotsFlag                 = "max-slots"
 	maxEnqueuedFlag              = "max-enqueued"
+	priceBumpFlag                = "price-bump"
+	journalDisableFlag           = "journal-disable"
+	journalPathFlag              = "journal-path"
+	rejournalIntervalFlag        = "rejournal-interval"
+	lifetimeFlag                 = "lifetime"
+	lifetimePromotedFlag         = "lifetime-promoted"
+	lifetimeLocalsFlag           = "lifetime-locals"
+	privateTxMaxBlocksFlag       = "private-tx-max-blocks"
+	privateTxPeersFlag           = "private-tx-peers"
 	blockGasTargetFlag           = "block-gas-target"
 	secretsConfigFlag            = "secrets-config"
 	restoreFlag                  = "restore"
@@ -39,6 +77,7 @@ const (
 	corsOriginFlag               = "access-control-allow-origins"
 	logFileLocationFlag          = "log-to"
 	relayerFlag                  = "relayer"
+	storeRevertReasonsFlag       = "store-revert-reasons"
 )
 
 // Flags that are deprecated, but need to be preserved for
@@ -57,6 +96,10 @@ var (
 			Telemetry: &config.Telemetry{},
 			Network:   &config.Network{},
 			TxPool:    &config.TxPool{},
+
+			JSONRPCPrivate:   &config.JSONRPCListener{},
+			JSONRPCRateLimit: &config.RateLimit{},
+			JSONRPCAccounts:  &config.JSONRPCAccounts{},
 		},
 	}
 )
@@ -76,6 +119,17 @@ type serverParams struct {
 	grpcAddress       *net.TCPAddr
 	jsonRPCAddress    *net.TCPAddr
 
+	jsonRPCJWTSecret        []byte
+	jsonRPCPrivateAddress   *net.TCPAddr
+	jsonRPCPrivateJWTSecret []byte
+	jsonRPCIPCPath          string
+	jsonRPCIPCFileMode      os.FileMode
+	jsonRPCKeystoreDir      string
+	jsonRPCAccountsPassword string
+
+	txPoolJournalPath string
+	privateTxPeers    []peer.ID
+
 	blockGasTarget uint64
 	devInterval    uint64
 	isDevMode      bool
@@ -137,6 +191,10 @@ func (p *serverParams) setRawGRPCAddress(grpcAddress string) {
 	p.rawConfig.GRPCAddr = grpcAddress
 }
 
+func (p *serverParams) isJSONRPCPrivateAddressSet() bool {
+	return p.rawConfig.JSONRPCPrivate != nil && p.rawConfig.JSONRPCPrivate.Addr != ""
+}
+
 func (p *serverParams) setRawJSONRPCAddress(jsonRPCAddress string) {
 	p.rawConfig.JSONRPCAddr = jsonRPCAddress
 }
@@ -153,6 +211,20 @@ func (p *serverParams) generateConfig() *server.Config {
 			AccessControlAllowOrigin: p.corsAllowedOrigins,
 			BatchLengthLimit:         p.rawConfig.JSONRPCBatchRequestLimit,
 			BlockRangeLimit:          p.rawConfig.JSONRPCBlockRangeLimit,
+			Namespaces:               p.rawConfig.JSONRPCNamespaces,
+			JWTSecret:                p.jsonRPCJWTSecret,
+			Private:                  p.generateJSONRPCPrivateConfig(),
+			RateLimit:                p.generateJSONRPCRateLimitConfig(),
+			IPCPath:                  p.jsonRPCIPCPath,
+			IPCFileMode:              p.jsonRPCIPCFileMode,
+			Accounts:                 p.generateJSONRPCAccountsConfig(),
+			GraphQL:                  p.rawConfig.JSONRPCGraphQL,
+			GraphiQL:                 p.rawConfig.JSONRPCGraphiQL,
+			SimulateGasCap:           p.rawConfig.JSONRPCSimulateGasCap,
+			SimulateTimeout:          time.Duration(p.rawConfig.JSONRPCSimulateTimeout) * time.Second,
+			CheckpointFinality:       p.rawConfig.JSONRPCCheckpointFinality,
+			ResponseCacheSize:        int(p.rawConfig.JSONRPCResponseCacheSize),
+			SlowRequestThreshold:     time.Duration(p.rawConfig.JSONRPCSlowRequestThreshold) * time.Millisecond,
 		},
 		GRPCAddr:   p.grpcAddress,
 		LibP2PAddr: p.libp2pAddress,
@@ -175,6 +247,14 @@ func (p *serverParams) generateConfig() *server.Config {
 		PriceLimit:         p.rawConfig.TxPool.PriceLimit,
 		MaxSlots:           p.rawConfig.TxPool.MaxSlots,
 		MaxAccountEnqueued: p.rawConfig.TxPool.MaxAccountEnqueued,
+		PriceBump:          p.rawConfig.TxPool.PriceBump,
+		JournalPath:        p.txPoolJournalPath,
+		RejournalInterval:  time.Duration(p.rawConfig.TxPool.RejournalInterval) * time.Second,
+		TxLifetime:         time.Duration(p.rawConfig.TxPool.Lifetime) * time.Second,
+		TxLifetimePromoted: p.rawConfig.TxPool.LifetimePromoted,
+		TxLifetimeLocals:   p.rawConfig.TxPool.LifetimeLocals,
+		PrivateTxMaxBlocks: p.rawConfig.TxPool.PrivateTxMaxBlocks,
+		PrivateTxPeers:     p.privateTxPeers,
 		SecretsManager:     p.secretsConfig,
 		RestoreFile:        p.getRestoreFilePath(),
 		BlockTime:          p.rawConfig.BlockTime,
@@ -182,5 +262,48 @@ func (p *serverParams) generateConfig() *server.Config {
 		JSONLogFormat:      p.rawConfig.JSONLogFormat,
 		LogFilePath:        p.logFileLocation,
 		Relayer:            p.relayer,
+		StoreRevertReasons: p.rawConfig.StoreRevertReasons,
+	}
+}
+
+func (p *serverParams) generateJSONRPCPrivateConfig() *server.JSONRPCListener {
+	if p.jsonRPCPrivateAddress == nil {
+		return nil
+	}
+
+	return &server.JSONRPCListener{
+		Addr:       p.jsonRPCPrivateAddress,
+		Namespaces: p.rawConfig.JSONRPCPrivate.Namespaces,
+		JWTSecret:  p.jsonRPCPrivateJWTSecret,
+	}
+}
+
+func (p *serverParams) generateJSONRPCAccountsConfig() *server.JSONRPCAccounts {
+	accounts := p.rawConfig.JSONRPCAccounts
+	if accounts == nil || accounts.Backend == "" {
+		return nil
+	}
+
+	return &server.JSONRPCAccounts{
+		Backend:           server.AccountsBackend(accounts.Backend),
+		KeystoreDir:       p.jsonRPCKeystoreDir,
+		Password:          p.jsonRPCAccountsPassword,
+		MaxUnlockDuration: time.Duration(accounts.MaxUnlockDuration) * time.Second,
+	}
+}
+
+func (p *serverParams) generateJSONRPCRateLimitConfig() *jsonrpc.RateLimitConfig {
+	rateLimit := p.rawConfig.JSONRPCRateLimit
+	if rateLimit == nil {
+		return nil
+	}
+
+	return &jsonrpc.RateLimitConfig{
+		Rate:              rateLimit.Rate,
+		Burst:             rateLimit.Burst,
+		MethodCosts:       rateLimit.MethodCosts,
+		MethodConcurrency: rateLimit.MethodConcurrency,
+		ClientKeyHeader:   rateLimit.ClientKeyHeader,
+		ClientKeys:        rateLimit.ClientKeys,
 	}
 }
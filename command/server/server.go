@@ -185,6 +185,72 @@ func setFlags(cmd *cobra.Command) {
 		"maximum number of enqueued transactions per account",
 	)
 
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.TxPool.PriceBump,
+		priceBumpFlag,
+		defaultConfig.TxPool.PriceBump,
+		"minimum gas price increase (%) required to replace a pending transaction with the same nonce",
+	)
+
+	cmd.Flags().BoolVar(
+		&params.rawConfig.TxPool.JournalDisable,
+		journalDisableFlag,
+		defaultConfig.TxPool.JournalDisable,
+		"disable the journal persisting the local transactions across restarts",
+	)
+
+	cmd.Flags().StringVar(
+		&params.rawConfig.TxPool.JournalPath,
+		journalPathFlag,
+		defaultConfig.TxPool.JournalPath,
+		fmt.Sprintf(
+			"the path of the local transactions journal (default <data-dir>/%s)",
+			config.DefaultTxPoolJournalFileName,
+		),
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.TxPool.RejournalInterval,
+		rejournalIntervalFlag,
+		defaultConfig.TxPool.RejournalInterval,
+		"the interval (in seconds) of the local transactions journal regeneration",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.TxPool.Lifetime,
+		lifetimeFlag,
+		defaultConfig.TxPool.Lifetime,
+		"the max time (in seconds) the enqueued transactions stay in the pool for, 0 disables the expiry",
+	)
+
+	cmd.Flags().BoolVar(
+		&params.rawConfig.TxPool.LifetimePromoted,
+		lifetimePromotedFlag,
+		defaultConfig.TxPool.LifetimePromoted,
+		"apply the transaction lifetime to the promoted transactions as well",
+	)
+
+	cmd.Flags().BoolVar(
+		&params.rawConfig.TxPool.LifetimeLocals,
+		lifetimeLocalsFlag,
+		defaultConfig.TxPool.LifetimeLocals,
+		"apply the transaction lifetime to the local transactions as well",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.TxPool.PrivateTxMaxBlocks,
+		privateTxMaxBlocksFlag,
+		defaultConfig.TxPool.PrivateTxMaxBlocks,
+		"the number of blocks a private transaction without a max block can be included in",
+	)
+
+	cmd.Flags().StringSliceVar(
+		&params.rawConfig.TxPool.PrivateTxPeers,
+		privateTxPeersFlag,
+		defaultConfig.TxPool.PrivateTxPeers,
+		"the IDs of the trusted validator peers the private transactions are forwarded to and accepted from",
+	)
+
 	cmd.Flags().Uint64Var(
 		&params.rawConfig.BlockTime,
 		blockTimeFlag,
@@ -214,6 +280,199 @@ func setFlags(cmd *cobra.Command) {
 			"that consider fromBlock/toBlock values (e.g. eth_getLogs), value of 0 disables it",
 	)
 
+	cmd.Flags().StringSliceVar(
+		&params.rawConfig.JSONRPCNamespaces,
+		jsonRPCNamespacesFlag,
+		defaultConfig.JSONRPCNamespaces,
+		"the JSON-RPC namespaces exposed on the public listener",
+	)
+
+	cmd.Flags().StringVar(
+		&params.rawConfig.JSONRPCJWTSecretPath,
+		jsonRPCJWTSecretFlag,
+		"",
+		"path to the hex encoded 32 bytes secret used to authenticate requests to the public JSON-RPC listener "+
+			"with HS256 signed JWTs (authentication is disabled if not set)",
+	)
+
+	cmd.Flags().StringVar(
+		&params.rawConfig.JSONRPCPrivate.Addr,
+		jsonRPCPrivateAddrFlag,
+		"",
+		"the address and port for the private JSON-RPC listener (disabled if not set)",
+	)
+
+	cmd.Flags().StringSliceVar(
+		&params.rawConfig.JSONRPCPrivate.Namespaces,
+		jsonRPCPrivateNamespacesFlag,
+		defaultConfig.JSONRPCPrivate.Namespaces,
+		"the JSON-RPC namespaces exposed on the private listener, admin is added to the defaults "+
+			"if the listener requires jwt authentication (the admin and personal namespaces require it)",
+	)
+
+	cmd.Flags().StringVar(
+		&params.rawConfig.JSONRPCPrivate.JWTSecretPath,
+		jsonRPCPrivateJWTSecretFlag,
+		"",
+		"path to the hex encoded 32 bytes secret used to authenticate requests to the private JSON-RPC listener",
+	)
+
+	cmd.Flags().Float64Var(
+		&params.rawConfig.JSONRPCRateLimit.Rate,
+		jsonRPCRateLimitFlag,
+		0,
+		"the amount of request cost units refilled per second for every JSON-RPC client, value of 0 disables it",
+	)
+
+	cmd.Flags().IntVar(
+		&params.rawConfig.JSONRPCRateLimit.Burst,
+		jsonRPCRateLimitBurstFlag,
+		defaultConfig.JSONRPCRateLimit.Burst,
+		"the max amount of request cost units a JSON-RPC client can spend at once",
+	)
+
+	cmd.Flags().StringToIntVar(
+		&params.rawConfig.JSONRPCRateLimit.MethodCosts,
+		jsonRPCMethodCostFlag,
+		nil,
+		"the request cost units of the JSON-RPC methods (e.g. eth_getLogs=10), overriding the default ones",
+	)
+
+	cmd.Flags().StringToIntVar(
+		&params.rawConfig.JSONRPCRateLimit.MethodConcurrency,
+		jsonRPCMethodConcurrencyFlag,
+		nil,
+		"the max number of concurrent executions of the JSON-RPC methods (e.g. debug_traceBlock=4)",
+	)
+
+	cmd.Flags().StringVar(
+		&params.rawConfig.JSONRPCRateLimit.ClientKeyHeader,
+		jsonRPCClientKeyHeaderFlag,
+		"",
+		"the HTTP header carrying the API key used to identify the JSON-RPC clients instead of their IP",
+	)
+
+	cmd.Flags().StringSliceVar(
+		&params.rawConfig.JSONRPCRateLimit.ClientKeys,
+		jsonRPCClientKeysFlag,
+		nil,
+		"the API keys accepted in the client key header, the clients sending the other keys are identified by their IP",
+	)
+
+	cmd.Flags().BoolVar(
+		&params.rawConfig.JSONRPCIPCDisable,
+		jsonRPCIPCDisableFlag,
+		false,
+		"disable the JSON-RPC IPC listener",
+	)
+
+	cmd.Flags().StringVar(
+		&params.rawConfig.JSONRPCIPCPath,
+		jsonRPCIPCPathFlag,
+		"",
+		fmt.Sprintf(
+			"the path of the JSON-RPC IPC socket (default <data-dir>/%s)",
+			config.DefaultJSONRPCIPCFileName,
+		),
+	)
+
+	cmd.Flags().StringVar(
+		&params.rawConfig.JSONRPCIPCFileMode,
+		jsonRPCIPCFileModeFlag,
+		defaultConfig.JSONRPCIPCFileMode,
+		"the octal access permission of the JSON-RPC IPC socket file",
+	)
+
+	cmd.Flags().StringVar(
+		&params.rawConfig.JSONRPCAccounts.Backend,
+		jsonRPCAccountsFlag,
+		"",
+		fmt.Sprintf(
+			"enable the node-managed accounts and the personal JSON-RPC namespace, backed by "+
+				"the encrypted key files (%s) or the validator key of the secrets manager (%s)",
+			server.KeystoreAccountsBackend,
+			server.SecretsAccountsBackend,
+		),
+	)
+
+	cmd.Flags().StringVar(
+		&params.rawConfig.JSONRPCAccounts.KeystoreDir,
+		jsonRPCKeystoreDirFlag,
+		"",
+		fmt.Sprintf(
+			"the directory of the encrypted account key files (default <data-dir>/%s)",
+			config.DefaultJSONRPCKeystoreDir,
+		),
+	)
+
+	cmd.Flags().StringVar(
+		&params.rawConfig.JSONRPCAccounts.PasswordFile,
+		jsonRPCAccountsPasswordFlag,
+		"",
+		fmt.Sprintf(
+			"the file holding the password which unlocks the validator key account (required by the %s backend)",
+			server.SecretsAccountsBackend,
+		),
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.JSONRPCAccounts.MaxUnlockDuration,
+		jsonRPCMaxUnlockFlag,
+		defaultConfig.JSONRPCAccounts.MaxUnlockDuration,
+		"the max time in seconds a node-managed account can stay unlocked",
+	)
+
+	cmd.Flags().BoolVar(
+		&params.rawConfig.JSONRPCGraphQL,
+		jsonRPCGraphQLFlag,
+		false,
+		"serve the EIP-1767 GraphQL queries at /graphql on the JSON-RPC listeners exposing the eth namespace",
+	)
+
+	cmd.Flags().BoolVar(
+		&params.rawConfig.JSONRPCGraphiQL,
+		jsonRPCGraphiQLFlag,
+		false,
+		"serve the GraphiQL page at /graphql/ui, along with the GraphQL endpoint",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.JSONRPCSimulateGasCap,
+		jsonRPCSimulateGasCapFlag,
+		defaultConfig.JSONRPCSimulateGasCap,
+		"the gas budget of all the calls of a single eth_simulateV1 request, value of 0 disables it",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.JSONRPCSimulateTimeout,
+		jsonRPCSimulateTimeoutFlag,
+		defaultConfig.JSONRPCSimulateTimeout,
+		"the time budget in seconds of a single eth_simulateV1 request, value of 0 disables it",
+	)
+
+	cmd.Flags().BoolVar(
+		&params.rawConfig.JSONRPCCheckpointFinality,
+		jsonRPCCheckpointFinalFlag,
+		defaultConfig.JSONRPCCheckpointFinality,
+		"resolve the finalized block tag to the latest block checkpointed to the rootchain "+
+			"(PolyBFT with the bridge enabled only), "+
+			"instead of the latest block",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.JSONRPCResponseCacheSize,
+		jsonRPCResponseCacheFlag,
+		defaultConfig.JSONRPCResponseCacheSize,
+		"the number of the cached responses of the historical queries for the finalized blocks, value of 0 disables it",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.JSONRPCSlowRequestThreshold,
+		jsonRPCSlowRequestFlag,
+		defaultConfig.JSONRPCSlowRequestThreshold,
+		"log the requests taking longer than the given number of milliseconds, value of 0 disables it",
+	)
+
 	cmd.Flags().StringVar(
 		&params.rawConfig.LogFilePath,
 		logFileLocationFlag,
@@ -228,6 +487,13 @@ func setFlags(cmd *cobra.Command) {
 		"start the state sync relayer service (PolyBFT only)",
 	)
 
+	cmd.Flags().BoolVar(
+		&params.rawConfig.StoreRevertReasons,
+		storeRevertReasonsFlag,
+		defaultConfig.StoreRevertReasons,
+		"store the revert reasons of the reverted transactions in their receipts",
+	)
+
 	setLegacyFlags(cmd)
 
 	setDevFlags(cmd)
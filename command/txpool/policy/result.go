@@ -0,0 +1,65 @@
+package policy
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	txpoolOp "github.com/0xPolygon/polygon-edge/txpool/proto"
+)
+
+type TxPoolPolicyResult struct {
+	SenderAllowList            []string `json:"senderAllowList"`
+	SenderDenyList             []string `json:"senderDenyList"`
+	RecipientAllowList         []string `json:"recipientAllowList"`
+	MethodDenyList             []string `json:"methodDenyList"`
+	Contract                   string   `json:"contract,omitempty"`
+	SenderAllowListEnforced    bool     `json:"senderAllowListEnforced"`
+	RecipientAllowListEnforced bool     `json:"recipientAllowListEnforced"`
+}
+
+func newTxPoolPolicyResult(resp *txpoolOp.TxPolicyResp) *TxPoolPolicyResult {
+	return &TxPoolPolicyResult{
+		SenderAllowList:            resp.SenderAllowList,
+		SenderDenyList:             resp.SenderDenyList,
+		RecipientAllowList:         resp.RecipientAllowList,
+		MethodDenyList:             resp.MethodDenyList,
+		Contract:                   resp.Contract,
+		SenderAllowListEnforced:    resp.SenderAllowListEnforced,
+		RecipientAllowListEnforced: resp.RecipientAllowListEnforced,
+	}
+}
+
+func (r *TxPoolPolicyResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	contract := r.Contract
+	if contract == "" {
+		contract = "none"
+	}
+
+	buffer.WriteString("\n[TXPOOL POLICY]\n")
+	buffer.WriteString(helper.FormatKV([]string{
+		fmt.Sprintf("Policy contract|%s", contract),
+		fmt.Sprintf("Sender allowlist enforced|%t", r.SenderAllowListEnforced),
+		fmt.Sprintf("Recipient allowlist enforced|%t", r.RecipientAllowListEnforced),
+	}))
+	buffer.WriteString("\n")
+
+	writeList(&buffer, "SENDER ALLOWLIST", r.SenderAllowList)
+	writeList(&buffer, "SENDER DENYLIST", r.SenderDenyList)
+	writeList(&buffer, "RECIPIENT ALLOWLIST", r.RecipientAllowList)
+	writeList(&buffer, "METHOD DENYLIST", r.MethodDenyList)
+
+	return buffer.String()
+}
+
+func writeList(buffer *bytes.Buffer, title string, entries []string) {
+	if len(entries) == 0 {
+		return
+	}
+
+	buffer.WriteString(fmt.Sprintf("\n[%s]\n", title))
+	buffer.WriteString(helper.FormatList(entries))
+	buffer.WriteString("\n")
+}
@@ -0,0 +1,45 @@
+package policy
+
+import (
+	"context"
+
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/spf13/cobra"
+
+	txpoolOp "github.com/0xPolygon/polygon-edge/txpool/proto"
+	empty "google.golang.org/protobuf/types/known/emptypb"
+)
+
+func GetCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "policy",
+		Short: "Returns the transaction policy in effect on the chain",
+		Run:   runCommand,
+	}
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	policyResponse, err := getTxPoolPolicy(helper.GetGRPCAddress(cmd))
+	if err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(newTxPoolPolicyResult(policyResponse))
+}
+
+func getTxPoolPolicy(grpcAddress string) (*txpoolOp.TxPolicyResp, error) {
+	client, err := helper.GetTxPoolClientConnection(
+		grpcAddress,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.GetPolicy(context.Background(), &empty.Empty{})
+}
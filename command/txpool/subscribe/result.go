@@ -11,16 +11,23 @@ import (
 type TxPoolEventResult struct {
 	EventType txpoolProto.EventType `json:"event_type"`
 	TxHash    string                `json:"tx_hash"`
+	Reason    string                `json:"reason,omitempty"`
 }
 
 func (r *TxPoolEventResult) GetOutput() string {
 	var buffer bytes.Buffer
 
 	buffer.WriteString("\n[TXPOOL EVENT]\n")
-	buffer.WriteString(helper.FormatKV([]string{
+	vals := []string{
 		fmt.Sprintf("TYPE|%s", r.EventType),
 		fmt.Sprintf("HASH|%s", r.TxHash),
-	}))
+	}
+
+	if r.Reason != "" {
+		vals = append(vals, fmt.Sprintf("REASON|%s", r.Reason))
+	}
+
+	buffer.WriteString(helper.FormatKV(vals))
 	buffer.WriteString("\n")
 
 	return buffer.String()
@@ -85,4 +85,7 @@ type BridgeDataProvider interface {
 
 	// GetStateSyncProof retrieves the StateSync proof
 	GetStateSyncProof(stateSyncID uint64) (types.Proof, error)
+
+	// GetLatestCheckpointBlock returns the latest block checkpointed to the rootchain
+	GetLatestCheckpointBlock() (uint64, error)
 }
@@ -0,0 +1,266 @@
+package dev
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+var (
+	ErrInvalidTimestamp = errors.New("timestamp is not after the head block")
+	ErrNotImpersonated  = errors.New("account is not impersonated")
+)
+
+// snapshot is the saved head of the chain, the chain and the state are reverted to
+type snapshot struct {
+	number     uint64
+	timeOffset uint64
+}
+
+// isAutomine checks if the blocks are sealed automatically
+func (d *Dev) isAutomine() bool {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	return d.automine
+}
+
+// SetAutomine enables or disables sealing the blocks on the interval and on every control change
+func (d *Dev) SetAutomine(enabled bool) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	d.automine = enabled
+}
+
+// Mine seals a new block on top of the head, with the given timestamp if not 0
+func (d *Dev) Mine(timestamp uint64) error {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	parent := d.blockchain.Header()
+
+	if timestamp != 0 {
+		if timestamp <= parent.Timestamp {
+			return fmt.Errorf("%w: %d", ErrInvalidTimestamp, timestamp)
+		}
+
+		d.nextTimestamp = timestamp
+	}
+
+	return d.writeNewBlock(parent)
+}
+
+// mineIfAutomine seals a new block with the pending changes, if the blocks are sealed automatically.
+// The lock must be held by the caller
+func (d *Dev) mineIfAutomine() error {
+	if !d.automine {
+		return nil
+	}
+
+	return d.writeNewBlock(d.blockchain.Header())
+}
+
+// nextBlockTimestamp returns the timestamp of the new block, which is the one set with SetNextBlockTimestamp,
+// or the wall clock time moved forward by the time offset. The lock must be held by the caller
+func (d *Dev) nextBlockTimestamp() uint64 {
+	now := uint64(time.Now().Unix())
+
+	if d.nextTimestamp == 0 {
+		return now + d.timeOffset
+	}
+
+	timestamp := d.nextTimestamp
+	d.nextTimestamp = 0
+
+	// the time of the later blocks continues from the set timestamp
+	if timestamp > now {
+		d.timeOffset = timestamp - now
+	}
+
+	return timestamp
+}
+
+// SetNextBlockTimestamp sets the timestamp of the next block, the time of the later blocks continues from it
+func (d *Dev) SetNextBlockTimestamp(timestamp uint64) error {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	if timestamp <= d.blockchain.Header().Timestamp {
+		return fmt.Errorf("%w: %d", ErrInvalidTimestamp, timestamp)
+	}
+
+	d.nextTimestamp = timestamp
+
+	return nil
+}
+
+// IncreaseTime moves the time of the next blocks forward, and returns the total time offset in seconds
+func (d *Dev) IncreaseTime(seconds uint64) uint64 {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	d.timeOffset += seconds
+
+	return d.timeOffset
+}
+
+// Snapshot saves the current head of the chain, and returns the id of the snapshot
+func (d *Dev) Snapshot() uint64 {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	d.nextSnapshotID++
+
+	d.snapshots[d.nextSnapshotID] = &snapshot{
+		number:     d.blockchain.Header().Number,
+		timeOffset: d.timeOffset,
+	}
+
+	return d.nextSnapshotID
+}
+
+// Revert rewinds the chain and the state to the snapshot, the snapshot and the later ones are discarded.
+// The pending changes and the pool transactions are dropped. Returns false if the snapshot is unknown
+func (d *Dev) Revert(id uint64) (bool, error) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	snap, ok := d.snapshots[id]
+	if !ok {
+		return false, nil
+	}
+
+	if err := d.blockchain.RewindHead(snap.number); err != nil {
+		return false, err
+	}
+
+	for snapID := range d.snapshots {
+		if snapID >= id {
+			delete(d.snapshots, snapID)
+		}
+	}
+
+	d.timeOffset = snap.timeOffset
+	d.nextTimestamp = 0
+	d.stateOverrides = nil
+	d.impersonatedTxs = nil
+
+	d.txpool.Rewind()
+
+	d.logger.Info("reverted to snapshot", "id", id, "number", snap.number)
+
+	return true, nil
+}
+
+// overrideState applies the override to the next block, sealing it right away if automine is enabled
+func (d *Dev) overrideState(override *stateOverride) error {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	d.stateOverrides = append(d.stateOverrides, override)
+
+	if err := d.mineIfAutomine(); err != nil {
+		// don't let the failed override break the next blocks
+		d.stateOverrides = d.stateOverrides[:len(d.stateOverrides)-1]
+
+		return err
+	}
+
+	return nil
+}
+
+// SetBalance sets the balance of the account
+func (d *Dev) SetBalance(addr types.Address, balance *big.Int) error {
+	return d.overrideState(&stateOverride{Kind: balanceOverride, Address: addr, Balance: balance})
+}
+
+// SetCode sets the code of the account
+func (d *Dev) SetCode(addr types.Address, code []byte) error {
+	return d.overrideState(&stateOverride{Kind: codeOverride, Address: addr, Code: code})
+}
+
+// SetStorageAt sets the value of the account's storage slot
+func (d *Dev) SetStorageAt(addr types.Address, key, value types.Hash) error {
+	return d.overrideState(&stateOverride{Kind: storageOverride, Address: addr, Key: key, Value: value})
+}
+
+// Impersonate lets the account send the transactions without signing them
+func (d *Dev) Impersonate(addr types.Address) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	d.impersonated[addr] = struct{}{}
+}
+
+// StopImpersonating stops impersonating the account
+func (d *Dev) StopImpersonating(addr types.Address) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	delete(d.impersonated, addr)
+}
+
+// IsImpersonated checks if the account is impersonated
+func (d *Dev) IsImpersonated(addr types.Address) bool {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	_, ok := d.impersonated[addr]
+
+	return ok
+}
+
+// SendImpersonatedTx adds the unsigned transaction of the impersonated account to the next block,
+// sealing it right away if automine is enabled. The transaction carries the sender in its R value,
+// so the same transactions of the different senders don't share the hash, and the dev chain signer
+// (see NewTxSigner) recovers the sender once the block is read back or replayed.
+// The chain can only be replayed by the nodes running the dev consensus
+func (d *Dev) SendImpersonatedTx(tx *types.Transaction) (types.Hash, error) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	if _, ok := d.impersonated[tx.From]; !ok {
+		return types.ZeroHash, fmt.Errorf("%w: %s", ErrNotImpersonated, tx.From)
+	}
+
+	markImpersonatedTx(tx)
+	tx.ComputeHash()
+
+	d.impersonatedTxs = append(d.impersonatedTxs, tx)
+
+	if err := d.mineIfAutomine(); err != nil {
+		d.impersonatedTxs = d.impersonatedTxs[:len(d.impersonatedTxs)-1]
+
+		return types.ZeroHash, err
+	}
+
+	return tx.Hash, nil
+}
+
+// writeImpersonatedTxs writes the pending unsigned transactions of the impersonated accounts,
+// the ones which fail are left out of the block
+func (d *Dev) writeImpersonatedTxs(gasLimit uint64, transition transitionInterface) []*types.Transaction {
+	successful := make([]*types.Transaction, 0, len(d.impersonatedTxs))
+
+	for _, tx := range d.impersonatedTxs {
+		if tx.ExceedsBlockGasLimit(gasLimit) {
+			d.logger.Warn("impersonated tx exceeds the block gas limit", "hash", tx.Hash)
+
+			continue
+		}
+
+		if err := transition.Write(tx); err != nil {
+			d.logger.Warn("failed to write impersonated tx", "hash", tx.Hash, "err", err)
+
+			continue
+		}
+
+		successful = append(successful, tx)
+	}
+
+	return successful
+}
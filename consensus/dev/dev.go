@@ -2,6 +2,7 @@ package dev
 
 import (
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/0xPolygon/polygon-edge/blockchain"
@@ -29,6 +30,32 @@ type Dev struct {
 
 	blockchain *blockchain.Blockchain
 	executor   *state.Executor
+
+	// lock serializes writing the blocks and the test chain controls
+	lock sync.Mutex
+
+	// automine seals the blocks on the interval and on every control change, if set.
+	// Otherwise the blocks are sealed only on evm_mine
+	automine bool
+
+	// timeOffset is added to the wall clock time of the new blocks
+	timeOffset uint64
+
+	// nextTimestamp is the timestamp of the next block only, ignored if 0
+	nextTimestamp uint64
+
+	// snapshots are the saved chain heads, by the snapshot id
+	snapshots      map[uint64]*snapshot
+	nextSnapshotID uint64
+
+	// stateOverrides are applied to the state of the next block, after its transactions
+	stateOverrides stateOverrides
+
+	// impersonated are the accounts which send the transactions without signing them
+	impersonated map[types.Address]struct{}
+
+	// impersonatedTxs are the unsigned transactions of the impersonated accounts, written to the next block
+	impersonatedTxs []*types.Transaction
 }
 
 // Factory implements the base factory method
@@ -44,6 +71,10 @@ func Factory(
 		blockchain: params.Blockchain,
 		executor:   params.Executor,
 		txpool:     params.TxPool,
+		automine:   true,
+		snapshots:  make(map[uint64]*snapshot),
+
+		impersonated: make(map[types.Address]struct{}),
 	}
 
 	rawInterval, ok := params.Config.Config["interval"]
@@ -97,9 +128,12 @@ func (d *Dev) run() {
 			return
 		}
 
+		if !d.isAutomine() {
+			continue
+		}
+
 		// There are new transactions in the pool, try to seal them
-		header := d.blockchain.Header()
-		if err := d.writeNewBlock(header); err != nil {
+		if err := d.Mine(0); err != nil {
 			d.logger.Error("failed to mine block", "err", err)
 		}
 	}
@@ -150,7 +184,7 @@ func (d *Dev) writeTransactions(gasLimit uint64, transition transitionInterface)
 }
 
 // writeNewBLock generates a new block based on transactions from the pool,
-// and writes them to the blockchain
+// and writes them to the blockchain. The lock must be held by the caller
 func (d *Dev) writeNewBlock(parent *types.Header) error {
 	// Generate the base block
 	num := parent.Number
@@ -158,7 +192,7 @@ func (d *Dev) writeNewBlock(parent *types.Header) error {
 		ParentHash: parent.Hash,
 		Number:     num + 1,
 		GasLimit:   parent.GasLimit, // Inherit from parent for now, will need to adjust dynamically later.
-		Timestamp:  uint64(time.Now().Unix()),
+		Timestamp:  d.nextBlockTimestamp(),
 	}
 
 	// calculate gas limit based on parent header
@@ -180,7 +214,16 @@ func (d *Dev) writeNewBlock(parent *types.Header) error {
 		return err
 	}
 
-	txns := d.writeTransactions(gasLimit, transition)
+	txns := append(d.writeImpersonatedTxs(gasLimit, transition), d.writeTransactions(gasLimit, transition)...)
+
+	// the overrides are recorded in the block, so the block is replayed with them
+	if len(d.stateOverrides) != 0 {
+		header.ExtraData = d.stateOverrides.MarshalRLPTo(nil)
+	}
+
+	if err := d.PreCommitState(header, transition); err != nil {
+		return err
+	}
 
 	// Commit the changes
 	_, root := transition.Commit()
@@ -210,6 +253,9 @@ func (d *Dev) writeNewBlock(parent *types.Header) error {
 	// the old transactions are removed
 	d.txpool.ResetWithHeaders(block.Header)
 
+	d.stateOverrides = nil
+	d.impersonatedTxs = nil
+
 	return nil
 }
 
@@ -228,8 +274,25 @@ func (d *Dev) GetBlockCreator(header *types.Header) (types.Address, error) {
 	return types.BytesToAddress(header.Miner), nil
 }
 
-// PreCommitState a hook to be called before finalizing state transition on inserting block
-func (d *Dev) PreCommitState(_header *types.Header, _txn *state.Transition) error {
+// PreCommitState a hook to be called before finalizing state transition on inserting block.
+// Applies the state overrides recorded in the extra data of the block, both to the block being written
+// and to the blocks replayed once the node restarts or syncs the chain
+func (d *Dev) PreCommitState(header *types.Header, txn *state.Transition) error {
+	if len(header.ExtraData) == 0 {
+		return nil
+	}
+
+	var overrides stateOverrides
+	if err := overrides.UnmarshalRLP(header.ExtraData); err != nil {
+		return fmt.Errorf("invalid state overrides of block %d: %w", header.Number, err)
+	}
+
+	for _, override := range overrides {
+		if err := override.apply(txn); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
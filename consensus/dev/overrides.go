@@ -0,0 +1,159 @@
+package dev
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/state"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/umbracle/fastrlp"
+)
+
+var ErrInvalidStateOverride = errors.New("invalid state override")
+
+// stateOverrideKind is the kind of the state change made by the override
+type stateOverrideKind uint64
+
+const (
+	balanceOverride stateOverrideKind = iota
+	codeOverride
+	storageOverride
+)
+
+// stateOverride changes the state of the block directly, without a transaction.
+// The overrides of the block are recorded in its extra data, so the block can be replayed
+// by the nodes running the dev consensus, once they restart or sync the chain
+type stateOverride struct {
+	Kind    stateOverrideKind
+	Address types.Address
+	Balance *big.Int
+	Code    []byte
+	Key     types.Hash
+	Value   types.Hash
+}
+
+// apply applies the override to the state of the block, creating the account if it doesn't exist yet
+func (o *stateOverride) apply(transition *state.Transition) error {
+	if !transition.AccountExists(o.Address) {
+		if err := transition.SetAccountDirectly(o.Address, &chain.GenesisAccount{Balance: big.NewInt(0)}); err != nil {
+			return err
+		}
+	}
+
+	switch o.Kind {
+	case balanceOverride:
+		transition.Txn().SetBalance(o.Address, o.Balance)
+	case codeOverride:
+		return transition.SetCodeDirectly(o.Address, o.Code)
+	case storageOverride:
+		transition.Txn().SetState(o.Address, o.Key, o.Value)
+	default:
+		return fmt.Errorf("%w: unknown kind %d", ErrInvalidStateOverride, o.Kind)
+	}
+
+	return nil
+}
+
+// MarshalRLPWith defines the marshal function implementation for stateOverride
+func (o *stateOverride) MarshalRLPWith(ar *fastrlp.Arena) *fastrlp.Value {
+	vv := ar.NewArray()
+
+	vv.Set(ar.NewUint(uint64(o.Kind)))
+	vv.Set(ar.NewBytes(o.Address.Bytes()))
+
+	if o.Balance == nil {
+		vv.Set(ar.NewNull())
+	} else {
+		vv.Set(ar.NewBigInt(o.Balance))
+	}
+
+	vv.Set(ar.NewCopyBytes(o.Code))
+	vv.Set(ar.NewBytes(o.Key.Bytes()))
+	vv.Set(ar.NewBytes(o.Value.Bytes()))
+
+	return vv
+}
+
+// UnmarshalRLPWith defines the unmarshal implementation for stateOverride
+func (o *stateOverride) UnmarshalRLPWith(v *fastrlp.Value) error {
+	const expectedElements = 6
+
+	elems, err := v.GetElems()
+	if err != nil {
+		return err
+	}
+
+	if num := len(elems); num != expectedElements {
+		return fmt.Errorf("incorrect elements count to decode state override, expected %d but found %d",
+			expectedElements, num)
+	}
+
+	kind, err := elems[0].GetUint64()
+	if err != nil {
+		return err
+	}
+
+	o.Kind = stateOverrideKind(kind)
+
+	if err := elems[1].GetAddr(o.Address[:]); err != nil {
+		return err
+	}
+
+	o.Balance = new(big.Int)
+	if err := elems[2].GetBigInt(o.Balance); err != nil {
+		return err
+	}
+
+	if o.Code, err = elems[3].GetBytes(o.Code[:0]); err != nil {
+		return err
+	}
+
+	if err := elems[4].GetHash(o.Key[:]); err != nil {
+		return err
+	}
+
+	return elems[5].GetHash(o.Value[:])
+}
+
+// stateOverrides are the overrides of the block, in the order they are applied
+type stateOverrides []*stateOverride
+
+// MarshalRLPTo defines the marshal function wrapper for stateOverrides
+func (s stateOverrides) MarshalRLPTo(dst []byte) []byte {
+	ar := &fastrlp.Arena{}
+	vv := ar.NewArray()
+
+	for _, override := range s {
+		vv.Set(override.MarshalRLPWith(ar))
+	}
+
+	return vv.MarshalTo(dst)
+}
+
+// UnmarshalRLP defines the unmarshal function wrapper for stateOverrides
+func (s *stateOverrides) UnmarshalRLP(input []byte) error {
+	return fastrlp.UnmarshalRLP(input, s)
+}
+
+// UnmarshalRLPWith defines the unmarshal implementation for stateOverrides
+func (s *stateOverrides) UnmarshalRLPWith(v *fastrlp.Value) error {
+	elems, err := v.GetElems()
+	if err != nil {
+		return err
+	}
+
+	overrides := make(stateOverrides, len(elems))
+
+	for i, elem := range elems {
+		overrides[i] = &stateOverride{}
+		if err := overrides[i].UnmarshalRLPWith(elem); err != nil {
+			return err
+		}
+	}
+
+	*s = overrides
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package dev
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/state"
+	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestTransition(t *testing.T) *state.Transition {
+	t.Helper()
+
+	ex := state.NewExecutor(&chain.Params{
+		Forks: chain.AllForksEnabled,
+	}, itrie.NewState(itrie.NewMemoryStorage()), hclog.NewNullLogger())
+
+	rootHash := ex.WriteGenesis(nil)
+	ex.GetHash = func(h *types.Header) state.GetHashByNumber {
+		return func(i uint64) types.Hash {
+			return rootHash
+		}
+	}
+
+	transition, err := ex.BeginTxn(rootHash, &types.Header{}, types.ZeroAddress)
+	require.NoError(t, err)
+
+	return transition
+}
+
+func TestDev_PreCommitState_ReplaysOverrides(t *testing.T) {
+	t.Parallel()
+
+	addr := types.StringToAddress("1")
+	key, value := types.StringToHash("2"), types.StringToHash("3")
+
+	overrides := stateOverrides{
+		{Kind: balanceOverride, Address: addr, Balance: big.NewInt(100)},
+		{Kind: codeOverride, Address: addr, Code: []byte{0x60, 0x00}},
+		{Kind: storageOverride, Address: addr, Key: key, Value: value},
+	}
+
+	header := &types.Header{Number: 1, ExtraData: overrides.MarshalRLPTo(nil)}
+
+	// the block is written and then replayed by another node, both get the same state
+	written, replayed := newTestTransition(t), newTestTransition(t)
+	require.NoError(t, (&Dev{stateOverrides: overrides}).PreCommitState(header, written))
+	require.NoError(t, (&Dev{}).PreCommitState(header, replayed))
+
+	assert.Equal(t, big.NewInt(100), replayed.GetBalance(addr))
+	assert.Equal(t, []byte{0x60, 0x00}, replayed.GetCode(addr))
+	assert.Equal(t, value, replayed.GetStorage(addr, key))
+
+	_, writtenRoot := written.Commit()
+	_, replayedRoot := replayed.Commit()
+	assert.Equal(t, writtenRoot, replayedRoot)
+
+	// the block without the overrides is left as it is
+	require.NoError(t, (&Dev{}).PreCommitState(&types.Header{Number: 2}, newTestTransition(t)))
+
+	// the corrupted overrides are not skipped
+	assert.Error(t, (&Dev{}).PreCommitState(&types.Header{Number: 3, ExtraData: []byte{0x01}}, newTestTransition(t)))
+}
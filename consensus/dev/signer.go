@@ -0,0 +1,45 @@
+package dev
+
+import (
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/blockchain"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// impersonatedTxSigner recovers the senders of the unsigned transactions of the impersonated accounts
+// from their R values, and the senders of the other transactions with the wrapped signer
+type impersonatedTxSigner struct {
+	blockchain.TxSigner
+}
+
+// NewTxSigner wraps the signer of the dev chain, so the unsigned transactions of the impersonated accounts
+// written to the blocks keep their senders once the blocks are read back or replayed
+func NewTxSigner(signer blockchain.TxSigner) blockchain.TxSigner {
+	return &impersonatedTxSigner{TxSigner: signer}
+}
+
+// Sender returns the sender of the transaction
+func (s *impersonatedTxSigner) Sender(tx *types.Transaction) (types.Address, error) {
+	if isImpersonatedTx(tx) {
+		return types.BytesToAddress(tx.R.Bytes()), nil
+	}
+
+	return s.TxSigner.Sender(tx)
+}
+
+// markImpersonatedTx stores the sender in the R value of the unsigned transaction, leaving V and S zero,
+// which no signed transaction has
+func markImpersonatedTx(tx *types.Transaction) {
+	tx.V = big.NewInt(0)
+	tx.R = new(big.Int).SetBytes(tx.From.Bytes())
+	tx.S = big.NewInt(0)
+}
+
+// isImpersonatedTx checks if the transaction is the unsigned transaction of an impersonated account
+func isImpersonatedTx(tx *types.Transaction) bool {
+	return tx.Type == types.LegacyTx &&
+		(tx.V == nil || tx.V.Sign() == 0) &&
+		(tx.S == nil || tx.S.Sign() == 0) &&
+		tx.R != nil && tx.R.Sign() > 0 && tx.R.BitLen() <= 8*types.AddressLength
+}
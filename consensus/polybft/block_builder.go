@@ -1,6 +1,7 @@
 package polybft
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/0xPolygon/polygon-edge/consensus"
@@ -12,6 +13,10 @@ import (
 
 // TODO: Add opentracing
 
+// maxBundlesGasShare is the share of the block gas limit (1/n) the bundles can use at most,
+// the rest of the block is left for the pool transactions
+const maxBundlesGasShare = 2
+
 // BlockBuilderParams are fields for the block that cannot be changed
 type BlockBuilderParams struct {
 	// Parent block
@@ -148,6 +153,10 @@ func (b *BlockBuilder) WriteTx(tx *types.Transaction) error {
 func (b *BlockBuilder) Fill() {
 	blockTimer := time.NewTimer(b.params.BlockTime)
 
+	if expired := b.writeBundles(blockTimer); expired {
+		return
+	}
+
 	b.params.TxPool.Prepare()
 write:
 	for {
@@ -169,10 +178,88 @@ write:
 		}
 	}
 
+	updateBlockBuilderMetrics(b.params.TxPool.OrderingPolicy(), b.txns)
+
 	//	wait for the timer to expire
 	<-blockTimer.C
 }
 
+// writeBundles includes the bundles targeting the block at the top of it. Each bundle is simulated
+// on a snapshot of the state, and is either included atomically if all its transactions succeed
+// (apart from the ones allowed to revert), or reverted to the snapshot and discarded.
+// The bundles which don't fit in the gas budget of the bundles are left for the next blocks of their range.
+// Returns true if the block timer expired.
+func (b *BlockBuilder) writeBundles(blockTimer *time.Timer) bool {
+	gasBudget := b.params.GasLimit / maxBundlesGasShare
+
+	for _, bundle := range b.params.TxPool.Bundles(b.header.Number) {
+		select {
+		case <-blockTimer.C:
+			return true
+		default:
+		}
+
+		if b.state.TotalGas()+bundleGas(bundle) > gasBudget {
+			continue
+		}
+
+		snapshot := b.state.Snapshot()
+
+		err := b.writeBundle(bundle)
+		if err == nil {
+			b.txns = append(b.txns, bundle.Txs...)
+
+			continue
+		}
+
+		b.state.RevertToSnapshot(snapshot)
+
+		b.params.Logger.Debug("Fill bundle error", "hash", bundle.Hash, "err", err)
+
+		// the block is full, so the bundle is left for the next blocks of its range
+		if _, ok := err.(*state.GasLimitReachedTransitionApplicationError); ok { //nolint:errorlint
+			continue
+		}
+
+		b.params.TxPool.DiscardBundle(bundle.Hash)
+	}
+
+	return false
+}
+
+// bundleGas returns the gas limit of all the bundle transactions
+func bundleGas(bundle *txpool.Bundle) uint64 {
+	gas := uint64(0)
+
+	for _, tx := range bundle.Txs {
+		gas += tx.Gas
+	}
+
+	return gas
+}
+
+// writeBundle applies the bundle transactions to the state, until any of them fails
+func (b *BlockBuilder) writeBundle(bundle *txpool.Bundle) error {
+	for _, tx := range bundle.Txs {
+		if tx.ExceedsBlockGasLimit(b.params.GasLimit) {
+			return txpool.ErrBlockLimitExceeded
+		}
+
+		if err := b.state.Write(tx); err != nil {
+			return err
+		}
+
+		receipts := b.state.Receipts()
+		reverted := *receipts[len(receipts)-1].Status == types.ReceiptFailed
+
+		if reverted && !bundle.CanRevert(tx.Hash) {
+			return fmt.Errorf("%w: %s", txpool.ErrBundleTxReverted, tx.Hash)
+		}
+	}
+
+	return nil
+}
+
 // Receipts returns the collection of transaction receipts for given block
 func (b *BlockBuilder) Receipts() []*types.Receipt {
 	return b.state.Receipts()
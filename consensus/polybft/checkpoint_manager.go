@@ -2,10 +2,13 @@ package polybft
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"math/big"
 	"sort"
 	"strconv"
+	"sync"
+	"time"
 
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/contractsapi"
 	bls "github.com/0xPolygon/polygon-edge/consensus/polybft/signer"
@@ -23,12 +26,17 @@ var (
 	currentCheckpointBlockNumMethod, _ = contractsapi.CheckpointManager.Abi.Methods["currentCheckpointBlockNumber"]
 	// frequency at which checkpoints are sent to the rootchain (in blocks count)
 	defaultCheckpointsOffset = uint64(900)
+	// latestCheckpointBlockTTL is the time the latest checkpoint block is served without querying the rootchain
+	latestCheckpointBlockTTL = 2 * time.Second
+
+	errCheckpointsDisabled = errors.New("checkpoints are not submitted, because the bridge is not enabled")
 )
 
 type CheckpointManager interface {
 	PostBlock(req *PostBlockRequest) error
 	BuildEventRoot(epoch uint64) (types.Hash, error)
 	GenerateExitProof(exitID, epoch, checkpointBlock uint64) (types.Proof, error)
+	GetLatestCheckpointBlock() (uint64, error)
 }
 
 var _ CheckpointManager = (*dummyCheckpointManager)(nil)
@@ -42,6 +50,9 @@ func (d *dummyCheckpointManager) BuildEventRoot(epoch uint64) (types.Hash, error
 func (d *dummyCheckpointManager) GenerateExitProof(exitID, epoch, checkpointBlock uint64) (types.Proof, error) {
 	return types.Proof{}, nil
 }
+func (d *dummyCheckpointManager) GetLatestCheckpointBlock() (uint64, error) {
+	return 0, errCheckpointsDisabled
+}
 
 var _ CheckpointManager = (*checkpointManager)(nil)
 
@@ -65,6 +76,10 @@ type checkpointManager struct {
 	logger hclog.Logger
 	// state boltDb instance
 	state *State
+	// latestCheckpointBlock is the latest checkpoint block fetched from the rootchain at latestCheckpointBlockTime
+	latestCheckpointBlock     uint64
+	latestCheckpointBlockTime time.Time
+	latestCheckpointBlockLock sync.Mutex
 }
 
 // newCheckpointManager creates a new instance of checkpointManager
@@ -108,6 +123,27 @@ func (c *checkpointManager) getLatestCheckpointBlock() (uint64, error) {
 	return latestCheckpointBlockNum, nil
 }
 
+// GetLatestCheckpointBlock returns the latest checkpoint block,
+// refreshing it from the rootchain once it's older than latestCheckpointBlockTTL
+func (c *checkpointManager) GetLatestCheckpointBlock() (uint64, error) {
+	c.latestCheckpointBlockLock.Lock()
+	defer c.latestCheckpointBlockLock.Unlock()
+
+	if time.Since(c.latestCheckpointBlockTime) < latestCheckpointBlockTTL {
+		return c.latestCheckpointBlock, nil
+	}
+
+	latestCheckpointBlock, err := c.getLatestCheckpointBlock()
+	if err != nil {
+		return 0, err
+	}
+
+	c.latestCheckpointBlock = latestCheckpointBlock
+	c.latestCheckpointBlockTime = time.Now()
+
+	return latestCheckpointBlock, nil
+}
+
 // submitCheckpoint sends a transaction with checkpoint data to the rootchain
 func (c *checkpointManager) submitCheckpoint(latestHeader *types.Header, isEndOfEpoch bool) error {
 	lastCheckpointBlockNumber, err := c.getLatestCheckpointBlock()
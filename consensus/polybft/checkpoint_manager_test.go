@@ -476,3 +476,30 @@ func createTestLogForExitEvent(t *testing.T, exitEventID uint64) *types.Log {
 		Data:    encodedData,
 	}
 }
+
+func TestCheckpointManager_GetLatestCheckpointBlock(t *testing.T) {
+	t.Parallel()
+
+	txRelayerMock := newDummyTxRelayer(t)
+	txRelayerMock.On("Call", mock.Anything, mock.Anything, mock.Anything).
+		Return("16", error(nil)).
+		Once()
+
+	checkpointMgr := &checkpointManager{
+		txRelayer: txRelayerMock,
+		key:       wallet.GenerateAccount().Ecdsa,
+		logger:    hclog.NewNullLogger(),
+	}
+
+	// the rootchain is queried only once, while the cached block is fresh
+	for i := 0; i < 3; i++ {
+		checkpointBlock, err := checkpointMgr.GetLatestCheckpointBlock()
+		require.NoError(t, err)
+		require.Equal(t, uint64(16), checkpointBlock)
+	}
+
+	txRelayerMock.AssertExpectations(t)
+
+	_, err := (&dummyCheckpointManager{}).GetLatestCheckpointBlock()
+	require.ErrorIs(t, err, errCheckpointsDisabled)
+}
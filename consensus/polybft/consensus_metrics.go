@@ -36,6 +36,22 @@ func updateBlockMetrics(currentBlock *types.Block, parentHeader *types.Header) e
 	return nil
 }
 
+// updateBlockBuilderMetrics updates the metrics of the transactions picked for the built block
+// (number of transactions and senders), labeled by the transaction ordering policy
+func updateBlockBuilderMetrics(orderingPolicy string, txs []*types.Transaction) {
+	senders := make(map[types.Address]struct{}, len(txs))
+	for _, tx := range txs {
+		senders[tx.From] = struct{}{}
+	}
+
+	labels := []metrics.Label{{Name: "ordering_policy", Value: orderingPolicy}}
+
+	metrics.SetGaugeWithLabels(
+		[]string{consensusMetricsPrefix, "block_builder", "num_txs"}, float32(len(txs)), labels)
+	metrics.SetGaugeWithLabels(
+		[]string{consensusMetricsPrefix, "block_builder", "num_senders"}, float32(len(senders)), labels)
+}
+
 // updateEpochMetrics updates epoch-related metrics
 // (e.g. epoch number, validator set length)
 func updateEpochMetrics(epoch epochMetadata) {
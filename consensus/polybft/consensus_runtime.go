@@ -11,6 +11,7 @@ import (
 
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/contractsapi"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/wallet"
+	"github.com/0xPolygon/polygon-edge/txpool"
 	"github.com/0xPolygon/polygon-edge/txrelayer"
 	"github.com/0xPolygon/polygon-edge/types"
 
@@ -30,6 +31,8 @@ var (
 	errNotAValidator = errors.New("node is not a validator")
 	// errQuorumNotReached represents "quorum not reached for commitment message" error message
 	errQuorumNotReached = errors.New("quorum not reached for commitment message")
+	// errEpochNotInitialized represents "epoch is not initialized" error message
+	errEpochNotInitialized = errors.New("epoch is not initialized")
 )
 
 // txPoolInterface is an abstraction of transaction pool
@@ -42,6 +45,9 @@ type txPoolInterface interface {
 	Demote(*types.Transaction)
 	SetSealing(bool)
 	ResetWithHeaders(...*types.Header)
+	OrderingPolicy() string
+	Bundles(uint64) []*txpool.Bundle
+	DiscardBundle(types.Hash)
 }
 
 // epochMetadata is the static info for epoch currently being processed
@@ -55,6 +61,20 @@ type epochMetadata struct {
 	Validators AccountSet
 }
 
+// EpochInfo is the public view of the epoch currently being processed
+type EpochInfo struct {
+	// Number is the number of the epoch
+	Number uint64
+	// FirstBlock is the number of the first block in the epoch
+	FirstBlock uint64
+	// EpochSize is the configured number of blocks in an epoch
+	EpochSize uint64
+	// SprintSize is the configured number of blocks in a sprint
+	SprintSize uint64
+	// LastBuiltBlock is the number of the last processed block
+	LastBuiltBlock uint64
+}
+
 type guardedDataDTO struct {
 	// last built block header at the time of collecting data
 	lastBuiltBlock *types.Header
@@ -537,11 +557,94 @@ func (c *consensusRuntime) GenerateExitProof(exitID, epoch, checkpointBlock uint
 	return c.checkpointManager.GenerateExitProof(exitID, epoch, checkpointBlock)
 }
 
+// GetLatestCheckpointBlock returns the latest block checkpointed to the rootchain
+func (c *consensusRuntime) GetLatestCheckpointBlock() (uint64, error) {
+	return c.checkpointManager.GetLatestCheckpointBlock()
+}
+
 // GetStateSyncProof returns the proof for the state sync
 func (c *consensusRuntime) GetStateSyncProof(stateSyncID uint64) (types.Proof, error) {
 	return c.stateSyncManager.GetStateSyncProof(stateSyncID)
 }
 
+// GetEpoch returns the info of the epoch currently being processed
+func (c *consensusRuntime) GetEpoch() (*EpochInfo, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	if c.epoch == nil || c.lastBuiltBlock == nil {
+		return nil, errEpochNotInitialized
+	}
+
+	return &EpochInfo{
+		Number:         c.epoch.Number,
+		FirstBlock:     c.epoch.FirstBlockInEpoch,
+		EpochSize:      c.config.PolyBFTConfig.EpochSize,
+		SprintSize:     c.config.PolyBFTConfig.SprintSize,
+		LastBuiltBlock: c.lastBuiltBlock.Number,
+	}, nil
+}
+
+// GetEpochValidators returns the validator set of the given epoch.
+// Validators of past epochs are read from the validator snapshots persisted at the end of the preceding epoch
+func (c *consensusRuntime) GetEpochValidators(epoch uint64) (AccountSet, error) {
+	c.lock.RLock()
+	current := c.epoch
+	c.lock.RUnlock()
+
+	if current == nil {
+		return nil, errEpochNotInitialized
+	}
+
+	if epoch == current.Number {
+		return current.Validators, nil
+	}
+
+	if epoch > current.Number {
+		return nil, fmt.Errorf("epoch %d has not started yet", epoch)
+	}
+
+	snapshotEpoch := epoch
+	if snapshotEpoch > 0 {
+		snapshotEpoch--
+	}
+
+	snapshot, err := c.state.getValidatorSnapshot(snapshotEpoch)
+	if err != nil {
+		return nil, err
+	}
+
+	if snapshot == nil {
+		return nil, fmt.Errorf("validator snapshot for epoch %d is not available", epoch)
+	}
+
+	return snapshot.Snapshot, nil
+}
+
+// GetProposer calculates the proposer for the given height and round.
+// It is only able to calculate proposers for the height the proposer snapshot is currently at
+func (c *consensusRuntime) GetProposer(height, round uint64) (types.Address, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	snapshot, ok := c.proposerCalculator.GetSnapshot()
+	if !ok {
+		return types.ZeroAddress, errors.New("proposer snapshot is empty")
+	}
+
+	return snapshot.CalcProposer(round, height)
+}
+
+// GetCommitment returns the commitment which contains given state sync event
+func (c *consensusRuntime) GetCommitment(stateSyncID uint64) (*CommitmentMessageSigned, error) {
+	return c.state.getCommitmentForStateSync(stateSyncID)
+}
+
+// GetExitEvents returns the exit events emitted in the given epoch
+func (c *consensusRuntime) GetExitEvents(epoch uint64) ([]*ExitEvent, error) {
+	return c.state.getExitEventsByEpoch(epoch)
+}
+
 // setIsActiveValidator updates the activeValidatorFlag field
 func (c *consensusRuntime) setIsActiveValidator(isActiveValidator bool) {
 	if isActiveValidator {
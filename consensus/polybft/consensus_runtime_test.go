@@ -538,6 +538,52 @@ func TestConsensusRuntime_restartEpoch_SameEpochNumberAsTheLastOne(t *testing.T)
 	blockchainMock.AssertExpectations(t)
 }
 
+func TestConsensusRuntime_GetEpochValidators(t *testing.T) {
+	t.Parallel()
+
+	currentValidators := newTestValidators(3).getPublicIdentities()
+	previousValidators := newTestValidators(4).getPublicIdentities()
+
+	state := newTestState(t)
+	require.NoError(t, state.insertValidatorSnapshot(&validatorSnapshot{
+		Epoch:            1,
+		EpochEndingBlock: 10,
+		Snapshot:         previousValidators,
+	}))
+
+	runtime := &consensusRuntime{
+		state: state,
+		config: &runtimeConfig{
+			PolyBFTConfig: &PolyBFTConfig{EpochSize: 10, SprintSize: 5},
+		},
+		epoch: &epochMetadata{
+			Number:            3,
+			FirstBlockInEpoch: 21,
+			Validators:        currentValidators,
+		},
+		lastBuiltBlock: &types.Header{Number: 22},
+	}
+
+	epoch, err := runtime.GetEpoch()
+	require.NoError(t, err)
+	require.Equal(t, &EpochInfo{Number: 3, FirstBlock: 21, EpochSize: 10, SprintSize: 5, LastBuiltBlock: 22}, epoch)
+
+	validators, err := runtime.GetEpochValidators(3)
+	require.NoError(t, err)
+	require.True(t, currentValidators.Equals(validators))
+
+	// validators of the epoch 2 are the ones snapshotted at the end of the epoch 1
+	validators, err = runtime.GetEpochValidators(2)
+	require.NoError(t, err)
+	require.True(t, previousValidators.Equals(validators))
+
+	_, err = runtime.GetEpochValidators(1)
+	require.ErrorContains(t, err, "not available")
+
+	_, err = runtime.GetEpochValidators(4)
+	require.ErrorContains(t, err, "has not started yet")
+}
+
 func TestConsensusRuntime_calculateCommitEpochInput_SecondEpoch(t *testing.T) {
 	t.Parallel()
 
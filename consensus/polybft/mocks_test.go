@@ -14,6 +14,7 @@ import (
 	"github.com/0xPolygon/polygon-edge/helper/progress"
 	"github.com/0xPolygon/polygon-edge/state"
 	"github.com/0xPolygon/polygon-edge/syncer"
+	"github.com/0xPolygon/polygon-edge/txpool"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/hashicorp/go-hclog"
 	"github.com/stretchr/testify/mock"
@@ -514,6 +515,22 @@ func (tp *txPoolMock) ResetWithHeaders(values ...*types.Header) {
 	tp.Called(values)
 }
 
+func (tp *txPoolMock) OrderingPolicy() string {
+	args := tp.Called()
+
+	return args.String(0)
+}
+
+func (tp *txPoolMock) Bundles(number uint64) []*txpool.Bundle {
+	args := tp.Called(number)
+
+	return args[0].([]*txpool.Bundle) //nolint
+}
+
+func (tp *txPoolMock) DiscardBundle(hash types.Hash) {
+	tp.Called(hash)
+}
+
 var _ syncer.Syncer = (*syncerMock)(nil)
 
 type syncerMock struct {
@@ -452,3 +452,29 @@ func (p *Polybft) PreCommitState(_ *types.Header, _ *state.Transition) error {
 func (p *Polybft) GetBridgeProvider() consensus.BridgeDataProvider {
 	return p.runtime
 }
+
+// DataProvider exposes the consensus runtime and state internals for introspection
+type DataProvider interface {
+	// GetEpoch returns the info of the epoch currently being processed
+	GetEpoch() (*EpochInfo, error)
+	// GetEpochValidators returns the validator set of the given epoch
+	GetEpochValidators(epoch uint64) (AccountSet, error)
+	// GetProposer calculates the proposer for the given height and round
+	GetProposer(height, round uint64) (types.Address, error)
+	// GetLatestCheckpointBlock returns the latest block checkpointed to the rootchain
+	GetLatestCheckpointBlock() (uint64, error)
+	// GetCommitment returns the commitment which contains given state sync event
+	GetCommitment(stateSyncID uint64) (*CommitmentMessageSigned, error)
+	// GetExitEvents returns the exit events emitted in the given epoch
+	GetExitEvents(epoch uint64) ([]*ExitEvent, error)
+}
+
+// GetDataProvider returns an instance of DataProvider,
+// or nil if the consensus runtime has not been started yet
+func (p *Polybft) GetDataProvider() DataProvider {
+	if p.runtime == nil {
+		return nil
+	}
+
+	return p.runtime
+}
@@ -69,7 +69,10 @@ require (
 
 require (
 	github.com/dave/jennifer v1.6.0
+	github.com/graph-gophers/graphql-go v1.3.0
+	github.com/vektah/gqlparser/v2 v2.2.0
 	golang.org/x/sync v0.0.0-20220929204114-8fcdb60fdcc0
+	golang.org/x/time v0.0.0-20220411224347-583f2d630306
 	gopkg.in/DataDog/dd-trace-go.v1 v1.43.1
 	pgregory.net/rapid v0.5.5
 )
@@ -225,7 +228,6 @@ require (
 	golang.org/x/oauth2 v0.0.0-20221006150949-b44042a4b9c1 // indirect
 	golang.org/x/term v0.0.0-20210927222741-03fcf44c2211 // indirect
 	golang.org/x/text v0.3.8 // indirect
-	golang.org/x/time v0.0.0-20220411224347-583f2d630306 // indirect
 	golang.org/x/xerrors v0.0.0-20220907171357-04be3eba64a2 // indirect
 	google.golang.org/api v0.99.0 // indirect
 	google.golang.org/appengine v1.6.7 // indirect
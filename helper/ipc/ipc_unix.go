@@ -7,6 +7,7 @@ import (
 	"net"
 	"os"
 	"path/filepath"
+	"syscall"
 	"time"
 
 	"github.com/0xPolygon/polygon-edge/helper/common"
@@ -22,24 +23,36 @@ func DialTimeout(path string, timeout time.Duration) (net.Conn, error) {
 	return net.DialTimeout("unix", path, timeout)
 }
 
-// Listen listens an IPC path
-func Listen(path string) (net.Listener, error) {
+// Listen listens an IPC path, restricting the socket file access to the given permissions
+func Listen(path string, perm os.FileMode) (net.Listener, error) {
 	if err := common.CreateDirSafe(filepath.Dir(path), 0751); err != nil {
 		return nil, err
 	}
 
-	if removeErr := os.Remove(path); removeErr != nil {
+	// remove the stale socket file left by the previous run
+	if removeErr := os.Remove(path); removeErr != nil && !os.IsNotExist(removeErr) {
 		return nil, removeErr
 	}
 
+	// the socket is created with the restricted permissions right away, so that it's not
+	// accessible with the default ones until the chmod below
+	oldMask := syscall.Umask(int(0777 &^ perm.Perm()))
 	lis, err := net.Listen("unix", path)
+
+	syscall.Umask(oldMask)
+
 	if err != nil {
 		return nil, err
 	}
 
-	if chmodErr := os.Chmod(path, 0600); chmodErr != nil {
+	if chmodErr := os.Chmod(path, perm); chmodErr != nil {
 		return nil, chmodErr
 	}
 
 	return lis, nil
 }
+
+// Path returns the path of the IPC socket with the given name in the data directory
+func Path(dataDir, name string) string {
+	return filepath.Join(dataDir, name)
+}
@@ -4,7 +4,11 @@
 package ipc
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"net"
+	"os"
+	"path/filepath"
 	"time"
 
 	"gopkg.in/natefinch/npipe.v2"
@@ -20,7 +24,20 @@ func DialTimeout(path string, timeout time.Duration) (net.Conn, error) {
 	return npipe.DialTimeout(path, timeout)
 }
 
-// Listen listens an IPC path
-func Listen(path string) (net.Listener, error) {
+// Listen listens an IPC path. The named pipes don't support the file permissions
+func Listen(path string, _ os.FileMode) (net.Listener, error) {
 	return npipe.Listen(path)
 }
+
+// Path returns the path of the named pipe with the given name. The named pipes can't be placed
+// in the data directory, so the pipe name is suffixed with the data directory hash instead,
+// which lets the nodes of the different data directories run side by side
+func Path(dataDir, name string) string {
+	if absDir, err := filepath.Abs(dataDir); err == nil {
+		dataDir = absDir
+	}
+
+	hash := sha256.Sum256([]byte(filepath.Clean(dataDir)))
+
+	return `\\.\pipe\` + name + "-" + hex.EncodeToString(hash[:8])
+}
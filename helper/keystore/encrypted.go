@@ -0,0 +1,41 @@
+package keystore
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/umbracle/ethgo/keystore"
+)
+
+const (
+	// StandardScryptN is the scrypt cost parameter of the encrypted keys,
+	// making the brute forcing of the passwords expensive
+	StandardScryptN = 1 << 18
+
+	// LightScryptN is the scrypt cost parameter used when the key decryption has to be cheap
+	LightScryptN = 1 << 12
+)
+
+var (
+	ErrDecryptionFailed = errors.New("could not decrypt key with given password")
+)
+
+// EncryptKey encrypts the private key with the password, using the Web3 Secret Storage (v3) format
+func EncryptKey(key []byte, password string, scryptN int) ([]byte, error) {
+	encrypted, err := keystore.EncryptV3(key, password, scryptN)
+	if err != nil {
+		return nil, fmt.Errorf("unable to encrypt private key, %w", err)
+	}
+
+	return encrypted, nil
+}
+
+// DecryptKey decrypts the private key stored in the Web3 Secret Storage (v3) format
+func DecryptKey(encrypted []byte, password string) ([]byte, error) {
+	key, err := keystore.DecryptV3(encrypted, password)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDecryptionFailed, err)
+	}
+
+	return key, nil
+}
@@ -0,0 +1,153 @@
+package jsonrpc
+
+import (
+	"errors"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/0xPolygon/polygon-edge/versioning"
+)
+
+var (
+	ErrRPCControllerNotSet = errors.New("rpc listeners are not controllable")
+)
+
+// adminStore provides methods needed for Admin endpoint
+type adminStore interface {
+	// Header returns the current header of the chain (genesis if empty)
+	Header() *types.Header
+
+	// GetNodeInfo returns the networking details of the local node
+	GetNodeInfo() *NodeInfo
+
+	// GetPeersInfo returns the details of the connected peers
+	GetPeersInfo() []*PeerInfo
+
+	// JoinPeer attempts to add a new peer to the networking server
+	JoinPeer(rawPeerMultiaddr string) error
+
+	// AddTrustedPeer marks the peer as trusted and attempts to add it to the networking server
+	AddTrustedPeer(rawPeerMultiaddr string) error
+
+	// RemovePeer removes the trusted mark from the peer and disconnects from it
+	RemovePeer(rawPeerID string) error
+}
+
+// rpcController controls the JSON-RPC listeners at runtime
+type rpcController interface {
+	StartListener(name string) error
+	StopListener(name string) error
+}
+
+// NodeInfo holds the details of the local node
+type NodeInfo struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	Addrs     []string  `json:"addrs"`
+	Protocols []string  `json:"protocols"`
+	ChainID   argUint64 `json:"chainId"`
+	Head      *NodeHead `json:"head"`
+}
+
+// NodeHead holds the details of the local node's head block
+type NodeHead struct {
+	Number argUint64  `json:"number"`
+	Hash   types.Hash `json:"hash"`
+}
+
+// PeerInfo holds the details of the connected peer
+type PeerInfo struct {
+	ID        string   `json:"id"`
+	Addrs     []string `json:"addrs"`
+	Direction string   `json:"direction"`
+	Protocols []string `json:"protocols"`
+	Latency   string   `json:"latency"`
+	Trusted   bool     `json:"trusted"`
+}
+
+// Admin is the admin jsonrpc endpoint
+type Admin struct {
+	store   adminStore
+	chainID uint64
+	rpc     rpcController
+}
+
+// NodeInfo returns the details of the local node
+func (a *Admin) NodeInfo() (interface{}, error) {
+	info := a.store.GetNodeInfo()
+	header := a.store.Header()
+
+	info.Name = "polygon-edge/" + versioning.Version
+	info.ChainID = argUint64(a.chainID)
+	info.Head = &NodeHead{
+		Number: argUint64(header.Number),
+		Hash:   header.Hash,
+	}
+
+	return info, nil
+}
+
+// Peers returns the details of the connected peers
+func (a *Admin) Peers() (interface{}, error) {
+	return a.store.GetPeersInfo(), nil
+}
+
+// AddPeer requests adding the peer with the given p2p multiaddr
+func (a *Admin) AddPeer(rawPeerMultiaddr string) (interface{}, error) {
+	if err := a.store.JoinPeer(rawPeerMultiaddr); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// AddTrustedPeer requests adding the peer with the given p2p multiaddr, keeping it always connected
+func (a *Admin) AddTrustedPeer(rawPeerMultiaddr string) (interface{}, error) {
+	if err := a.store.AddTrustedPeer(rawPeerMultiaddr); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// RemovePeer disconnects from the peer with the given ID
+func (a *Admin) RemovePeer(rawPeerID string) (interface{}, error) {
+	if err := a.store.RemovePeer(rawPeerID); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// StartRPC starts the stopped JSON-RPC listener, the public one if not specified
+func (a *Admin) StartRPC(name *string) (interface{}, error) {
+	if a.rpc == nil {
+		return false, ErrRPCControllerNotSet
+	}
+
+	if err := a.rpc.StartListener(listenerName(name)); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// StopRPC stops the JSON-RPC listener, the public one if not specified
+func (a *Admin) StopRPC(name *string) (interface{}, error) {
+	if a.rpc == nil {
+		return false, ErrRPCControllerNotSet
+	}
+
+	if err := a.rpc.StopListener(listenerName(name)); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func listenerName(name *string) string {
+	if name == nil || *name == "" {
+		return publicListener
+	}
+
+	return *name
+}
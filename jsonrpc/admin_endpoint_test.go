@@ -0,0 +1,215 @@
+package jsonrpc
+
+import (
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/helper/tests"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type adminEndpointMockStore struct {
+	JSONRPCStore
+
+	header      *types.Header
+	nodeInfo    *NodeInfo
+	peers       []*PeerInfo
+	joined      []string
+	trusted     []string
+	removed     []string
+	joinPeerErr error
+}
+
+func (s *adminEndpointMockStore) Header() *types.Header {
+	return s.header
+}
+
+func (s *adminEndpointMockStore) GetNodeInfo() *NodeInfo {
+	return s.nodeInfo
+}
+
+func (s *adminEndpointMockStore) GetPeersInfo() []*PeerInfo {
+	return s.peers
+}
+
+func (s *adminEndpointMockStore) JoinPeer(rawPeerMultiaddr string) error {
+	if s.joinPeerErr != nil {
+		return s.joinPeerErr
+	}
+
+	s.joined = append(s.joined, rawPeerMultiaddr)
+
+	return nil
+}
+
+func (s *adminEndpointMockStore) AddTrustedPeer(rawPeerMultiaddr string) error {
+	s.trusted = append(s.trusted, rawPeerMultiaddr)
+
+	return nil
+}
+
+func (s *adminEndpointMockStore) RemovePeer(rawPeerID string) error {
+	s.removed = append(s.removed, rawPeerID)
+
+	return nil
+}
+
+type mockRPCController struct {
+	started []string
+	stopped []string
+}
+
+func (c *mockRPCController) StartListener(name string) error {
+	c.started = append(c.started, name)
+
+	return nil
+}
+
+func (c *mockRPCController) StopListener(name string) error {
+	c.stopped = append(c.stopped, name)
+
+	return nil
+}
+
+func TestAdmin_NodeInfo(t *testing.T) {
+	t.Parallel()
+
+	store := &adminEndpointMockStore{
+		header: &types.Header{Number: 10, Hash: types.StringToHash("1")},
+		nodeInfo: &NodeInfo{
+			ID:        "16Uiu2",
+			Addrs:     []string{"/ip4/127.0.0.1/tcp/1478/p2p/16Uiu2"},
+			Protocols: []string{"/syncer/0.2"},
+		},
+	}
+	admin := &Admin{store: store, chainID: 100}
+
+	res, err := admin.NodeInfo()
+	require.NoError(t, err)
+
+	info, ok := res.(*NodeInfo)
+	require.True(t, ok)
+
+	assert.Equal(t, "16Uiu2", info.ID)
+	assert.Equal(t, argUint64(100), info.ChainID)
+	assert.Equal(t, argUint64(10), info.Head.Number)
+	assert.Equal(t, types.StringToHash("1"), info.Head.Hash)
+	assert.Contains(t, info.Name, "polygon-edge")
+}
+
+func TestAdmin_PeerManagement(t *testing.T) {
+	t.Parallel()
+
+	store := &adminEndpointMockStore{
+		peers: []*PeerInfo{{ID: "peer", Direction: "outbound"}},
+	}
+	admin := &Admin{store: store}
+
+	res, err := admin.Peers()
+	require.NoError(t, err)
+	assert.Equal(t, store.peers, res)
+
+	res, err = admin.AddPeer("/ip4/127.0.0.1/tcp/1478/p2p/peer")
+	require.NoError(t, err)
+	assert.Equal(t, true, res)
+	assert.Equal(t, []string{"/ip4/127.0.0.1/tcp/1478/p2p/peer"}, store.joined)
+
+	res, err = admin.AddTrustedPeer("/ip4/127.0.0.1/tcp/1478/p2p/trusted")
+	require.NoError(t, err)
+	assert.Equal(t, true, res)
+	assert.Equal(t, []string{"/ip4/127.0.0.1/tcp/1478/p2p/trusted"}, store.trusted)
+
+	res, err = admin.RemovePeer("peer")
+	require.NoError(t, err)
+	assert.Equal(t, true, res)
+	assert.Equal(t, []string{"peer"}, store.removed)
+
+	store.joinPeerErr = errors.New("invalid multiaddr")
+
+	res, err = admin.AddPeer("invalid")
+	assert.Error(t, err)
+	assert.Equal(t, false, res)
+}
+
+func TestAdmin_RPCToggles(t *testing.T) {
+	t.Parallel()
+
+	_, err := (&Admin{}).StopRPC(nil)
+	assert.ErrorIs(t, err, ErrRPCControllerNotSet)
+
+	controller := &mockRPCController{}
+	admin := &Admin{rpc: controller}
+
+	private := privateListener
+
+	_, err = admin.StopRPC(nil)
+	require.NoError(t, err)
+
+	_, err = admin.StartRPC(&private)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{publicListener}, controller.stopped)
+	assert.Equal(t, []string{privateListener}, controller.started)
+}
+
+func TestJSONRPC_AdminNamespaceRequiresAuthentication(t *testing.T) {
+	t.Parallel()
+
+	port, err := tests.GetFreePort()
+	require.NoError(t, err)
+
+	config := &Config{
+		Store:      newMockStore(),
+		Addr:       &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: port},
+		Namespaces: []string{"eth", adminNamespace},
+	}
+
+	_, err = NewJSONRPC(hclog.NewNullLogger(), config)
+	assert.ErrorContains(t, err, "requires jwt authentication")
+
+	// the admin namespace is left out of the unauthenticated listeners exposing every namespace
+	d := newDispatcher(hclog.NewNullLogger(), newMockStore(), &dispatcherParams{})
+
+	scope, err := d.listenerScope(privateListener, nil, false)
+	require.NoError(t, err)
+	assert.True(t, scope.isEnabled("eth"))
+	assert.False(t, scope.isEnabled(adminNamespace))
+
+	scope, err = d.listenerScope(privateListener, nil, true)
+	require.NoError(t, err)
+	assert.True(t, scope.isEnabled(adminNamespace))
+}
+
+func TestJSONRPC_StartStopListener(t *testing.T) {
+	t.Parallel()
+
+	port, err := tests.GetFreePort()
+	require.NoError(t, err)
+
+	srv, err := NewJSONRPC(hclog.NewNullLogger(), &Config{
+		Store: newMockStore(),
+		Addr:  &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: port},
+	})
+	require.NoError(t, err)
+
+	addr := srv.config.Addr.String()
+
+	assert.Error(t, srv.StartListener(publicListener))
+	assert.Error(t, srv.StopListener("unknown"))
+
+	require.NoError(t, srv.StopListener(publicListener))
+	assert.Error(t, srv.StopListener(publicListener))
+
+	_, err = net.Dial("tcp", addr)
+	assert.Error(t, err)
+
+	require.NoError(t, srv.StartListener(publicListener))
+
+	conn, err := net.Dial("tcp", addr)
+	require.NoError(t, err)
+	require.NoError(t, conn.Close())
+}
@@ -30,7 +30,7 @@ func TestBridgeEndpoint(t *testing.T) {
 		"id": 1
 	}`)
 
-	data, err := dispatcher.HandleWs(msg, mockConnection)
+	data, err := dispatcher.HandleWs(msg, mockConnection, nil)
 	require.NoError(t, err)
 
 	resp := new(SuccessResponse)
@@ -44,7 +44,7 @@ func TestBridgeEndpoint(t *testing.T) {
 		"id": 1
 	}`)
 
-	data, err = dispatcher.HandleWs(msg, mockConnection)
+	data, err = dispatcher.HandleWs(msg, mockConnection, nil)
 	require.NoError(t, err)
 
 	resp = new(SuccessResponse)
@@ -2,6 +2,7 @@ package jsonrpc
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -94,15 +95,19 @@ func (e *ObjectError) Error() string {
 }
 
 const (
-	pending  = "pending"
-	latest   = "latest"
-	earliest = "earliest"
+	pending   = "pending"
+	latest    = "latest"
+	earliest  = "earliest"
+	safe      = "safe"
+	finalized = "finalized"
 )
 
 const (
-	PendingBlockNumber  = BlockNumber(-3)
-	LatestBlockNumber   = BlockNumber(-2)
-	EarliestBlockNumber = BlockNumber(-1)
+	FinalizedBlockNumber = BlockNumber(-5)
+	SafeBlockNumber      = BlockNumber(-4)
+	PendingBlockNumber   = BlockNumber(-3)
+	LatestBlockNumber    = BlockNumber(-2)
+	EarliestBlockNumber  = BlockNumber(-1)
 )
 
 type BlockNumber int64
@@ -115,7 +120,7 @@ type BlockNumberOrHash struct {
 // UnmarshalJSON will try to extract the filter's data.
 // Here are the possible input formats :
 //
-// 1 - "latest", "pending" or "earliest"	- self-explaining keywords
+// 1 - "latest", "pending", "earliest", "safe" or "finalized"	- self-explaining keywords
 // 2 - "0x2"								- block number #2 (EIP-1898 backward compatible)
 // 3 - {blockNumber:	"0x2"}				- EIP-1898 compliant block number #2
 // 4 - {blockHash:		"0xe0e..."}			- EIP-1898 compliant block hash 0xe0e...
@@ -158,6 +163,10 @@ func stringToBlockNumber(str string) (BlockNumber, error) {
 		return LatestBlockNumber, nil
 	case earliest:
 		return EarliestBlockNumber, nil
+	case safe:
+		return SafeBlockNumber, nil
+	case finalized:
+		return FinalizedBlockNumber, nil
 	}
 
 	n, err := types.ParseUint64orHex(&str)
@@ -209,7 +218,14 @@ func NewRPCResponse(id interface{}, jsonrpcver string, reply []byte, err Error)
 	case nil:
 		response = &SuccessResponse{JSONRPC: jsonrpcver, ID: id, Result: reply}
 	default:
-		response = NewRPCErrorResponse(id, err.ErrorCode(), err.Error(), jsonrpcver)
+		errObject := &ObjectError{Code: err.ErrorCode(), Message: err.Error()}
+
+		var dataErr DataError
+		if errors.As(err, &dataErr) {
+			errObject.Data = dataErr.ErrorData()
+		}
+
+		response = &ErrorResponse{JSONRPC: jsonrpcver, ID: id, Error: errObject}
 	}
 
 	return response
@@ -0,0 +1,122 @@
+package jsonrpc
+
+import (
+	"encoding/json"
+	"io/fs"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+)
+
+// conformanceExchange is a recorded JSON-RPC request and the response expected for it
+type conformanceExchange struct {
+	Request  json.RawMessage `json:"request"`
+	Response json.RawMessage `json:"response"`
+}
+
+// conformanceStore is the block store serving the conformance chain
+type conformanceStore struct {
+	*mockBlockStore
+}
+
+func (s *conformanceStore) GetHeaderByNumber(number uint64) (*types.Header, bool) {
+	block, ok := s.GetBlockByNumber(number, false)
+	if !ok {
+		return nil, false
+	}
+
+	return block.Header, true
+}
+
+// newConformanceStore creates the deterministic chain the conformance fixtures are recorded against.
+// The head block 0x1 holds two transactions with logs and a single uncle
+func newConformanceStore() *conformanceStore {
+	store := newMockBlockStore()
+
+	genesis := newTestBlock(0, types.StringToHash("0x10"))
+
+	head := newTestBlock(1, types.StringToHash("0x11"))
+	head.Header.ParentHash = genesis.Hash()
+	head.Header.Miner = addr0.Bytes()
+	head.Header.GasLimit = 30000000
+	head.Header.GasUsed = 42000
+	head.Header.Timestamp = 1000
+	head.Transactions = []*types.Transaction{
+		newTestTransaction(1, addr0),
+		newTestTransaction(2, addr0),
+	}
+	head.Uncles = []*types.Header{
+		{
+			Number:     1,
+			ParentHash: genesis.Hash(),
+			Hash:       types.StringToHash("0x21"),
+		},
+	}
+
+	success := types.ReceiptSuccess
+	store.receipts[head.Hash()] = []*types.Receipt{
+		{
+			CumulativeGasUsed: 21000,
+			GasUsed:           21000,
+			Status:            &success,
+			TxHash:            head.Transactions[0].Hash,
+			Logs: []*types.Log{
+				{Address: addr1, Topics: []types.Hash{hash1}, Data: []byte{0x1}},
+			},
+		},
+		{
+			CumulativeGasUsed: 42000,
+			GasUsed:           21000,
+			Status:            &success,
+			TxHash:            head.Transactions[1].Hash,
+			Logs: []*types.Log{
+				{Address: addr1, Topics: []types.Hash{hash1, hash2}},
+				{Address: addr1, Data: []byte{0x2}},
+			},
+		},
+	}
+
+	store.add(genesis, head)
+
+	return &conformanceStore{store}
+}
+
+// TestConformance replays the recorded fixtures under testsuite/conformance
+// and checks the responses match the recorded ones
+func TestConformance(t *testing.T) {
+	t.Parallel()
+
+	dispatcher := &Dispatcher{
+		logger: hclog.NewNullLogger(),
+		params: &dispatcherParams{},
+	}
+	dispatcher.registerService("eth", newTestEthEndpoint(newConformanceStore()))
+
+	files, err := fs.Glob(testsuite, "testsuite/conformance/*.json")
+	require.NoError(t, err)
+	require.NotEmpty(t, files)
+
+	for _, file := range files {
+		file := file
+
+		t.Run(strings.TrimSuffix(path.Base(file), ".json"), func(t *testing.T) {
+			t.Parallel()
+
+			data, err := testsuite.ReadFile(file)
+			require.NoError(t, err)
+
+			var exchanges []conformanceExchange
+			require.NoError(t, json.Unmarshal(data, &exchanges))
+
+			for _, exchange := range exchanges {
+				resp, err := dispatcher.Handle(exchange.Request, nil)
+				require.NoError(t, err)
+				require.JSONEq(t, string(exchange.Response), string(resp), string(exchange.Request))
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/0xPolygon/polygon-edge/blockchain"
 	"github.com/0xPolygon/polygon-edge/helper/hex"
 	"github.com/0xPolygon/polygon-edge/state/runtime/tracer"
 	"github.com/0xPolygon/polygon-edge/state/runtime/tracer/structtracer"
@@ -47,10 +48,19 @@ type debugBlockchainStore interface {
 
 	// TraceCall traces a single call at the point when the given header is mined
 	TraceCall(*types.Transaction, *types.Header, tracer.Tracer) (interface{}, error)
+
+	// GetReceiptsByHash returns the receipts for a block hash
+	GetReceiptsByHash(hash types.Hash) ([]*types.Receipt, error)
+
+	// GetBadBlocks returns the recently rejected blocks
+	GetBadBlocks() []*blockchain.BadBlock
 }
 
 type debugTxPoolStore interface {
 	GetNonce(types.Address) uint64
+
+	// GetPendingTx gets the pending transaction from the transaction pool, if it's present
+	GetPendingTx(txHash types.Hash) (*types.Transaction, bool)
 }
 
 type debugStateStore interface {
@@ -175,6 +185,91 @@ func (d *Debug) TraceCall(
 	return d.store.TraceCall(tx, header, tracer)
 }
 
+// badBlock is the rejected block returned by debug_getBadBlocks
+type badBlock struct {
+	Hash   types.Hash `json:"hash"`
+	Block  *block     `json:"block"`
+	RLP    argBytes   `json:"rlp"`
+	Reason string     `json:"reason"`
+}
+
+// GetRawHeader returns the RLP encoding of the header
+func (d *Debug) GetRawHeader(filter BlockNumberOrHash) (interface{}, error) {
+	header, err := GetHeaderFromBlockNumberOrHash(filter, d.store)
+	if err != nil {
+		return nil, err
+	}
+
+	return argBytes(header.MarshalRLP()), nil
+}
+
+// GetRawBlock returns the RLP encoding of the block
+func (d *Debug) GetRawBlock(filter BlockNumberOrHash) (interface{}, error) {
+	header, err := GetHeaderFromBlockNumberOrHash(filter, d.store)
+	if err != nil {
+		return nil, err
+	}
+
+	block, ok := d.store.GetBlockByHash(header.Hash, true)
+	if !ok {
+		return nil, fmt.Errorf("block %s not found", header.Hash)
+	}
+
+	return argBytes(block.MarshalRLP()), nil
+}
+
+// GetRawReceipts returns the consensus encodings of the receipts of the block
+func (d *Debug) GetRawReceipts(filter BlockNumberOrHash) (interface{}, error) {
+	header, err := GetHeaderFromBlockNumberOrHash(filter, d.store)
+	if err != nil {
+		return nil, err
+	}
+
+	receipts, err := d.store.GetReceiptsByHash(header.Hash)
+	if err != nil {
+		return nil, err
+	}
+
+	raw := make([]argBytes, len(receipts))
+	for i, receipt := range receipts {
+		raw[i] = receipt.MarshalRLP()
+	}
+
+	return raw, nil
+}
+
+// GetRawTransaction returns the RLP encoding of the mined or the pending transaction
+func (d *Debug) GetRawTransaction(txHash types.Hash) (interface{}, error) {
+	tx, _ := GetTxAndBlockByTxHash(txHash, d.store)
+	if tx == nil {
+		pendingTx, ok := d.store.GetPendingTx(txHash)
+		if !ok {
+			return nil, nil
+		}
+
+		tx = pendingTx
+	}
+
+	return argBytes(tx.MarshalRLP()), nil
+}
+
+// GetBadBlocks returns the blocks recently rejected by the node, with the reason of the rejection
+func (d *Debug) GetBadBlocks() (interface{}, error) {
+	badBlocks := d.store.GetBadBlocks()
+	res := make([]*badBlock, len(badBlocks))
+
+	for i, bad := range badBlocks {
+		res[i] = &badBlock{
+			Hash:   bad.Block.Hash(),
+			Block:  toBlock(bad.Block, true),
+			RLP:    bad.Block.MarshalRLP(),
+			Reason: bad.Err.Error(),
+		}
+	}
+
+	return res, nil
+}
+
 func (d *Debug) traceBlock(
 	block *types.Block,
 	config *TraceConfig,
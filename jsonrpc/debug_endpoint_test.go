@@ -2,10 +2,12 @@ package jsonrpc
 
 import (
 	"encoding/json"
+	"errors"
 	"math/big"
 	"testing"
 	"time"
 
+	"github.com/0xPolygon/polygon-edge/blockchain"
 	"github.com/0xPolygon/polygon-edge/helper/hex"
 	"github.com/0xPolygon/polygon-edge/state/runtime/tracer"
 	"github.com/0xPolygon/polygon-edge/types"
@@ -23,6 +25,9 @@ type debugEndpointMockStore struct {
 	traceCallFn         func(*types.Transaction, *types.Header, tracer.Tracer) (interface{}, error)
 	getNonceFn          func(types.Address) uint64
 	getAccountFn        func(types.Hash, types.Address) (*Account, error)
+	getReceiptsFn       func(types.Hash) ([]*types.Receipt, error)
+	getBadBlocksFn      func() []*blockchain.BadBlock
+	getPendingTxFn      func(types.Hash) (*types.Transaction, bool)
 }
 
 func (s *debugEndpointMockStore) Header() *types.Header {
@@ -65,6 +70,18 @@ func (s *debugEndpointMockStore) GetAccount(root types.Hash, addr types.Address)
 	return s.getAccountFn(root, addr)
 }
 
+func (s *debugEndpointMockStore) GetReceiptsByHash(hash types.Hash) ([]*types.Receipt, error) {
+	return s.getReceiptsFn(hash)
+}
+
+func (s *debugEndpointMockStore) GetBadBlocks() []*blockchain.BadBlock {
+	return s.getBadBlocksFn()
+}
+
+func (s *debugEndpointMockStore) GetPendingTx(txHash types.Hash) (*types.Transaction, bool) {
+	return s.getPendingTxFn(txHash)
+}
+
 func TestDebugTraceConfigDecode(t *testing.T) {
 	timeout15s := "15s"
 
@@ -767,3 +784,93 @@ func Test_newTracer(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func TestDebug_GetRawData(t *testing.T) {
+	t.Parallel()
+
+	tx := &types.Transaction{Nonce: 1, Gas: 21000, GasPrice: big.NewInt(1), Value: big.NewInt(0)}
+	tx.ComputeHash()
+
+	block := &types.Block{Header: testHeader10, Transactions: []*types.Transaction{tx}}
+
+	status := types.ReceiptSuccess
+	receipts := []*types.Receipt{{Status: &status, CumulativeGasUsed: 21000}}
+
+	pendingTx := &types.Transaction{Nonce: 2, Gas: 21000, GasPrice: big.NewInt(1), Value: big.NewInt(0)}
+	pendingTx.ComputeHash()
+
+	endpoint := &Debug{&debugEndpointMockStore{
+		headerFn: func() *types.Header {
+			return testHeader10
+		},
+		getHeaderByNumberFn: func(num uint64) (*types.Header, bool) {
+			return testHeader10, num == testHeader10.Number
+		},
+		getBlockByHashFn: func(hash types.Hash, full bool) (*types.Block, bool) {
+			return block, hash == testHeader10.Hash
+		},
+		readTxLookupFn: func(hash types.Hash) (types.Hash, bool) {
+			return testHeader10.Hash, hash == tx.Hash
+		},
+		getReceiptsFn: func(hash types.Hash) ([]*types.Receipt, error) {
+			assert.Equal(t, testHeader10.Hash, hash)
+
+			return receipts, nil
+		},
+		getPendingTxFn: func(hash types.Hash) (*types.Transaction, bool) {
+			return pendingTx, hash == pendingTx.Hash
+		},
+	}}
+
+	latest := BlockNumberOrHash{}
+
+	res, err := endpoint.GetRawHeader(latest)
+	assert.NoError(t, err)
+	assert.Equal(t, argBytes(testHeader10.MarshalRLP()), res)
+
+	res, err = endpoint.GetRawBlock(BlockNumberOrHash{BlockHash: &testHeader10.Hash})
+	assert.NoError(t, err)
+	assert.Equal(t, argBytes(block.MarshalRLP()), res)
+
+	res, err = endpoint.GetRawReceipts(latest)
+	assert.NoError(t, err)
+	assert.Equal(t, []argBytes{receipts[0].MarshalRLP()}, res)
+
+	res, err = endpoint.GetRawTransaction(tx.Hash)
+	assert.NoError(t, err)
+	assert.Equal(t, argBytes(tx.MarshalRLP()), res)
+
+	res, err = endpoint.GetRawTransaction(pendingTx.Hash)
+	assert.NoError(t, err)
+	assert.Equal(t, argBytes(pendingTx.MarshalRLP()), res)
+
+	res, err = endpoint.GetRawTransaction(testHash11)
+	assert.NoError(t, err)
+	assert.Nil(t, res)
+
+	_, err = endpoint.GetRawHeader(BlockNumberOrHash{BlockHash: &testHash11})
+	assert.Error(t, err)
+}
+
+func TestDebug_GetBadBlocks(t *testing.T) {
+	t.Parallel()
+
+	rejectErr := errors.New("invalid block state root")
+
+	endpoint := &Debug{&debugEndpointMockStore{
+		getBadBlocksFn: func() []*blockchain.BadBlock {
+			return []*blockchain.BadBlock{{Block: testBlock10, Err: rejectErr}}
+		},
+	}}
+
+	res, err := endpoint.GetBadBlocks()
+	assert.NoError(t, err)
+
+	badBlocks, ok := res.([]*badBlock)
+	assert.True(t, ok)
+	assert.Len(t, badBlocks, 1)
+	assert.Equal(t, testHeader10.Hash, badBlocks[0].Hash)
+	assert.Equal(t, argUint64(10), badBlocks[0].Block.Number)
+	assert.Equal(t, argBytes(testBlock10.MarshalRLP()), badBlocks[0].RLP)
+	assert.Equal(t, rejectErr.Error(), badBlocks[0].Reason)
+}
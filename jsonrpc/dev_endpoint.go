@@ -0,0 +1,169 @@
+package jsonrpc
+
+import (
+	"encoding/json"
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// DevControls are the test chain controls of the dev consensus
+type DevControls interface {
+	// Mine seals a new block, with the given timestamp if not 0
+	Mine(timestamp uint64) error
+
+	// SetNextBlockTimestamp sets the timestamp of the next block, the time of the later blocks continues from it
+	SetNextBlockTimestamp(timestamp uint64) error
+
+	// IncreaseTime moves the time of the next blocks forward, and returns the total time offset in seconds
+	IncreaseTime(seconds uint64) uint64
+
+	// Snapshot saves the current head of the chain, and returns the id of the snapshot
+	Snapshot() uint64
+
+	// Revert rewinds the chain and the state to the snapshot, the snapshot and the later ones are discarded.
+	// Returns false if the snapshot is unknown
+	Revert(id uint64) (bool, error)
+
+	// SetBalance sets the balance of the account
+	SetBalance(addr types.Address, balance *big.Int) error
+
+	// SetCode sets the code of the account
+	SetCode(addr types.Address, code []byte) error
+
+	// SetStorageAt sets the value of the account's storage slot
+	SetStorageAt(addr types.Address, key, value types.Hash) error
+
+	// Impersonate lets the account send the transactions without signing them
+	Impersonate(addr types.Address)
+
+	// StopImpersonating stops impersonating the account
+	StopImpersonating(addr types.Address)
+
+	// IsImpersonated checks if the account is impersonated
+	IsImpersonated(addr types.Address) bool
+
+	// SendImpersonatedTx adds the unsigned transaction of the impersonated account to the next block,
+	// and returns its hash
+	SendImpersonatedTx(tx *types.Transaction) (types.Hash, error)
+
+	// SetAutomine enables or disables sealing the blocks on the interval and on every change
+	SetAutomine(enabled bool)
+}
+
+// argTime is the timestamp or the number of seconds, given either as a JSON number or as a hex string
+type argTime uint64
+
+func (a *argTime) UnmarshalJSON(data []byte) error {
+	var num uint64
+	if err := json.Unmarshal(data, &num); err == nil {
+		*a = argTime(num)
+
+		return nil
+	}
+
+	var hex argUint64
+	if err := json.Unmarshal(data, &hex); err != nil {
+		return err
+	}
+
+	*a = argTime(hex)
+
+	return nil
+}
+
+// Evm is the evm jsonrpc endpoint, controlling the blocks and the time of the dev chain
+type Evm struct {
+	controls DevControls
+}
+
+// Mine seals a new block, with the given timestamp if specified
+func (e *Evm) Mine(timestamp *argTime) (interface{}, error) {
+	var ts uint64
+	if timestamp != nil {
+		ts = uint64(*timestamp)
+	}
+
+	if err := e.controls.Mine(ts); err != nil {
+		return nil, err
+	}
+
+	return "0x0", nil
+}
+
+// SetNextBlockTimestamp sets the timestamp of the next block
+func (e *Evm) SetNextBlockTimestamp(timestamp argTime) (interface{}, error) {
+	if err := e.controls.SetNextBlockTimestamp(uint64(timestamp)); err != nil {
+		return nil, err
+	}
+
+	return true, nil
+}
+
+// IncreaseTime moves the time of the next blocks forward, and returns the total time offset in seconds
+func (e *Evm) IncreaseTime(seconds argTime) (interface{}, error) {
+	return argUint64(e.controls.IncreaseTime(uint64(seconds))), nil
+}
+
+// Snapshot saves the current head of the chain, and returns the id of the snapshot
+func (e *Evm) Snapshot() (interface{}, error) {
+	return argUint64(e.controls.Snapshot()), nil
+}
+
+// Revert rewinds the chain and the state to the snapshot, returns false if the snapshot is unknown
+func (e *Evm) Revert(id argUint64) (interface{}, error) {
+	return e.controls.Revert(uint64(id))
+}
+
+// SetAutomine enables or disables sealing the blocks automatically
+func (e *Evm) SetAutomine(enabled bool) (interface{}, error) {
+	e.controls.SetAutomine(enabled)
+
+	return true, nil
+}
+
+// Dev is the dev jsonrpc endpoint, overriding the accounts of the dev chain
+type Dev struct {
+	controls DevControls
+}
+
+// SetBalance sets the balance of the account
+func (d *Dev) SetBalance(addr types.Address, balance argBig) (interface{}, error) {
+	if err := d.controls.SetBalance(addr, (*big.Int)(&balance)); err != nil {
+		return nil, err
+	}
+
+	return true, nil
+}
+
+// SetCode sets the code of the account
+func (d *Dev) SetCode(addr types.Address, code argBytes) (interface{}, error) {
+	if err := d.controls.SetCode(addr, code); err != nil {
+		return nil, err
+	}
+
+	return true, nil
+}
+
+// SetStorageAt sets the value of the account's storage slot
+func (d *Dev) SetStorageAt(addr types.Address, key, value types.Hash) (interface{}, error) {
+	if err := d.controls.SetStorageAt(addr, key, value); err != nil {
+		return nil, err
+	}
+
+	return true, nil
+}
+
+// ImpersonateAccount lets eth_sendTransaction send the account's transactions without signing them
+func (d *Dev) ImpersonateAccount(addr types.Address) (interface{}, error) {
+	d.controls.Impersonate(addr)
+
+	return true, nil
+}
+
+// StopImpersonatingAccount stops impersonating the account
+func (d *Dev) StopImpersonatingAccount(addr types.Address) (interface{}, error) {
+	d.controls.StopImpersonating(addr)
+
+	return true, nil
+}
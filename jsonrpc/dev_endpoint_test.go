@@ -0,0 +1,256 @@
+package jsonrpc
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockDevControls records the changes made over the dev endpoints
+type mockDevControls struct {
+	mined         []uint64
+	nextTimestamp uint64
+	timeOffset    uint64
+	snapshots     uint64
+	automine      bool
+
+	balances     map[types.Address]*big.Int
+	codes        map[types.Address][]byte
+	storage      map[types.Hash]types.Hash
+	impersonated map[types.Address]bool
+	sent         []*types.Transaction
+}
+
+func newMockDevControls() *mockDevControls {
+	return &mockDevControls{
+		automine:     true,
+		balances:     make(map[types.Address]*big.Int),
+		codes:        make(map[types.Address][]byte),
+		storage:      make(map[types.Hash]types.Hash),
+		impersonated: make(map[types.Address]bool),
+	}
+}
+
+func (m *mockDevControls) Mine(timestamp uint64) error {
+	m.mined = append(m.mined, timestamp)
+
+	return nil
+}
+
+func (m *mockDevControls) SetNextBlockTimestamp(timestamp uint64) error {
+	if timestamp == 0 {
+		return errors.New("invalid timestamp")
+	}
+
+	m.nextTimestamp = timestamp
+
+	return nil
+}
+
+func (m *mockDevControls) IncreaseTime(seconds uint64) uint64 {
+	m.timeOffset += seconds
+
+	return m.timeOffset
+}
+
+func (m *mockDevControls) Snapshot() uint64 {
+	m.snapshots++
+
+	return m.snapshots
+}
+
+func (m *mockDevControls) Revert(id uint64) (bool, error) {
+	if id == 0 || id > m.snapshots {
+		return false, nil
+	}
+
+	m.snapshots = id - 1
+
+	return true, nil
+}
+
+func (m *mockDevControls) SetBalance(addr types.Address, balance *big.Int) error {
+	m.balances[addr] = balance
+
+	return nil
+}
+
+func (m *mockDevControls) SetCode(addr types.Address, code []byte) error {
+	m.codes[addr] = code
+
+	return nil
+}
+
+func (m *mockDevControls) SetStorageAt(_ types.Address, key, value types.Hash) error {
+	m.storage[key] = value
+
+	return nil
+}
+
+func (m *mockDevControls) Impersonate(addr types.Address) {
+	m.impersonated[addr] = true
+}
+
+func (m *mockDevControls) StopImpersonating(addr types.Address) {
+	delete(m.impersonated, addr)
+}
+
+func (m *mockDevControls) IsImpersonated(addr types.Address) bool {
+	return m.impersonated[addr]
+}
+
+func (m *mockDevControls) SendImpersonatedTx(tx *types.Transaction) (types.Hash, error) {
+	m.sent = append(m.sent, tx)
+
+	return tx.Hash, nil
+}
+
+func (m *mockDevControls) SetAutomine(enabled bool) {
+	m.automine = enabled
+}
+
+func TestEvm_Controls(t *testing.T) {
+	t.Parallel()
+
+	controls := newMockDevControls()
+	evm := &Evm{controls: controls}
+
+	_, err := evm.Mine(nil)
+	require.NoError(t, err)
+
+	timestamp := argTime(1000)
+
+	res, err := evm.Mine(&timestamp)
+	require.NoError(t, err)
+	assert.Equal(t, "0x0", res)
+	assert.Equal(t, []uint64{0, 1000}, controls.mined)
+
+	_, err = evm.SetNextBlockTimestamp(0)
+	assert.Error(t, err)
+
+	_, err = evm.SetNextBlockTimestamp(2000)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2000), controls.nextTimestamp)
+
+	var seconds argTime
+
+	// the time is given either as a number or as a hex string
+	require.NoError(t, json.Unmarshal([]byte(`60`), &seconds))
+	assert.Equal(t, argTime(60), seconds)
+
+	_, err = evm.IncreaseTime(seconds)
+	require.NoError(t, err)
+
+	require.NoError(t, json.Unmarshal([]byte(`"0x1e"`), &seconds))
+
+	res, err = evm.IncreaseTime(seconds)
+	require.NoError(t, err)
+	assert.Equal(t, argUint64(90), res)
+
+	first, err := evm.Snapshot()
+	require.NoError(t, err)
+	assert.Equal(t, argUint64(1), first)
+
+	_, err = evm.Snapshot()
+	require.NoError(t, err)
+
+	res, err = evm.Revert(1)
+	require.NoError(t, err)
+	assert.Equal(t, true, res)
+
+	// the later snapshots are discarded along with the reverted one
+	res, err = evm.Revert(2)
+	require.NoError(t, err)
+	assert.Equal(t, false, res)
+
+	_, err = evm.SetAutomine(false)
+	require.NoError(t, err)
+	assert.False(t, controls.automine)
+}
+
+func TestDev_Controls(t *testing.T) {
+	t.Parallel()
+
+	controls := newMockDevControls()
+	dev := &Dev{controls: controls}
+
+	_, err := dev.SetBalance(addr1, argBig(*big.NewInt(100)))
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(100), controls.balances[addr1])
+
+	_, err = dev.SetCode(addr1, argBytes{0x1, 0x2})
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0x1, 0x2}, controls.codes[addr1])
+
+	key, value := types.StringToHash("0x1"), types.StringToHash("0x2")
+
+	_, err = dev.SetStorageAt(addr1, key, value)
+	require.NoError(t, err)
+	assert.Equal(t, value, controls.storage[key])
+
+	_, err = dev.ImpersonateAccount(addr1)
+	require.NoError(t, err)
+	assert.True(t, controls.IsImpersonated(addr1))
+
+	_, err = dev.StopImpersonatingAccount(addr1)
+	require.NoError(t, err)
+	assert.False(t, controls.IsImpersonated(addr1))
+}
+
+func TestEth_SendTransaction_Impersonated(t *testing.T) {
+	t.Parallel()
+
+	controls := newMockDevControls()
+	controls.Impersonate(addr1)
+
+	store := &mockAccountsStore{nonce: 3}
+	eth := newTestEthEndpoint(store)
+	eth.devControls = controls
+
+	// the impersonated transactions are sent without the node-managed accounts
+	res, err := eth.SendTransaction(&txnArgs{
+		From: &addr1,
+		To:   &addr2,
+		Gas:  argUintPtr(21000),
+	})
+	require.NoError(t, err)
+	require.Len(t, controls.sent, 1)
+	assert.Empty(t, store.added)
+
+	tx := controls.sent[0]
+	assert.Equal(t, tx.Hash.String(), res)
+	assert.Equal(t, addr1, tx.From)
+	assert.Equal(t, uint64(3), tx.Nonce)
+	assert.Equal(t, big.NewInt(7), tx.GasPrice)
+
+	_, err = eth.SendTransaction(&txnArgs{From: &addr2, To: &addr1, Gas: argUintPtr(21000)})
+	assert.ErrorIs(t, err, ErrAccountsDisabled)
+}
+
+func TestDispatcher_DevNamespaces(t *testing.T) {
+	t.Parallel()
+
+	d := newDispatcher(hclog.NewNullLogger(), newMockStore(), &dispatcherParams{})
+
+	for _, namespace := range []string{"evm", "dev"} {
+		_, ok := d.serviceMap[namespace]
+		assert.False(t, ok)
+	}
+
+	d = newDispatcher(hclog.NewNullLogger(), newMockStore(), &dispatcherParams{
+		devControls: newMockDevControls(),
+	})
+
+	for _, namespace := range []string{"evm", "dev"} {
+		_, ok := d.serviceMap[namespace]
+		assert.True(t, ok)
+	}
+
+	assert.Equal(t, d.params.devControls, d.endpoints.Eth.devControls)
+}
@@ -8,6 +8,7 @@ import (
 	"math"
 	"reflect"
 	"strings"
+	"time"
 	"unicode"
 
 	"github.com/hashicorp/go-hclog"
@@ -30,12 +31,24 @@ func (f *funcData) numParams() int {
 }
 
 type endpoints struct {
-	Eth    *Eth
-	Web3   *Web3
-	Net    *Net
-	TxPool *TxPool
-	Bridge *Bridge
-	Debug  *Debug
+	Eth     *Eth
+	Web3    *Web3
+	Net     *Net
+	TxPool  *TxPool
+	Bridge  *Bridge
+	PolyBFT *PolyBFT
+	Debug   *Debug
+	Admin   *Admin
+
+	// Personal is set only if the node-managed accounts are enabled
+	Personal *Personal
+
+	// Evm and Dev are set only if the dev consensus controls are enabled
+	Evm *Evm
+	Dev *Dev
+
+	// GraphQL is set only if the GraphQL endpoint is enabled
+	GraphQL *GraphQL
 }
 
 // Dispatcher handles all json rpc requests by delegating
@@ -45,6 +58,8 @@ type Dispatcher struct {
 	serviceMap    map[string]*serviceData
 	filterManager *FilterManager
 	endpoints     endpoints
+	rateLimiter   *rateLimiter
+	responseCache *responseCache
 
 	params *dispatcherParams
 }
@@ -56,6 +71,14 @@ type dispatcherParams struct {
 	priceLimit              uint64
 	jsonRPCBatchLengthLimit uint64
 	blockRangeLimit         uint64
+	rateLimit               *RateLimitConfig
+	accounts                AccountManager
+	devControls             DevControls
+	graphQL                 bool
+	simulateGasCap          uint64
+	simulateTimeout         time.Duration
+	responseCacheSize       int
+	slowRequestThreshold    time.Duration
 }
 
 func newDispatcher(
@@ -64,13 +87,24 @@ func newDispatcher(
 	params *dispatcherParams,
 ) *Dispatcher {
 	d := &Dispatcher{
-		logger: logger.Named("dispatcher"),
-		params: params,
+		logger:      logger.Named("dispatcher"),
+		params:      params,
+		rateLimiter: newRateLimiter(params.rateLimit),
 	}
 
 	if store != nil {
 		d.filterManager = NewFilterManager(logger, store, params.blockRangeLimit)
 		go d.filterManager.Run()
+
+		if params.responseCacheSize > 0 {
+			cache, err := newResponseCache(logger, store, params.responseCacheSize)
+			if err != nil {
+				d.logger.Error("unable to create the response cache", "err", err)
+			} else {
+				d.responseCache = cache
+				go d.responseCache.Run()
+			}
+		}
 	}
 
 	d.registerEndpoints(store)
@@ -78,6 +112,17 @@ func newDispatcher(
 	return d
 }
 
+// Close stops the background routines of the dispatcher
+func (d *Dispatcher) Close() {
+	if d.filterManager != nil {
+		d.filterManager.Close()
+	}
+
+	if d.responseCache != nil {
+		d.responseCache.Close()
+	}
+}
+
 func (d *Dispatcher) registerEndpoints(store JSONRPCStore) {
 	d.endpoints.Eth = &Eth{
 		d.logger,
@@ -85,6 +130,10 @@ func (d *Dispatcher) registerEndpoints(store JSONRPCStore) {
 		d.params.chainID,
 		d.filterManager,
 		d.params.priceLimit,
+		d.params.accounts,
+		d.params.simulateGasCap,
+		d.params.simulateTimeout,
+		d.params.devControls,
 	}
 	d.endpoints.Net = &Net{
 		store,
@@ -100,19 +149,184 @@ func (d *Dispatcher) registerEndpoints(store JSONRPCStore) {
 	d.endpoints.Bridge = &Bridge{
 		store,
 	}
+	d.endpoints.PolyBFT = &PolyBFT{
+		store,
+	}
 	d.endpoints.Debug = &Debug{
 		store,
 	}
+	d.endpoints.Admin = &Admin{
+		store:   store,
+		chainID: d.params.chainID,
+	}
 
 	d.registerService("eth", d.endpoints.Eth)
 	d.registerService("net", d.endpoints.Net)
 	d.registerService("web3", d.endpoints.Web3)
 	d.registerService("txpool", d.endpoints.TxPool)
 	d.registerService("bridge", d.endpoints.Bridge)
+	d.registerService("polybft", d.endpoints.PolyBFT)
 	d.registerService("debug", d.endpoints.Debug)
+	d.registerService(adminNamespace, d.endpoints.Admin)
+
+	if d.params.accounts != nil {
+		d.endpoints.Personal = &Personal{
+			accounts: d.params.accounts,
+		}
+		d.registerService(personalNamespace, d.endpoints.Personal)
+	}
+
+	if d.params.devControls != nil {
+		d.endpoints.Evm = &Evm{
+			controls: d.params.devControls,
+		}
+		d.endpoints.Dev = &Dev{
+			controls: d.params.devControls,
+		}
+		d.registerService("evm", d.endpoints.Evm)
+		d.registerService("dev", d.endpoints.Dev)
+	}
+
+	if d.params.graphQL {
+		d.endpoints.GraphQL = newGraphQL(store, d.endpoints.Eth, d.params.blockRangeLimit)
+	}
+}
+
+// requestScope holds the details of the listener a request has been received on
+type requestScope struct {
+	// namespaces is the set of namespaces enabled on the listener,
+	// nil if every registered namespace is enabled
+	namespaces map[string]struct{}
+
+	// client identifies the request sender (API key or IP) for the rate limiting
+	client string
+
+	// authenticated is set if the listener requires the jwt authentication, or is the IPC one
+	authenticated bool
 }
 
-func (d *Dispatcher) getFnHandler(req Request) (*serviceData, *funcData, Error) {
+// newRequestScope creates a scope which enables the given namespaces, or every namespace if none is given
+func newRequestScope(namespaces []string) *requestScope {
+	scope := &requestScope{}
+
+	if len(namespaces) == 0 {
+		return scope
+	}
+
+	scope.namespaces = make(map[string]struct{}, len(namespaces))
+
+	for _, namespace := range namespaces {
+		scope.namespaces[namespace] = struct{}{}
+	}
+
+	return scope
+}
+
+// isEnabled checks if the namespace is exposed within the scope
+func (s *requestScope) isEnabled(namespace string) bool {
+	if s == nil || s.namespaces == nil {
+		return true
+	}
+
+	_, ok := s.namespaces[namespace]
+
+	return ok
+}
+
+// withClient returns a copy of the scope bound to the given client
+func (s *requestScope) withClient(client string) *requestScope {
+	if s == nil {
+		return &requestScope{client: client}
+	}
+
+	scoped := *s
+	scoped.client = client
+
+	return &scoped
+}
+
+// isAuthenticated checks if the requests of the scope come from the authenticated senders
+func (s *requestScope) isAuthenticated() bool {
+	return s == nil || s.authenticated
+}
+
+// clientID returns the identifier of the request sender
+func (s *requestScope) clientID() string {
+	if s == nil {
+		return ""
+	}
+
+	return s.client
+}
+
+// limit charges the request sender for the method call, if the rate limiting is enabled.
+// The returned release function must be called once the call is done
+func (d *Dispatcher) limit(method string, scope *requestScope) (func(), Error) {
+	if d.rateLimiter == nil {
+		return func() {}, nil
+	}
+
+	return d.rateLimiter.acquire(scope.clientID(), method, time.Now())
+}
+
+// listenerScope creates the scope of the listener exposing the given namespaces, or every namespace if none
+// is given. The admin and personal namespaces are exposed only if the listener requires authentication
+func (d *Dispatcher) listenerScope(listener string, namespaces []string, authenticated bool) (*requestScope, error) {
+	if len(namespaces) == 0 {
+		for serviceName := range d.serviceMap {
+			if !isRestrictedNamespace(serviceName) || authenticated {
+				namespaces = append(namespaces, serviceName)
+			}
+		}
+
+		return newAuthenticatedScope(namespaces, authenticated), nil
+	}
+
+	for _, namespace := range namespaces {
+		if _, ok := d.serviceMap[namespace]; !ok {
+			return nil, fmt.Errorf("unknown namespace '%s' enabled on %s listener", namespace, listener)
+		}
+
+		if isRestrictedNamespace(namespace) && !authenticated {
+			return nil, fmt.Errorf("%s namespace enabled on %s listener requires jwt authentication", namespace, listener)
+		}
+	}
+
+	return newAuthenticatedScope(namespaces, authenticated), nil
+}
+
+// newAuthenticatedScope creates the scope of the given namespaces, marked as authenticated if set
+func newAuthenticatedScope(namespaces []string, authenticated bool) *requestScope {
+	scope := newRequestScope(namespaces)
+	scope.authenticated = authenticated
+
+	return scope
+}
+
+// isRestrictedNamespace returns true if the namespace can't be served on the unauthenticated listeners
+func isRestrictedNamespace(namespace string) bool {
+	return namespace == adminNamespace || namespace == personalNamespace
+}
+
+// restrictedMethods are the methods of the unrestricted namespaces which use the node-managed accounts
+// or the block building of the node (the bundles are put at the top of its blocks, ahead of the pool),
+// so they can't be served on the unauthenticated listeners either
+var restrictedMethods = map[string]struct{}{
+	"eth_sendTransaction":  {},
+	"eth_signTransaction":  {},
+	"eth_sign":             {},
+	"eth_signTypedData_v4": {},
+	"eth_sendBundle":       {},
+}
+
+// isRestrictedMethod returns true if the method can't be served on the unauthenticated listeners
+func isRestrictedMethod(method string) bool {
+	_, ok := restrictedMethods[method]
+
+	return ok
+}
+
+func (d *Dispatcher) getFnHandler(req Request, scope *requestScope) (*serviceData, *funcData, Error) {
 	callName := strings.SplitN(req.Method, "_", 2)
 	if len(callName) != 2 {
 		return nil, nil, NewMethodNotFoundError(req.Method)
@@ -120,6 +334,14 @@ func (d *Dispatcher) getFnHandler(req Request) (*serviceData, *funcData, Error)
 
 	serviceName, funcName := callName[0], callName[1]
 
+	if !scope.isEnabled(serviceName) {
+		return nil, nil, NewMethodNotFoundError(req.Method)
+	}
+
+	if isRestrictedMethod(req.Method) && !scope.isAuthenticated() {
+		return nil, nil, NewMethodNotFoundError(req.Method)
+	}
+
 	service, ok := d.serviceMap[serviceName]
 	if !ok {
 		return nil, nil, NewMethodNotFoundError(req.Method)
@@ -174,15 +396,33 @@ func (d *Dispatcher) handleSubscribe(req Request, conn wsConn) (string, Error) {
 	}
 
 	var filterID string
-	if subscribeMethod == "newHeads" {
+
+	switch subscribeMethod {
+	case "newHeads":
 		filterID = d.filterManager.NewBlockFilter(conn)
-	} else if subscribeMethod == "logs" {
+	case "logs":
 		logQuery, err := decodeLogQueryFromInterface(params[1])
 		if err != nil {
 			return "", NewInternalError(err.Error())
 		}
 		filterID = d.filterManager.NewLogFilter(logQuery, conn)
-	} else {
+	case "newPendingTransactions":
+		fullTx := false
+
+		if len(params) > 1 {
+			if fullTx, ok = params[1].(bool); !ok {
+				return "", NewInvalidParamsError("Invalid params")
+			}
+		}
+
+		filterID = d.filterManager.NewPendingTxFilter(fullTx, conn)
+	case "syncing":
+		var err error
+
+		if filterID, err = d.filterManager.NewSyncingFilter(conn); err != nil {
+			return "", NewInternalError(err.Error())
+		}
+	default:
 		return "", NewSubscriptionNotFoundError(subscribeMethod)
 	}
 
@@ -211,12 +451,26 @@ func (d *Dispatcher) RemoveFilterByWs(conn wsConn) {
 	d.filterManager.RemoveFilterByWs(conn)
 }
 
-func (d *Dispatcher) HandleWs(reqBody []byte, conn wsConn) ([]byte, error) {
+func (d *Dispatcher) HandleWs(reqBody []byte, conn wsConn, scope *requestScope) ([]byte, error) {
 	var req Request
 	if err := json.Unmarshal(reqBody, &req); err != nil {
 		return NewRPCResponse(req.ID, "2.0", nil, NewInvalidRequestError("Invalid json request")).Bytes()
 	}
 
+	// subscriptions belong to the eth namespace
+	if (req.Method == "eth_subscribe" || req.Method == "eth_unsubscribe") && !scope.isEnabled("eth") {
+		return NewRPCResponse(req.ID, "2.0", nil, NewMethodNotFoundError(req.Method)).Bytes()
+	}
+
+	if req.Method == "eth_subscribe" || req.Method == "eth_unsubscribe" {
+		release, err := d.limit(req.Method, scope)
+		if err != nil {
+			return NewRPCResponse(req.ID, "2.0", nil, err).Bytes()
+		}
+
+		defer release()
+	}
+
 	// if the request method is eth_subscribe we need to create a
 	// new filter with ws connection
 	if req.Method == "eth_subscribe" {
@@ -253,16 +507,19 @@ func (d *Dispatcher) HandleWs(reqBody []byte, conn wsConn) ([]byte, error) {
 		return []byte(resp), nil
 	}
 
-	// its a normal query that we handle with the dispatcher
-	resp, err := d.handleReq(req)
-	if err != nil {
-		return nil, err
+	transport := transportWS
+	if _, ok := conn.(*ipcConn); ok {
+		transport = transportIPC
 	}
 
+	// its a normal query that we handle with the dispatcher,
+	// errors (e.g. exceeded limits) are reported to the peer as JSON-RPC errors
+	resp, err := d.handleReq(req, scope, transport)
+
 	return NewRPCResponse(req.ID, "2.0", resp, err).Bytes()
 }
 
-func (d *Dispatcher) Handle(reqBody []byte) ([]byte, error) {
+func (d *Dispatcher) Handle(reqBody []byte, scope *requestScope) ([]byte, error) {
 	x := bytes.TrimLeft(reqBody, " \t\r\n")
 	if len(x) == 0 {
 		return NewRPCResponse(nil, "2.0", nil, NewInvalidRequestError("Invalid json request")).Bytes()
@@ -278,7 +535,7 @@ func (d *Dispatcher) Handle(reqBody []byte) ([]byte, error) {
 			return NewRPCResponse(req.ID, "2.0", nil, NewInvalidRequestError("Invalid json request")).Bytes()
 		}
 
-		resp, err := d.handleReq(req)
+		resp, err := d.handleReq(req, scope, transportHTTP)
 
 		return NewRPCResponse(req.ID, "2.0", resp, err).Bytes()
 	}
@@ -307,7 +564,7 @@ func (d *Dispatcher) Handle(reqBody []byte) ([]byte, error) {
 	responses := make([]Response, 0)
 
 	for _, req := range requests {
-		var response, err = d.handleReq(req)
+		var response, err = d.handleReq(req, scope, transportBatch)
 		if err != nil {
 			errorResponse := NewRPCResponse(req.ID, "2.0", nil, err)
 			responses = append(responses, errorResponse)
@@ -327,14 +584,31 @@ func (d *Dispatcher) Handle(reqBody []byte) ([]byte, error) {
 	return respBytes, nil
 }
 
-func (d *Dispatcher) handleReq(req Request) ([]byte, Error) {
+// handleReq handles the single request received over the given transport and records its metrics
+func (d *Dispatcher) handleReq(req Request, scope *requestScope, transport string) ([]byte, Error) {
 	d.logger.Debug("request", "method", req.Method, "id", req.ID)
 
-	service, fd, ferr := d.getFnHandler(req)
+	start := time.Now()
+	data, err := d.callHandler(req, scope)
+	d.observeRequest(req, transport, time.Since(start), err)
+
+	return data, err
+}
+
+// callHandler calls the handler of the request method
+func (d *Dispatcher) callHandler(req Request, scope *requestScope) ([]byte, Error) {
+	service, fd, ferr := d.getFnHandler(req, scope)
 	if ferr != nil {
 		return nil, ferr
 	}
 
+	release, lerr := d.limit(req.Method, scope)
+	if lerr != nil {
+		return nil, lerr
+	}
+
+	defer release()
+
 	inArgs := make([]reflect.Value, fd.inNum)
 	inArgs[0] = service.sv
 
@@ -352,10 +626,23 @@ func (d *Dispatcher) handleReq(req Request) ([]byte, Error) {
 		}
 	}
 
+	cached := d.responseCache != nil && d.responseCache.isCached(req.Method)
+	if cached {
+		if data, ok := d.responseCache.get(req.Method, inputs); ok {
+			return data, nil
+		}
+	}
+
 	output := fd.fv.Call(inArgs)
 	if err := getError(output[1]); err != nil {
 		d.logInternalError(req.Method, err)
 
+		// the errors carrying the data, such as the revert payload, keep their code and data
+		var dataErr DataError
+		if errors.As(err, &dataErr) {
+			return nil, dataErr
+		}
+
 		return nil, NewInvalidRequestError(err.Error())
 	}
 
@@ -371,6 +658,10 @@ func (d *Dispatcher) handleReq(req Request) ([]byte, Error) {
 
 			return nil, NewInternalError("Internal error")
 		}
+
+		if cached {
+			d.responseCache.put(req.Method, inputs, res, data)
+		}
 	}
 
 	return data, nil
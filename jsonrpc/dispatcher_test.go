@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/0xPolygon/polygon-edge/txpool/proto"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/hashicorp/go-hclog"
 	"github.com/stretchr/testify/assert"
@@ -79,7 +80,7 @@ func TestDispatcher_HandleWebsocketConnection_EthSubscribe(t *testing.T) {
 		"method": "eth_subscribe",
 		"params": ["newHeads"]
 	}`)
-		if _, err := dispatcher.HandleWs(req, mockConnection); err != nil {
+		if _, err := dispatcher.HandleWs(req, mockConnection, nil); err != nil {
 			t.Fatal(err)
 		}
 
@@ -99,6 +100,63 @@ func TestDispatcher_HandleWebsocketConnection_EthSubscribe(t *testing.T) {
 			t.Fatal("\"newHeads\" event not received in 2 seconds")
 		}
 	})
+
+	t.Run("clients should be able to receive \"newPendingTransactions\" event thru eth_subscribe", func(t *testing.T) {
+		t.Parallel()
+
+		store := newMockStore()
+		dispatcher := newDispatcher(
+			hclog.NewNullLogger(),
+			store,
+			&dispatcherParams{
+				chainID:                 0,
+				priceLimit:              0,
+				jsonRPCBatchLengthLimit: 20,
+				blockRangeLimit:         1000,
+			},
+		)
+
+		mockConnection, msgCh := newMockWsConnWithMsgCh()
+
+		req := []byte(`{
+		"method": "eth_subscribe",
+		"params": ["newPendingTransactions"]
+	}`)
+		if _, err := dispatcher.HandleWs(req, mockConnection, nil); err != nil {
+			t.Fatal(err)
+		}
+
+		store.emitTxPoolEvent(proto.EventType_ADDED, &types.Transaction{Hash: types.StringToHash("1")})
+
+		select {
+		case <-msgCh:
+		case <-time.After(2 * time.Second):
+			t.Fatal("\"newPendingTransactions\" event not received in 2 seconds")
+		}
+	})
+
+	t.Run("\"newPendingTransactions\" full transaction flag should be a boolean", func(t *testing.T) {
+		t.Parallel()
+
+		dispatcher := newDispatcher(
+			hclog.NewNullLogger(),
+			newMockStore(),
+			&dispatcherParams{},
+		)
+
+		mockConnection, _ := newMockWsConnWithMsgCh()
+
+		req := []byte(`{
+		"method": "eth_subscribe",
+		"params": ["newPendingTransactions", "yes"]
+	}`)
+
+		resp, err := dispatcher.HandleWs(req, mockConnection, nil)
+		assert.NoError(t, err)
+
+		var res interface{}
+		assert.Error(t, expectJSONResult(resp, &res))
+	})
 }
 
 func TestDispatcher_WebsocketConnection_RequestFormats(t *testing.T) {
@@ -161,7 +219,7 @@ func TestDispatcher_WebsocketConnection_RequestFormats(t *testing.T) {
 		},
 	}
 	for _, c := range cases {
-		data, err := dispatcher.HandleWs(c.msg, mockConnection)
+		data, err := dispatcher.HandleWs(c.msg, mockConnection, nil)
 		resp := new(SuccessResponse)
 		merr := json.Unmarshal(data, resp)
 
@@ -230,7 +288,7 @@ func TestDispatcherFuncDecode(t *testing.T) {
 		_, err := dispatcher.handleReq(Request{
 			Method: "mock_" + typ,
 			Params: []byte(msg),
-		})
+		}, nil, transportHTTP)
 		assert.NoError(t, err)
 
 		return <-srv.msgCh
@@ -248,6 +306,16 @@ func TestDispatcherFuncDecode(t *testing.T) {
 			`["earliest"]`,
 			EarliestBlockNumber,
 		},
+		{
+			"block",
+			`["safe"]`,
+			SafeBlockNumber,
+		},
+		{
+			"block",
+			`["finalized"]`,
+			FinalizedBlockNumber,
+		},
 		{
 			"block",
 			`["latest"]`,
@@ -290,7 +358,7 @@ func TestDispatcherFuncDecode(t *testing.T) {
 
 func TestDispatcherBatchRequest(t *testing.T) {
 	handle := func(dispatcher *Dispatcher, reqBody []byte) []byte {
-		res, _ := dispatcher.Handle(reqBody)
+		res, _ := dispatcher.Handle(reqBody, nil)
 
 		return res
 	}
@@ -455,3 +523,109 @@ func TestDispatcherBatchRequest(t *testing.T) {
 		}
 	}
 }
+
+func TestDispatcher_RequestScope(t *testing.T) {
+	t.Parallel()
+
+	dispatcher := newDispatcher(
+		hclog.NewNullLogger(),
+		newMockStore(),
+		&dispatcherParams{
+			chainID:                 1,
+			jsonRPCBatchLengthLimit: 20,
+		},
+	)
+
+	scope := newRequestScope([]string{"web3"})
+
+	resp, err := dispatcher.Handle([]byte(`{"method": "web3_clientVersion", "params": []}`), scope)
+	assert.NoError(t, err)
+
+	var res interface{}
+	assert.NoError(t, expectJSONResult(resp, &res))
+
+	// namespace which is not enabled in the scope
+	resp, err = dispatcher.Handle([]byte(`{"method": "net_version", "params": []}`), scope)
+	assert.NoError(t, err)
+
+	var errResp ErrorResponse
+
+	assert.NoError(t, json.Unmarshal(resp, &errResp))
+	assert.Equal(t, -32601, errResp.Error.Code)
+
+	// namespace which is not enabled in the scope within a batch
+	resp, err = dispatcher.Handle([]byte(`[
+		{"id": 1, "method": "web3_clientVersion", "params": []},
+		{"id": 2, "method": "net_version", "params": []}
+	]`), scope)
+	assert.NoError(t, err)
+
+	var batchResp []SuccessResponse
+
+	assert.NoError(t, expectBatchJSONResult(resp, &batchResp))
+	assert.Len(t, batchResp, 2)
+	assert.Nil(t, batchResp[0].Error)
+	assert.Equal(t, -32601, batchResp[1].Error.Code)
+
+	// subscriptions are denied if eth namespace is not enabled
+	mockConnection, _ := newMockWsConnWithMsgCh()
+
+	resp, err = dispatcher.HandleWs([]byte(`{"method": "eth_subscribe", "params": ["newHeads"]}`), mockConnection, scope)
+	assert.NoError(t, err)
+	assert.NoError(t, json.Unmarshal(resp, &errResp))
+	assert.Equal(t, -32601, errResp.Error.Code)
+}
+
+func TestDispatcher_RateLimit(t *testing.T) {
+	t.Parallel()
+
+	dispatcher := newDispatcher(
+		hclog.NewNullLogger(),
+		newMockStore(),
+		&dispatcherParams{
+			chainID:                 1,
+			jsonRPCBatchLengthLimit: 20,
+			rateLimit: &RateLimitConfig{
+				Rate:  0.001,
+				Burst: 2,
+			},
+		},
+	)
+
+	alice := newRequestScope(nil).withClient("alice")
+	bob := newRequestScope(nil).withClient("bob")
+
+	// the limits apply to the batch entries
+	resp, err := dispatcher.Handle([]byte(`[
+		{"id": 1, "method": "web3_clientVersion", "params": []},
+		{"id": 2, "method": "web3_clientVersion", "params": []},
+		{"id": 3, "method": "web3_clientVersion", "params": []}
+	]`), alice)
+	assert.NoError(t, err)
+
+	var batchResp []SuccessResponse
+
+	assert.NoError(t, expectBatchJSONResult(resp, &batchResp))
+	assert.Len(t, batchResp, 3)
+	assert.Nil(t, batchResp[0].Error)
+	assert.Nil(t, batchResp[1].Error)
+	assert.Equal(t, -32005, batchResp[2].Error.Code)
+
+	// as well as to the websocket sessions
+	mockConnection, _ := newMockWsConnWithMsgCh()
+
+	resp, err = dispatcher.HandleWs([]byte(`{"method": "web3_clientVersion", "params": []}`), mockConnection, alice)
+	assert.NoError(t, err)
+
+	var errResp ErrorResponse
+
+	assert.NoError(t, json.Unmarshal(resp, &errResp))
+	assert.Equal(t, -32005, errResp.Error.Code)
+
+	// other clients have their own buckets
+	resp, err = dispatcher.Handle([]byte(`{"method": "web3_clientVersion", "params": []}`), bob)
+	assert.NoError(t, err)
+
+	var res interface{}
+	assert.NoError(t, expectJSONResult(resp, &res))
+}
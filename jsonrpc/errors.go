@@ -1,8 +1,10 @@
 package jsonrpc
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"math/big"
 
 	"github.com/0xPolygon/polygon-edge/state/runtime"
 	"github.com/umbracle/ethgo/abi"
@@ -64,6 +66,18 @@ func (e *subscriptionNotFoundError) ErrorCode() int {
 	return -32601
 }
 
+type limitExceededError struct {
+	err string
+}
+
+func (e *limitExceededError) Error() string {
+	return e.err
+}
+
+func (e *limitExceededError) ErrorCode() int {
+	return -32005
+}
+
 type methodNotFoundError struct {
 	err string
 }
@@ -90,15 +104,95 @@ func NewInternalError(msg string) *internalError {
 	return &internalError{msg}
 }
 
+func NewLimitExceededError(msg string) *limitExceededError {
+	return &limitExceededError{msg}
+}
+
 func NewSubscriptionNotFoundError(method string) *subscriptionNotFoundError {
 	return &subscriptionNotFoundError{fmt.Sprintf("subscribe method %s not found", method)}
 }
 
+// DataError is the error carrying the additional data, which is returned in the data field of the error object
+type DataError interface {
+	Error
+	ErrorData() interface{}
+}
+
+// executionRevertedCode is the error code of the reverted executions
+const executionRevertedCode = 3
+
+// revertError is the error of the reverted execution, carrying the revert payload
+type revertError struct {
+	err  error
+	msg  string
+	data argBytes
+}
+
+func (e *revertError) Error() string {
+	return e.msg
+}
+
+func (e *revertError) Unwrap() error {
+	return e.err
+}
+
+func (e *revertError) ErrorCode() int {
+	return executionRevertedCode
+}
+
+func (e *revertError) ErrorData() interface{} {
+	return e.data
+}
+
 func constructErrorFromRevert(result *runtime.ExecutionResult) error {
-	revertErrMsg, unpackErr := abi.UnpackRevertError(result.ReturnValue)
-	if unpackErr != nil {
-		return result.Err
+	msg := result.Err.Error()
+	if reason, ok := decodeRevertReason(result.ReturnValue); ok {
+		msg = fmt.Sprintf("%s: %s", msg, reason)
+	}
+
+	return &revertError{
+		err:  result.Err,
+		msg:  msg,
+		data: argBytes(result.ReturnValue),
+	}
+}
+
+var (
+	// panicSelector is the selector of the Panic(uint256) revert payload
+	panicSelector = []byte{0x4e, 0x48, 0x7b, 0x71}
+
+	// panicReasons are the descriptions of the Solidity panic codes
+	panicReasons = map[uint64]string{
+		0x00: "generic panic",
+		0x01: "assert(false)",
+		0x11: "arithmetic underflow or overflow",
+		0x12: "division or modulo by zero",
+		0x21: "enum overflow",
+		0x22: "invalid encoded storage byte array accessed",
+		0x31: "out-of-bounds array access; popping on an empty array",
+		0x32: "out-of-bounds access of an array or bytesN",
+		0x41: "out of memory",
+		0x51: "uninitialized function",
+	}
+)
+
+// decodeRevertReason decodes the Error(string) and the Panic(uint256) revert payloads
+func decodeRevertReason(data []byte) (string, bool) {
+	if len(data) == len(panicSelector)+32 && bytes.Equal(data[:len(panicSelector)], panicSelector) {
+		code := new(big.Int).SetBytes(data[len(panicSelector):])
+
+		reason, ok := panicReasons[code.Uint64()]
+		if !ok || !code.IsUint64() {
+			reason = "unknown panic code"
+		}
+
+		return fmt.Sprintf("panic: %s (0x%x)", reason, code), true
+	}
+
+	reason, err := abi.UnpackRevertError(data)
+	if err != nil {
+		return "", false
 	}
 
-	return fmt.Errorf("%w: %s", result.Err, revertErrMsg)
+	return reason, true
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/0xPolygon/polygon-edge/blockchain"
 	"github.com/0xPolygon/polygon-edge/helper/progress"
 	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/txpool/proto"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/stretchr/testify/assert"
 )
@@ -95,7 +96,7 @@ func TestEth_Block_GetBlockTransactionCountByNumber(t *testing.T) {
 
 	assert.NoError(t, err)
 	assert.NotNil(t, res, "expected to return block, but got nil")
-	assert.Equal(t, res, 10)
+	assert.Equal(t, argUint64(10), res)
 }
 
 func TestEth_GetTransactionByHash(t *testing.T) {
@@ -525,6 +526,10 @@ func (m *mockBlockStore) SubscribeEvents() blockchain.Subscription {
 	return nil
 }
 
+func (m *mockBlockStore) TxPoolSubscribe(request *proto.SubscribeRequest) (<-chan *proto.TxPoolEvent, func(), error) {
+	return nil, func() {}, nil
+}
+
 func newTestBlock(number uint64, hash types.Hash) *types.Block {
 	return &types.Block{
 		Header: &types.Header{
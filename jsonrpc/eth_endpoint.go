@@ -4,11 +4,14 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"time"
 
 	"github.com/hashicorp/go-hclog"
 	"github.com/umbracle/fastrlp"
 
+	"github.com/0xPolygon/polygon-edge/accounts"
 	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/crypto"
 	"github.com/0xPolygon/polygon-edge/helper/common"
 	"github.com/0xPolygon/polygon-edge/helper/progress"
 	"github.com/0xPolygon/polygon-edge/state"
@@ -20,6 +23,14 @@ type ethTxPoolStore interface {
 	// AddTx adds a new transaction to the tx pool
 	AddTx(tx *types.Transaction) error
 
+	// AddPrivateTx adds a new transaction to the tx pool without gossiping it,
+	// it can be included until the max block (the configured default applies if zero)
+	AddPrivateTx(tx *types.Transaction, maxBlock uint64) error
+
+	// AddBundle adds a bundle of the transactions included in a block of the range atomically,
+	// the reverting tx hashes are the transactions allowed to revert. Returns the bundle hash.
+	AddBundle(txs []*types.Transaction, minBlock, maxBlock uint64, revertingTxHashes []types.Hash) (types.Hash, error)
+
 	// GetPendingTx gets the pending transaction from the transaction pool, if it's present
 	GetPendingTx(txHash types.Hash) (*types.Transaction, bool)
 
@@ -66,6 +77,9 @@ type ethBlockchainStore interface {
 
 	// GetSyncProgression retrieves the current sync progression, if any
 	GetSyncProgression() *progress.Progression
+
+	// BeginSimulation starts the simulation on top of the state of the header
+	BeginSimulation(header *types.Header) (Simulation, error)
 }
 
 // ethStore provides access to the methods needed by eth endpoint
@@ -82,12 +96,24 @@ type Eth struct {
 	chainID       uint64
 	filterManager *FilterManager
 	priceLimit    uint64
+	accounts      AccountManager
+
+	// simulateGasCap and simulateTimeout are the budgets of a single simulation, unlimited if 0
+	simulateGasCap  uint64
+	simulateTimeout time.Duration
+
+	// devControls impersonate the senders of eth_sendTransaction on the dev chains, if set
+	devControls DevControls
 }
 
 var (
 	ErrInsufficientFunds = errors.New("insufficient funds for execution")
+	ErrMissingSender     = errors.New("transaction sender is not specified")
 )
 
+// ethProtocolVersion is the version of the eth wire protocol reported to the clients
+const ethProtocolVersion = 65
+
 // ChainId returns the chain id of the client
 //
 //nolint:stylecheck
@@ -135,19 +161,160 @@ func (e *Eth) GetBlockByHash(hash types.Hash, fullTx bool) (interface{}, error)
 	return toBlock(block, fullTx), nil
 }
 
+// GetBlockTransactionCountByNumber returns the number of transactions in the block with the given number
 func (e *Eth) GetBlockTransactionCountByNumber(number BlockNumber) (interface{}, error) {
+	block, err := e.getBlockByNumber(number)
+	if err != nil || block == nil {
+		return nil, err
+	}
+
+	return argUint64(len(block.Transactions)), nil
+}
+
+// GetBlockTransactionCountByHash returns the number of transactions in the block with the given hash
+func (e *Eth) GetBlockTransactionCountByHash(hash types.Hash) (interface{}, error) {
+	block, ok := e.store.GetBlockByHash(hash, true)
+	if !ok {
+		return nil, nil
+	}
+
+	return argUint64(len(block.Transactions)), nil
+}
+
+// GetTransactionByBlockNumberAndIndex returns the transaction at the given index of the block with the given number
+func (e *Eth) GetTransactionByBlockNumberAndIndex(number BlockNumber, index argUint64) (interface{}, error) {
+	block, err := e.getBlockByNumber(number)
+	if err != nil || block == nil {
+		return nil, err
+	}
+
+	return blockTransactionAt(block, index), nil
+}
+
+// GetTransactionByBlockHashAndIndex returns the transaction at the given index of the block with the given hash
+func (e *Eth) GetTransactionByBlockHashAndIndex(hash types.Hash, index argUint64) (interface{}, error) {
+	block, ok := e.store.GetBlockByHash(hash, true)
+	if !ok {
+		return nil, nil
+	}
+
+	return blockTransactionAt(block, index), nil
+}
+
+// GetUncleCountByBlockNumber returns the number of uncles in the block with the given number
+func (e *Eth) GetUncleCountByBlockNumber(number BlockNumber) (interface{}, error) {
+	block, err := e.getBlockByNumber(number)
+	if err != nil || block == nil {
+		return nil, err
+	}
+
+	return argUint64(len(block.Uncles)), nil
+}
+
+// GetUncleCountByBlockHash returns the number of uncles in the block with the given hash
+func (e *Eth) GetUncleCountByBlockHash(hash types.Hash) (interface{}, error) {
+	block, ok := e.store.GetBlockByHash(hash, true)
+	if !ok {
+		return nil, nil
+	}
+
+	return argUint64(len(block.Uncles)), nil
+}
+
+// GetUncleByBlockNumberAndIndex returns the uncle at the given index of the block with the given number
+func (e *Eth) GetUncleByBlockNumberAndIndex(number BlockNumber, index argUint64) (interface{}, error) {
+	block, err := e.getBlockByNumber(number)
+	if err != nil || block == nil {
+		return nil, err
+	}
+
+	return blockUncleAt(block, index), nil
+}
+
+// GetUncleByBlockHashAndIndex returns the uncle at the given index of the block with the given hash
+func (e *Eth) GetUncleByBlockHashAndIndex(hash types.Hash, index argUint64) (interface{}, error) {
+	block, ok := e.store.GetBlockByHash(hash, true)
+	if !ok {
+		return nil, nil
+	}
+
+	return blockUncleAt(block, index), nil
+}
+
+// getBlockByNumber returns the block with the given number, or nil if it is not found
+func (e *Eth) getBlockByNumber(number BlockNumber) (*types.Block, error) {
 	num, err := GetNumericBlockNumber(number, e.store)
 	if err != nil {
 		return nil, err
 	}
 
 	block, ok := e.store.GetBlockByNumber(num, true)
-
 	if !ok {
 		return nil, nil
 	}
 
-	return len(block.Transactions), nil
+	return block, nil
+}
+
+// blockTransactionAt returns the transaction at the given index of the block, or nil if it is out of range
+func blockTransactionAt(block *types.Block, index argUint64) *transaction {
+	if uint64(index) >= uint64(len(block.Transactions)) {
+		return nil
+	}
+
+	idx := int(index)
+
+	return toTransaction(
+		block.Transactions[idx],
+		argUintPtr(block.Number()),
+		argHashPtr(block.Hash()),
+		&idx,
+	)
+}
+
+// blockUncleAt returns the uncle at the given index of the block, or nil if it is out of range
+func blockUncleAt(b *types.Block, index argUint64) *block {
+	if uint64(index) >= uint64(len(b.Uncles)) {
+		return nil
+	}
+
+	return toBlock(&types.Block{Header: b.Uncles[index]}, false)
+}
+
+// Accounts returns the list of addresses owned by the client,
+// which is empty unless the node-managed accounts are enabled
+func (e *Eth) Accounts() (interface{}, error) {
+	if e.accounts == nil {
+		return []types.Address{}, nil
+	}
+
+	addrs, err := e.accounts.Accounts()
+	if err != nil {
+		return nil, err
+	}
+
+	return addrs, nil
+}
+
+// ProtocolVersion returns the version of the eth wire protocol the client is compatible with
+func (e *Eth) ProtocolVersion() (interface{}, error) {
+	return argUint64(ethProtocolVersion), nil
+}
+
+// Coinbase returns the client's block reward address, which is always zero
+// as the block producers are determined by the consensus
+func (e *Eth) Coinbase() (interface{}, error) {
+	return types.ZeroAddress, nil
+}
+
+// Mining returns false, as the client doesn't mine proof-of-work blocks
+func (e *Eth) Mining() (interface{}, error) {
+	return false, nil
+}
+
+// Hashrate returns zero, as the client doesn't mine proof-of-work blocks
+func (e *Eth) Hashrate() (interface{}, error) {
+	return argUint64(0), nil
 }
 
 // BlockNumber returns current block number
@@ -176,10 +343,207 @@ func (e *Eth) SendRawTransaction(buf argBytes) (interface{}, error) {
 	return tx.Hash.String(), nil
 }
 
-// SendTransaction rejects eth_sendTransaction json-rpc call as we don't support wallet management
-func (e *Eth) SendTransaction(_ *txnArgs) (interface{}, error) {
-	return nil, fmt.Errorf("request calls to eth_sendTransaction method are not supported," +
-		" use eth_sendRawTransaction insead")
+// SendPrivateTransaction sends a raw transaction which is never gossiped.
+// It is included only in the blocks proposed by this node or its trusted peers, up to the max block number.
+func (e *Eth) SendPrivateTransaction(arg *privateTxArgs) (interface{}, error) {
+	tx := &types.Transaction{}
+	if err := tx.UnmarshalRLP(arg.Tx); err != nil {
+		return nil, err
+	}
+
+	tx.ComputeHash()
+
+	var maxBlock uint64
+	if arg.MaxBlockNumber != nil {
+		maxBlock = uint64(*arg.MaxBlockNumber)
+	}
+
+	if err := e.store.AddPrivateTx(tx, maxBlock); err != nil {
+		return nil, err
+	}
+
+	return tx.Hash.String(), nil
+}
+
+// SendBundle sends an ordered group of raw transactions, which are included at the top of a block
+// within the block number range either all together, or not at all. The bundles are not gossiped,
+// so they are only included in the blocks this node proposes
+func (e *Eth) SendBundle(arg *bundleArgs) (interface{}, error) {
+	txs := make([]*types.Transaction, len(arg.Txs))
+
+	for i, raw := range arg.Txs {
+		tx := &types.Transaction{}
+		if err := tx.UnmarshalRLP(raw); err != nil {
+			return nil, err
+		}
+
+		tx.ComputeHash()
+
+		txs[i] = tx
+	}
+
+	var minBlock, maxBlock uint64
+
+	if arg.MinBlockNumber != nil {
+		minBlock = uint64(*arg.MinBlockNumber)
+	}
+
+	if arg.MaxBlockNumber != nil {
+		maxBlock = uint64(*arg.MaxBlockNumber)
+	}
+
+	hash, err := e.store.AddBundle(txs, minBlock, maxBlock, arg.RevertingTxHashes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sendBundleResult{BundleHash: hash}, nil
+}
+
+// SendTransaction signs the transaction with the unlocked node-managed account and sends it.
+// The transactions of the accounts impersonated on the dev chain are sent unsigned
+func (e *Eth) SendTransaction(arg *txnArgs) (interface{}, error) {
+	if e.devControls != nil && arg != nil && arg.From != nil && e.devControls.IsImpersonated(*arg.From) {
+		return e.sendImpersonatedTransaction(arg)
+	}
+
+	tx, err := e.signTransaction(arg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := e.store.AddTx(tx); err != nil {
+		return nil, err
+	}
+
+	return tx.Hash.String(), nil
+}
+
+// sendImpersonatedTransaction hands the unsigned transaction of the impersonated account
+// over to the dev consensus, bypassing the pool
+func (e *Eth) sendImpersonatedTransaction(arg *txnArgs) (interface{}, error) {
+	tx, err := e.fillTransaction(arg)
+	if err != nil {
+		return nil, err
+	}
+
+	hash, err := e.devControls.SendImpersonatedTx(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return hash.String(), nil
+}
+
+// SignTransaction signs the transaction with the unlocked node-managed account,
+// returning it both RLP encoded and decoded
+func (e *Eth) SignTransaction(arg *txnArgs) (interface{}, error) {
+	tx, err := e.signTransaction(arg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &signTransactionResult{
+		Raw: tx.MarshalRLP(),
+		Tx:  toPendingTransaction(tx),
+	}, nil
+}
+
+// Sign signs the EIP-191 prefixed message with the unlocked node-managed account
+func (e *Eth) Sign(addr types.Address, data argBytes) (interface{}, error) {
+	if e.accounts == nil {
+		return nil, ErrAccountsDisabled
+	}
+
+	return e.signHash(addr, accounts.TextHash(data))
+}
+
+// SignTypedData_v4 signs the EIP-712 typed data with the unlocked node-managed account
+//
+//nolint:stylecheck
+func (e *Eth) SignTypedData_v4(addr types.Address, typedData accounts.TypedData) (interface{}, error) {
+	if e.accounts == nil {
+		return nil, ErrAccountsDisabled
+	}
+
+	hash, err := typedData.Hash()
+	if err != nil {
+		return nil, err
+	}
+
+	return e.signHash(addr, hash.Bytes())
+}
+
+// signHash signs the hash, returning the signature with the V value of 27 or 28
+func (e *Eth) signHash(addr types.Address, hash []byte) (interface{}, error) {
+	sig, err := e.accounts.SignHash(addr, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	sig[64] += 27
+
+	return argBytes(sig), nil
+}
+
+// signTransaction fills in the missing fields of the transaction,
+// and signs it with the unlocked node-managed account
+func (e *Eth) signTransaction(arg *txnArgs) (*types.Transaction, error) {
+	if e.accounts == nil {
+		return nil, ErrAccountsDisabled
+	}
+
+	tx, err := e.fillTransaction(arg)
+	if err != nil {
+		return nil, err
+	}
+
+	signer := crypto.NewSigner(e.store.GetForksInTime(e.store.Header().Number), e.chainID)
+
+	signed, err := e.accounts.SignTx(tx.From, tx, signer)
+	if err != nil {
+		return nil, err
+	}
+
+	signed.ComputeHash()
+
+	return signed, nil
+}
+
+// fillTransaction fills in the missing nonce, gas price and gas limit of the unsigned transaction
+func (e *Eth) fillTransaction(arg *txnArgs) (*types.Transaction, error) {
+	if arg == nil || arg.From == nil {
+		return nil, ErrMissingSender
+	}
+
+	if arg.Nonce == nil {
+		nonce, err := GetNextNonce(*arg.From, PendingBlockNumber, e.store)
+		if err != nil {
+			return nil, err
+		}
+
+		arg.Nonce = argUintPtr(nonce)
+	}
+
+	if arg.GasPrice == nil {
+		gasPrice, err := e.GasPrice()
+		if err != nil {
+			return nil, err
+		}
+
+		arg.GasPrice = argBytesPtr(new(big.Int).SetUint64(uint64(gasPrice.(argUint64))).Bytes()) //nolint:forcetypeassert
+	}
+
+	if arg.Gas == nil {
+		gas, err := e.EstimateGas(arg, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		arg.Gas = argUintPtr(uint64(gas.(argUint64))) //nolint:forcetypeassert
+	}
+
+	return DecodeTxn(arg, e.store)
 }
 
 // GetTransactionByHash returns a transaction by its hash.
@@ -300,38 +664,42 @@ func (e *Eth) GetTransactionReceipt(hash types.Hash) (interface{}, error) {
 		return nil, nil
 	}
 
-	txn := block.Transactions[indx]
-	raw := receipts[indx]
-
-	logs := make([]*Log, len(raw.Logs))
-	for indx, elem := range raw.Logs {
-		logs[indx] = &Log{
-			Address:     elem.Address,
-			Topics:      elem.Topics,
-			Data:        argBytes(elem.Data),
-			BlockHash:   block.Hash(),
-			BlockNumber: argUint64(block.Number()),
-			TxHash:      txn.Hash,
-			TxIndex:     argUint64(indx),
-			LogIndex:    argUint64(indx),
-			Removed:     false,
-		}
+	// logs are indexed within the block
+	logIndex := 0
+	for _, raw := range receipts[:indx] {
+		logIndex += len(raw.Logs)
+	}
+
+	return toReceipt(receipts[indx], block.Transactions[indx], uint64(indx), block.Header, logIndex), nil
+}
+
+// GetBlockReceipts returns the receipts of all the transactions in the block
+func (e *Eth) GetBlockReceipts(filter BlockNumberOrHash) (interface{}, error) {
+	header, err := GetHeaderFromBlockNumberOrHash(filter, e.store)
+	if err != nil {
+		return nil, err
 	}
 
-	res := &receipt{
-		Root:              raw.Root,
-		CumulativeGasUsed: argUint64(raw.CumulativeGasUsed),
-		LogsBloom:         raw.LogsBloom,
-		Status:            argUint64(*raw.Status),
-		TxHash:            txn.Hash,
-		TxIndex:           argUint64(indx),
-		BlockHash:         block.Hash(),
-		BlockNumber:       argUint64(block.Number()),
-		GasUsed:           argUint64(raw.GasUsed),
-		ContractAddress:   raw.ContractAddress,
-		FromAddr:          txn.From,
-		ToAddr:            txn.To,
-		Logs:              logs,
+	block, ok := e.store.GetBlockByHash(header.Hash, true)
+	if !ok {
+		return nil, nil
+	}
+
+	receipts, err := e.store.GetReceiptsByHash(block.Hash())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(receipts) != len(block.Transactions) {
+		return nil, fmt.Errorf("receipts for block with hash [%s] not found", block.Hash())
+	}
+
+	res := make([]*receipt, len(receipts))
+	logIndex := 0
+
+	for indx, raw := range receipts {
+		res[indx] = toReceipt(raw, block.Transactions[indx], uint64(indx), block.Header, logIndex)
+		logIndex += len(raw.Logs)
 	}
 
 	return res, nil
@@ -599,6 +967,12 @@ func (e *Eth) EstimateGas(arg *txnArgs, rawNum *BlockNumber) (interface{}, error
 	// Check if the highEnd is a good value to make the transaction pass
 	failed, err := testTransaction(highEnd, false)
 	if failed {
+		// the revert is returned as it is, so the revert reason and data are not lost
+		var revertErr *revertError
+		if errors.As(err, &revertErr) {
+			return 0, revertErr
+		}
+
 		// The transaction shouldn't fail, for whatever reason, at highEnd
 		return 0, fmt.Errorf(
 			"unable to apply transaction even for the highest gas limit %d: %w",
@@ -711,6 +1085,11 @@ func (e *Eth) NewBlockFilter() (interface{}, error) {
 	return e.filterManager.NewBlockFilter(nil), nil
 }
 
+// NewPendingTransactionFilter creates a filter in the node, to notify when new pending transactions arrive
+func (e *Eth) NewPendingTransactionFilter() (interface{}, error) {
+	return e.filterManager.NewPendingTxFilter(false, nil), nil
+}
+
 // GetFilterChanges is a polling method for a filter, which returns an array of logs which occurred since last poll.
 func (e *Eth) GetFilterChanges(id string) (interface{}, error) {
 	return e.filterManager.GetFilterChanges(id)
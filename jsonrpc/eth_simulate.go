@@ -0,0 +1,392 @@
+package jsonrpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+const (
+	// simulateMaxBlocks is the max number of the blocks simulated by a single request
+	simulateMaxBlocks = 256
+
+	// simulateTimestampIncrement is the timestamp increment of the simulated blocks without the timestamp override
+	simulateTimestampIncrement = 1
+
+	// executionFailedCode is the error code of the calls failed for a reason other than the revert
+	executionFailedCode = -32015
+)
+
+var (
+	ErrSimulateNoBlocks       = errors.New("no blocks to simulate")
+	ErrSimulateTooManyBlocks  = fmt.Errorf("too many blocks to simulate, the limit is %d", simulateMaxBlocks)
+	ErrSimulateBlockNumber    = errors.New("simulated block numbers must be increasing")
+	ErrSimulateBlockTimestamp = errors.New("simulated block timestamps must be increasing")
+	ErrSimulateGasCapReached  = errors.New("simulation gas budget exhausted")
+	ErrSimulateTimeout        = errors.New("simulation time budget exhausted")
+)
+
+// Simulation is the scratch state the simulated calls are executed on.
+// It is discarded once the simulation is over, so the chain state is never changed
+type Simulation interface {
+	// SetBlock moves the simulation to the block the next calls are executed in.
+	// The fees are paid to the miner of the header, or to the creator of the base block if it's not set
+	SetBlock(header *types.Header)
+
+	// OverrideAccount replaces the fields of the account which are set by the override
+	OverrideAccount(addr types.Address, override *AccountOverride)
+
+	// GetNonce returns the nonce of the account in the simulated state
+	GetNonce(addr types.Address) uint64
+
+	// Apply executes the transaction, returning its result and the logs it emitted.
+	// The execution is halted and the context error returned once the context is done
+	Apply(ctx context.Context, txn *types.Transaction) (*runtime.ExecutionResult, []*types.Log, error)
+}
+
+// AccountOverride is the replacement of the account fields applied before the simulated calls.
+// State replaces the whole storage of the account, while StateDiff replaces the given slots only
+type AccountOverride struct {
+	Nonce     *uint64
+	Code      []byte
+	Balance   *big.Int
+	State     map[types.Hash]types.Hash
+	StateDiff map[types.Hash]types.Hash
+}
+
+func (o *AccountOverride) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Nonce     *argUint64                `json:"nonce"`
+		Code      *argBytes                 `json:"code"`
+		Balance   *argBig                   `json:"balance"`
+		State     map[types.Hash]types.Hash `json:"state"`
+		StateDiff map[types.Hash]types.Hash `json:"stateDiff"`
+	}
+
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	if raw.State != nil && raw.StateDiff != nil {
+		return errors.New("state and stateDiff can't be overridden at the same time")
+	}
+
+	*o = AccountOverride{
+		State:     raw.State,
+		StateDiff: raw.StateDiff,
+	}
+
+	if raw.Nonce != nil {
+		nonce := uint64(*raw.Nonce)
+		o.Nonce = &nonce
+	}
+
+	if raw.Code != nil {
+		o.Code = *raw.Code
+	}
+
+	if raw.Balance != nil {
+		o.Balance = new(big.Int).Set((*big.Int)(raw.Balance))
+	}
+
+	return nil
+}
+
+// StateOverride is the set of the account overrides
+type StateOverride map[types.Address]*AccountOverride
+
+// simulateOpts are the options of eth_simulateV1
+type simulateOpts struct {
+	BlockStateCalls []*simulateBlock `json:"blockStateCalls"`
+
+	// Validation enables the nonce checks, otherwise the calls use the nonces of the simulated state
+	Validation bool `json:"validation"`
+
+	ReturnFullTransactions bool `json:"returnFullTransactions"`
+}
+
+// simulateBlock is the block of the calls executed in order, after the overrides are applied
+type simulateBlock struct {
+	BlockOverrides *blockOverrides `json:"blockOverrides"`
+	StateOverrides StateOverride   `json:"stateOverrides"`
+	Calls          []*txnArgs      `json:"calls"`
+}
+
+// blockOverrides replace the header fields of the simulated block
+type blockOverrides struct {
+	Number       *argUint64     `json:"number"`
+	Time         *argUint64     `json:"time"`
+	GasLimit     *argUint64     `json:"gasLimit"`
+	FeeRecipient *types.Address `json:"feeRecipient"`
+}
+
+// simulatedBlock is the result of the simulated block
+type simulatedBlock struct {
+	*block
+
+	Calls []*simulatedCall `json:"calls"`
+}
+
+// simulatedCall is the result of the simulated call
+type simulatedCall struct {
+	ReturnData argBytes            `json:"returnData"`
+	Logs       []*Log              `json:"logs"`
+	GasUsed    argUint64           `json:"gasUsed"`
+	Status     argUint64           `json:"status"`
+	Error      *simulatedCallError `json:"error,omitempty"`
+}
+
+// simulatedCallError is the reason the simulated call failed
+type simulatedCallError struct {
+	Code    int       `json:"code"`
+	Message string    `json:"message"`
+	Data    *argBytes `json:"data,omitempty"`
+}
+
+// SimulateV1 executes the ordered calls in the simulated blocks on top of the given block,
+// every call seeing the state changes of the previous ones. The state is never committed.
+// The calls are bounded by the gas and the time budgets of the whole request
+func (e *Eth) SimulateV1(opts *simulateOpts, filter BlockNumberOrHash) (interface{}, error) {
+	if opts == nil || len(opts.BlockStateCalls) == 0 {
+		return nil, ErrSimulateNoBlocks
+	}
+
+	if len(opts.BlockStateCalls) > simulateMaxBlocks {
+		return nil, ErrSimulateTooManyBlocks
+	}
+
+	base, err := GetHeaderFromBlockNumberOrHash(filter, e.store)
+	if err != nil {
+		return nil, err
+	}
+
+	sim, err := e.store.BeginSimulation(base)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := context.Background()
+
+	if e.simulateTimeout != 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, e.simulateTimeout)
+		defer cancel()
+	}
+
+	s := &simulator{
+		eth:        e,
+		sim:        sim,
+		ctx:        ctx,
+		validation: opts.Validation,
+		gasLeft:    e.simulateGasCap,
+	}
+
+	parent := base
+	results := make([]*simulatedBlock, len(opts.BlockStateCalls))
+
+	for i, simBlock := range opts.BlockStateCalls {
+		header, err := simulatedHeader(parent, simBlock.BlockOverrides)
+		if err != nil {
+			return nil, err
+		}
+
+		if results[i], err = s.simulateBlock(header, simBlock, opts.ReturnFullTransactions); err != nil {
+			return nil, fmt.Errorf("block %d: %w", header.Number, err)
+		}
+
+		parent = header
+	}
+
+	return results, nil
+}
+
+// simulatedHeader creates the header of the simulated block following the parent
+func simulatedHeader(parent *types.Header, overrides *blockOverrides) (*types.Header, error) {
+	header := &types.Header{
+		ParentHash: parent.Hash,
+		Number:     parent.Number + 1,
+		Timestamp:  parent.Timestamp + simulateTimestampIncrement,
+		GasLimit:   parent.GasLimit,
+		Difficulty: parent.Difficulty,
+		Sha3Uncles: types.EmptyUncleHash,
+	}
+
+	if overrides == nil {
+		return header, nil
+	}
+
+	if overrides.Number != nil {
+		if uint64(*overrides.Number) <= parent.Number {
+			return nil, ErrSimulateBlockNumber
+		}
+
+		header.Number = uint64(*overrides.Number)
+	}
+
+	if overrides.Time != nil {
+		if uint64(*overrides.Time) <= parent.Timestamp {
+			return nil, ErrSimulateBlockTimestamp
+		}
+
+		header.Timestamp = uint64(*overrides.Time)
+	}
+
+	if overrides.GasLimit != nil {
+		header.GasLimit = uint64(*overrides.GasLimit)
+	}
+
+	if overrides.FeeRecipient != nil {
+		header.Miner = overrides.FeeRecipient.Bytes()
+	}
+
+	return header, nil
+}
+
+// simulator executes the calls of a single simulation, keeping track of its budgets
+type simulator struct {
+	eth        *Eth
+	sim        Simulation
+	validation bool
+
+	// ctx is done once the time budget is spent, halting the running call
+	ctx context.Context
+
+	// gasLeft is the remaining gas budget, unlimited if the cap is not set
+	gasLeft uint64
+}
+
+// simulateBlock applies the overrides and executes the calls of the block
+func (s *simulator) simulateBlock(header *types.Header, simBlock *simulateBlock, fullTx bool) (*simulatedBlock, error) {
+	s.sim.SetBlock(header)
+
+	for addr, override := range simBlock.StateOverrides {
+		s.sim.OverrideAccount(addr, override)
+	}
+
+	var (
+		txns  = make([]*types.Transaction, len(simBlock.Calls))
+		calls = make([]*simulatedCall, len(simBlock.Calls))
+		logs  = make([]*Log, 0)
+	)
+
+	for i, args := range simBlock.Calls {
+		txn, err := s.decodeCall(args, header)
+		if err != nil {
+			return nil, fmt.Errorf("call %d: %w", i, err)
+		}
+
+		result, txnLogs, err := s.sim.Apply(s.ctx, txn)
+		if err != nil {
+			if s.ctx.Err() != nil {
+				err = ErrSimulateTimeout
+			}
+
+			return nil, fmt.Errorf("call %d: %w", i, err)
+		}
+
+		if s.eth.simulateGasCap != 0 {
+			s.gasLeft -= result.GasUsed
+		}
+
+		header.GasUsed += result.GasUsed
+
+		call := &simulatedCall{
+			ReturnData: argBytes(result.ReturnValue),
+			Logs:       make([]*Log, len(txnLogs)),
+			GasUsed:    argUint64(result.GasUsed),
+			Status:     argUint64(types.ReceiptSuccess),
+		}
+
+		if result.Failed() {
+			call.Status = argUint64(types.ReceiptFailed)
+			call.Error = toSimulatedCallError(result)
+		}
+
+		for j, log := range txnLogs {
+			call.Logs[j] = &Log{
+				Address:     log.Address,
+				Topics:      log.Topics,
+				Data:        argBytes(log.Data),
+				BlockNumber: argUint64(header.Number),
+				TxHash:      txn.Hash,
+				TxIndex:     argUint64(i),
+				LogIndex:    argUint64(len(logs)),
+			}
+
+			logs = append(logs, call.Logs[j])
+		}
+
+		txns[i] = txn
+		calls[i] = call
+	}
+
+	header.ComputeHash()
+
+	// the block hash is known once all the calls are executed
+	for _, log := range logs {
+		log.BlockHash = header.Hash
+	}
+
+	return &simulatedBlock{
+		block: toBlock(&types.Block{Header: header, Transactions: txns}, fullTx),
+		Calls: calls,
+	}, nil
+}
+
+// decodeCall converts the call into the transaction, checking the budgets of the simulation
+func (s *simulator) decodeCall(args *txnArgs, header *types.Header) (*types.Transaction, error) {
+	if s.ctx.Err() != nil {
+		return nil, ErrSimulateTimeout
+	}
+
+	if args.From == nil {
+		args.From = &types.ZeroAddress
+	}
+
+	// the calls are not signed, so without the validation the senders are trusted to use their next nonce
+	if args.Nonce == nil || !s.validation {
+		args.Nonce = argUintPtr(s.sim.GetNonce(*args.From))
+	}
+
+	txn, err := DecodeTxn(args, s.eth.store)
+	if err != nil {
+		return nil, err
+	}
+
+	if txn.Gas == 0 {
+		txn.Gas = header.GasLimit
+	}
+
+	if s.eth.simulateGasCap != 0 {
+		if s.gasLeft == 0 {
+			return nil, ErrSimulateGasCapReached
+		}
+
+		if txn.Gas > s.gasLeft {
+			txn.Gas = s.gasLeft
+		}
+	}
+
+	return txn, nil
+}
+
+func toSimulatedCallError(result *runtime.ExecutionResult) *simulatedCallError {
+	if result.Reverted() {
+		return &simulatedCallError{
+			Code:    executionRevertedCode,
+			Message: constructErrorFromRevert(result).Error(),
+			Data:    argBytesPtr(result.ReturnValue),
+		}
+	}
+
+	return &simulatedCallError{
+		Code:    executionFailedCode,
+		Message: result.Err.Error(),
+	}
+}
@@ -0,0 +1,304 @@
+package jsonrpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	// revertInput makes the mock simulation revert the call
+	revertInput = []byte{0xfd}
+
+	// logInput makes the mock simulation emit a log
+	logInput = []byte{0x01}
+
+	// loopInput makes the mock simulation run until it's halted
+	loopInput = []byte{0x5b}
+
+	errNonceIncorrect = errors.New("incorrect nonce")
+)
+
+// mockSimulation executes every call for the gas of a transfer, keeping track of the nonces
+type mockSimulation struct {
+	headers   []*types.Header
+	nonces    map[types.Address]uint64
+	overrides map[types.Address]*AccountOverride
+}
+
+func (m *mockSimulation) SetBlock(header *types.Header) {
+	m.headers = append(m.headers, header)
+}
+
+func (m *mockSimulation) OverrideAccount(addr types.Address, override *AccountOverride) {
+	m.overrides[addr] = override
+
+	if override.Nonce != nil {
+		m.nonces[addr] = *override.Nonce
+	}
+}
+
+func (m *mockSimulation) GetNonce(addr types.Address) uint64 {
+	return m.nonces[addr]
+}
+
+func (m *mockSimulation) Apply(
+	ctx context.Context,
+	txn *types.Transaction,
+) (*runtime.ExecutionResult, []*types.Log, error) {
+	if string(txn.Input) == string(loopInput) {
+		<-ctx.Done()
+
+		return nil, nil, ctx.Err()
+	}
+
+	if txn.Nonce != m.nonces[txn.From] {
+		return nil, nil, errNonceIncorrect
+	}
+
+	m.nonces[txn.From]++
+
+	result := &runtime.ExecutionResult{GasUsed: 21000}
+	if txn.Gas < result.GasUsed {
+		result.GasUsed = txn.Gas
+		result.Err = runtime.ErrOutOfGas
+
+		return result, nil, nil
+	}
+
+	switch {
+	case string(txn.Input) == string(revertInput):
+		result.Err = runtime.ErrExecutionReverted
+		result.ReturnValue = []byte{0x1, 0x2}
+
+		return result, nil, nil
+
+	case string(txn.Input) == string(logInput):
+		return result, []*types.Log{{Address: *txn.To, Topics: []types.Hash{hash1}}}, nil
+	}
+
+	return result, nil, nil
+}
+
+type mockSimulationStore struct {
+	*mockBlockStore
+
+	sim *mockSimulation
+}
+
+func (m *mockSimulationStore) BeginSimulation(header *types.Header) (Simulation, error) {
+	return m.sim, nil
+}
+
+func newTestSimulationEndpoint(gasCap uint64) (*Eth, *mockSimulation) {
+	store := newMockBlockStore()
+
+	head := newTestBlock(10, hash1)
+	head.Header.GasLimit = 100000
+	head.Header.Timestamp = 1000
+	store.add(head)
+
+	sim := &mockSimulation{
+		nonces:    map[types.Address]uint64{addr0: 5},
+		overrides: map[types.Address]*AccountOverride{},
+	}
+
+	eth := newTestEthEndpoint(&mockSimulationStore{mockBlockStore: store, sim: sim})
+	eth.simulateGasCap = gasCap
+
+	return eth, sim
+}
+
+func decodeSimulateOpts(t *testing.T, raw string) *simulateOpts {
+	t.Helper()
+
+	opts := &simulateOpts{}
+	require.NoError(t, json.Unmarshal([]byte(raw), opts))
+
+	return opts
+}
+
+func TestEth_SimulateV1(t *testing.T) {
+	t.Parallel()
+
+	eth, sim := newTestSimulationEndpoint(0)
+
+	opts := decodeSimulateOpts(t, `{
+		"blockStateCalls": [
+			{
+				"stateOverrides": {
+					"`+addr1.String()+`": {"balance": "0x10", "stateDiff": {"`+hash1.String()+`": "`+hash2.String()+`"}}
+				},
+				"calls": [
+					{"from": "`+addr0.String()+`", "to": "`+addr1.String()+`", "data": "0x01"},
+					{"from": "`+addr0.String()+`", "to": "`+addr1.String()+`", "data": "0xfd"}
+				]
+			},
+			{
+				"blockOverrides": {"number": "0x14", "feeRecipient": "`+addr2.String()+`"},
+				"calls": [
+					{"from": "`+addr0.String()+`", "to": "`+addr1.String()+`", "nonce": "0x0"}
+				]
+			}
+		]
+	}`)
+
+	res, err := eth.SimulateV1(opts, BlockNumberOrHash{})
+	require.NoError(t, err)
+
+	blocks, ok := res.([]*simulatedBlock)
+	require.True(t, ok)
+	require.Len(t, blocks, 2)
+
+	// the blocks follow the base block, unless overridden
+	require.Len(t, sim.headers, 2)
+	assert.Equal(t, uint64(11), sim.headers[0].Number)
+	assert.Equal(t, uint64(1001), sim.headers[0].Timestamp)
+	assert.Equal(t, hash1, sim.headers[0].ParentHash)
+	assert.Equal(t, uint64(20), sim.headers[1].Number)
+	assert.Equal(t, addr2.Bytes(), sim.headers[1].Miner)
+	assert.Equal(t, sim.headers[0].Hash, sim.headers[1].ParentHash)
+
+	override := sim.overrides[addr1]
+	require.NotNil(t, override)
+	assert.Equal(t, big.NewInt(0x10), override.Balance)
+	assert.Equal(t, map[types.Hash]types.Hash{hash1: hash2}, override.StateDiff)
+
+	first := blocks[0]
+	assert.Equal(t, argUint64(42000), first.GasUsed)
+	require.Len(t, first.Transactions, 2)
+	require.Len(t, first.Calls, 2)
+
+	assert.Equal(t, argUint64(types.ReceiptSuccess), first.Calls[0].Status)
+	require.Len(t, first.Calls[0].Logs, 1)
+	assert.Equal(t, first.Hash, first.Calls[0].Logs[0].BlockHash)
+	assert.Equal(t, addr1, first.Calls[0].Logs[0].Address)
+
+	assert.Equal(t, argUint64(types.ReceiptFailed), first.Calls[1].Status)
+	require.NotNil(t, first.Calls[1].Error)
+	assert.Equal(t, executionRevertedCode, first.Calls[1].Error.Code)
+	assert.Equal(t, argBytes{0x1, 0x2}, *first.Calls[1].Error.Data)
+
+	// without the validation the calls use the nonces of the simulated state
+	assert.Equal(t, uint64(8), sim.nonces[addr0])
+}
+
+func TestEth_SimulateV1_Validation(t *testing.T) {
+	t.Parallel()
+
+	eth, _ := newTestSimulationEndpoint(0)
+
+	opts := decodeSimulateOpts(t, `{
+		"validation": true,
+		"blockStateCalls": [
+			{"calls": [{"from": "`+addr0.String()+`", "to": "`+addr1.String()+`", "nonce": "0x0"}]}
+		]
+	}`)
+
+	_, err := eth.SimulateV1(opts, BlockNumberOrHash{})
+	assert.ErrorIs(t, err, errNonceIncorrect)
+}
+
+func TestEth_SimulateV1_GasCap(t *testing.T) {
+	t.Parallel()
+
+	eth, _ := newTestSimulationEndpoint(50000)
+
+	opts := decodeSimulateOpts(t, `{
+		"blockStateCalls": [
+			{
+				"calls": [
+					{"to": "`+addr1.String()+`"},
+					{"to": "`+addr1.String()+`"},
+					{"to": "`+addr1.String()+`"}
+				]
+			}
+		]
+	}`)
+
+	// the third call gets the rest of the budget, which is not enough for the transfer
+	res, err := eth.SimulateV1(opts, BlockNumberOrHash{})
+	require.NoError(t, err)
+
+	blocks, ok := res.([]*simulatedBlock)
+	require.True(t, ok)
+	assert.Equal(t, argUint64(types.ReceiptFailed), blocks[0].Calls[2].Status)
+	assert.Equal(t, executionFailedCode, blocks[0].Calls[2].Error.Code)
+
+	// the budget is exhausted before the fourth call
+	opts.BlockStateCalls[0].Calls = append(opts.BlockStateCalls[0].Calls, &txnArgs{To: &addr1})
+
+	_, err = eth.SimulateV1(opts, BlockNumberOrHash{})
+	assert.ErrorIs(t, err, ErrSimulateGasCapReached)
+}
+
+func TestEth_SimulateV1_Timeout(t *testing.T) {
+	t.Parallel()
+
+	eth, _ := newTestSimulationEndpoint(0)
+	eth.simulateTimeout = 100 * time.Millisecond
+
+	opts := decodeSimulateOpts(t, `{
+		"blockStateCalls": [
+			{"calls": [{"to": "`+addr1.String()+`"}, {"to": "`+addr1.String()+`", "input": "0x5b"}]}
+		]
+	}`)
+
+	// the running call is halted once the time budget is spent
+	_, err := eth.SimulateV1(opts, BlockNumberOrHash{})
+	assert.ErrorIs(t, err, ErrSimulateTimeout)
+}
+
+func TestEth_SimulateV1_InvalidBlocks(t *testing.T) {
+	t.Parallel()
+
+	eth, _ := newTestSimulationEndpoint(0)
+
+	cases := []struct {
+		opts string
+		err  error
+	}{
+		{`{"blockStateCalls": []}`, ErrSimulateNoBlocks},
+		{`{"blockStateCalls": [{"blockOverrides": {"number": "0xa"}}]}`, ErrSimulateBlockNumber},
+		{`{"blockStateCalls": [{}, {"blockOverrides": {"time": "0x3e9"}}]}`, ErrSimulateBlockTimestamp},
+	}
+
+	for _, c := range cases {
+		_, err := eth.SimulateV1(decodeSimulateOpts(t, c.opts), BlockNumberOrHash{})
+		assert.ErrorIs(t, err, c.err, c.opts)
+	}
+
+	tooMany := &simulateOpts{BlockStateCalls: make([]*simulateBlock, simulateMaxBlocks+1)}
+
+	_, err := eth.SimulateV1(tooMany, BlockNumberOrHash{})
+	assert.ErrorIs(t, err, ErrSimulateTooManyBlocks)
+}
+
+func TestAccountOverride_UnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	var override StateOverride
+
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"`+addr1.String()+`": {"nonce": "0x2", "code": "0x6000", "state": {"`+hash1.String()+`": "`+hash2.String()+`"}}
+	}`), &override))
+
+	nonce := uint64(2)
+	assert.Equal(t, &AccountOverride{
+		Nonce: &nonce,
+		Code:  []byte{0x60, 0x00},
+		State: map[types.Hash]types.Hash{hash1: hash2},
+	}, override[addr1])
+
+	assert.Error(t, json.Unmarshal([]byte(`{
+		"`+addr1.String()+`": {"state": {}, "stateDiff": {}}
+	}`), &override))
+}
@@ -740,6 +740,49 @@ func TestEth_EstimateGas_Reverts(t *testing.T) {
 
 	// Make sure the EVM revert reason is contained
 	assert.ErrorAs(t, estimateErr, &revertReason)
+	assert.EqualError(t, estimateErr, "execution was reverted: revert reason")
+
+	// Make sure the revert data is returned in the error object
+	errResp, ok := NewRPCResponse(1, "2.0", nil, estimateErr.(Error)).(*ErrorResponse) //nolint:errorlint
+	assert.True(t, ok)
+	assert.Equal(t, executionRevertedCode, errResp.Error.Code)
+	assert.Equal(t, argBytes(rawReturnData), errResp.Error.Data)
+}
+
+func TestDecodeRevertReason(t *testing.T) {
+	t.Parallel()
+
+	panicData := func(code byte) []byte {
+		data := make([]byte, 36)
+		copy(data, panicSelector)
+		data[35] = code
+
+		return data
+	}
+
+	errorData, err := hex.DecodeHex("08c379a000000000000000000000000000000000000000000000000000000000000000" +
+		"20000000000000000000000000000000000000000000000000000000000000000d72657665727420726561736f6e" +
+		"00000000000000000000000000000000000000")
+	assert.NoError(t, err)
+
+	cases := []struct {
+		name   string
+		data   []byte
+		reason string
+		ok     bool
+	}{
+		{"error string", errorData, "revert reason", true},
+		{"panic", panicData(0x11), "panic: arithmetic underflow or overflow (0x11)", true},
+		{"unknown panic", panicData(0x99), "panic: unknown panic code (0x99)", true},
+		{"custom error", []byte{0x1, 0x2, 0x3, 0x4}, "", false},
+		{"empty", nil, "", false},
+	}
+
+	for _, c := range cases {
+		reason, ok := decodeRevertReason(c.data)
+		assert.Equal(t, c.ok, ok, c.name)
+		assert.Equal(t, c.reason, reason, c.name)
+	}
 }
 
 func TestEth_EstimateGas_Errors(t *testing.T) {
@@ -29,6 +29,60 @@ func TestEth_TxnPool_SendRawTransaction(t *testing.T) {
 	}
 }
 
+func TestEth_TxnPool_SendPrivateTransaction(t *testing.T) {
+	store := &mockStoreTxn{}
+	eth := newTestEthEndpoint(store)
+
+	txn := &types.Transaction{
+		From: addr0,
+		V:    big.NewInt(1),
+	}
+	txn.ComputeHash()
+
+	maxBlock := argUint64(10)
+
+	hash, err := eth.SendPrivateTransaction(&privateTxArgs{
+		Tx:             txn.MarshalRLP(),
+		MaxBlockNumber: &maxBlock,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, txn.Hash.String(), hash)
+	assert.Equal(t, txn.Hash, store.privateTxn.Hash)
+	assert.Equal(t, uint64(10), store.privateMaxBlock)
+	assert.Nil(t, store.txn)
+}
+
+func TestEth_TxnPool_SendBundle(t *testing.T) {
+	store := &mockStoreTxn{}
+	eth := newTestEthEndpoint(store)
+
+	txns := []*types.Transaction{
+		{From: addr0, Nonce: 0, V: big.NewInt(1)},
+		{From: addr0, Nonce: 1, V: big.NewInt(1)},
+	}
+
+	for _, txn := range txns {
+		txn.ComputeHash()
+	}
+
+	minBlock := argUint64(5)
+
+	res, err := eth.SendBundle(&bundleArgs{
+		Txs:               []argBytes{txns[0].MarshalRLP(), txns[1].MarshalRLP()},
+		MinBlockNumber:    &minBlock,
+		RevertingTxHashes: []types.Hash{txns[1].Hash},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &sendBundleResult{BundleHash: types.StringToHash("0xb")}, res)
+
+	assert.Len(t, store.bundle, 2)
+	assert.Equal(t, txns[0].Hash, store.bundle[0].Hash)
+	assert.Equal(t, txns[1].Hash, store.bundle[1].Hash)
+	assert.Equal(t, uint64(5), store.bundleMinBlock)
+	assert.Equal(t, uint64(0), store.bundleMaxBlock)
+	assert.Equal(t, []types.Hash{txns[1].Hash}, store.bundleRevertingTxHashes)
+}
+
 func TestEth_TxnPool_SendTransaction(t *testing.T) {
 	store := &mockStoreTxn{}
 	store.AddAccount(addr0)
@@ -50,6 +104,14 @@ type mockStoreTxn struct {
 	ethStore
 	accounts map[types.Address]*mockAccount
 	txn      *types.Transaction
+
+	privateTxn      *types.Transaction
+	privateMaxBlock uint64
+
+	bundle                  []*types.Transaction
+	bundleMinBlock          uint64
+	bundleMaxBlock          uint64
+	bundleRevertingTxHashes []types.Hash
 }
 
 func (m *mockStoreTxn) AddTx(tx *types.Transaction) error {
@@ -58,6 +120,22 @@ func (m *mockStoreTxn) AddTx(tx *types.Transaction) error {
 	return nil
 }
 
+func (m *mockStoreTxn) AddPrivateTx(tx *types.Transaction, maxBlock uint64) error {
+	m.privateTxn, m.privateMaxBlock = tx, maxBlock
+
+	return nil
+}
+
+func (m *mockStoreTxn) AddBundle(
+	txs []*types.Transaction,
+	minBlock, maxBlock uint64,
+	revertingTxHashes []types.Hash,
+) (types.Hash, error) {
+	m.bundle, m.bundleMinBlock, m.bundleMaxBlock, m.bundleRevertingTxHashes = txs, minBlock, maxBlock, revertingTxHashes
+
+	return types.StringToHash("0xb"), nil
+}
+
 func (m *mockStoreTxn) GetNonce(addr types.Address) uint64 {
 	return 1
 }
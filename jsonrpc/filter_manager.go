@@ -1,6 +1,7 @@
 package jsonrpc
 
 import (
+	"bytes"
 	"container/heap"
 	"encoding/json"
 	"errors"
@@ -11,16 +12,20 @@ import (
 	"time"
 
 	"github.com/0xPolygon/polygon-edge/blockchain"
+	"github.com/0xPolygon/polygon-edge/helper/progress"
+	"github.com/0xPolygon/polygon-edge/txpool/proto"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/google/uuid"
 	"github.com/gorilla/websocket"
 	"github.com/hashicorp/go-hclog"
+	lru "github.com/hashicorp/golang-lru"
 )
 
 var (
 	ErrFilterNotFound                   = errors.New("filter not found")
 	ErrWSFilterDoesNotSupportGetChanges = errors.New("web socket Filter doesn't support to return a batch of the changes")
 	ErrCastingFilterToLogFilter         = errors.New("casting filter object to logFilter error")
+	ErrSyncingFilterRequiresWS          = errors.New("syncing filter requires a web socket connection")
 	ErrBlockNotFound                    = errors.New("block not found")
 	ErrIncorrectBlockRange              = errors.New("incorrect range")
 	ErrBlockRangeTooHigh                = errors.New("block range too high")
@@ -30,9 +35,16 @@ var (
 // defaultTimeout is the timeout to remove the filters that don't have a web socket stream
 var defaultTimeout = 1 * time.Minute
 
+// syncingPollInterval is the interval at which the sync progression is checked for syncing filters
+var syncingPollInterval = 1 * time.Second
+
 const (
 	// The index in heap which is indicating the element is not in the heap
 	NoIndexInHeap = -1
+
+	// announcedTxsCacheSize is the number of recently announced pending transaction hashes
+	// kept in order not to announce the same transaction twice (on ADDED and on PROMOTED)
+	announcedTxsCacheSize = 4096
 )
 
 // filter is an interface that BlockFilter and LogFilter implement
@@ -212,6 +224,133 @@ func (f *logFilter) sendUpdates() error {
 	return nil
 }
 
+// pendingTxFilter is a filter to store the transactions that entered the pool
+type pendingTxFilter struct {
+	filterBase
+	sync.Mutex
+
+	// fullTx indicates whether the whole transaction objects are returned instead of hashes
+	fullTx bool
+	txs    []transactionOrHash
+}
+
+// appendTx appends new pending transaction (or its hash) to the filter
+func (f *pendingTxFilter) appendTx(tx transactionOrHash) {
+	f.Lock()
+	defer f.Unlock()
+
+	f.txs = append(f.txs, tx)
+}
+
+// takeTxUpdates returns all saved pending transactions in filter and set new slice
+func (f *pendingTxFilter) takeTxUpdates() []transactionOrHash {
+	f.Lock()
+	defer f.Unlock()
+
+	txs := f.txs
+	f.txs = []transactionOrHash{}
+
+	return txs
+}
+
+// getUpdates returns stored pending transactions
+func (f *pendingTxFilter) getUpdates() (interface{}, error) {
+	txs := f.takeTxUpdates()
+
+	return txs, nil
+}
+
+// sendUpdates writes stored pending transactions to web socket stream
+func (f *pendingTxFilter) sendUpdates() error {
+	txs := f.takeTxUpdates()
+
+	for _, tx := range txs {
+		raw, err := json.Marshal(tx)
+		if err != nil {
+			return err
+		}
+
+		if err := f.writeMessageToWs(string(raw)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// syncingStatus is the sync status sent to the syncing subscribers while the node is syncing
+type syncingStatus struct {
+	Syncing bool         `json:"syncing"`
+	Status  *progression `json:"status"`
+}
+
+// syncingFilter is a filter that notifies the web socket subscriber about sync status changes
+type syncingFilter struct {
+	filterBase
+	sync.Mutex
+
+	// status is the latest encoded sync status
+	status []byte
+
+	// sent is the last encoded sync status written to web socket stream
+	sent []byte
+}
+
+// setStatus sets the latest encoded sync status
+func (f *syncingFilter) setStatus(status []byte) {
+	f.Lock()
+	defer f.Unlock()
+
+	f.status = status
+}
+
+// takeStatusUpdate returns the latest sync status if it differs from the last one sent
+func (f *syncingFilter) takeStatusUpdate() []byte {
+	f.Lock()
+	defer f.Unlock()
+
+	if f.status == nil || bytes.Equal(f.status, f.sent) {
+		return nil
+	}
+
+	f.sent = f.status
+
+	return f.status
+}
+
+// getUpdates is not supported, syncing filter is only available through web socket stream
+func (f *syncingFilter) getUpdates() (interface{}, error) {
+	return nil, ErrSyncingFilterRequiresWS
+}
+
+// sendUpdates writes the sync status to web socket stream, if it changed
+func (f *syncingFilter) sendUpdates() error {
+	status := f.takeStatusUpdate()
+	if status == nil {
+		return nil
+	}
+
+	return f.writeMessageToWs(string(status))
+}
+
+// toSyncingStatus returns the JSON serializable sync status for the given progression
+func toSyncingStatus(syncProgression *progress.Progression) interface{} {
+	if syncProgression == nil {
+		// Node is not syncing
+		return false
+	}
+
+	return &syncingStatus{
+		Syncing: true,
+		Status: &progression{
+			Type:          string(syncProgression.SyncType),
+			StartingBlock: argUint64(syncProgression.StartingBlock),
+			CurrentBlock:  argUint64(syncProgression.CurrentBlock),
+			HighestBlock:  argUint64(syncProgression.HighestBlock),
+		},
+	}
+}
+
 // filterManagerStore provides methods required by FilterManager
 type filterManagerStore interface {
 	// Header returns the current header of the chain (genesis if empty)
@@ -228,6 +367,15 @@ type filterManagerStore interface {
 
 	// GetBlockByNumber returns a block using the provided number
 	GetBlockByNumber(num uint64, full bool) (*types.Block, bool)
+
+	// TxPoolSubscribe subscribes for the given txpool event types
+	TxPoolSubscribe(request *proto.SubscribeRequest) (<-chan *proto.TxPoolEvent, func(), error)
+
+	// GetPendingTx gets the pending transaction from the transaction pool, if it's present
+	GetPendingTx(txHash types.Hash) (*types.Transaction, bool)
+
+	// GetSyncProgression retrieves the current sync progression, if any
+	GetSyncProgression() *progress.Progression
 }
 
 // FilterManager manages all running filters
@@ -243,6 +391,13 @@ type FilterManager struct {
 	blockStream     *blockStream
 	blockRangeLimit uint64
 
+	// txPoolEventCh is the channel of txpool events feeding the pending transaction filters
+	txPoolEventCh     <-chan *proto.TxPoolEvent
+	txPoolUnsubscribe func()
+
+	// announcedTxs keeps recently announced pending transaction hashes
+	announcedTxs *lru.Cache
+
 	filters  map[string]filter
 	timeouts timeHeapImpl
 
@@ -272,6 +427,19 @@ func NewFilterManager(logger hclog.Logger, store filterManagerStore, blockRangeL
 	// start the head watcher
 	m.subscription = store.SubscribeEvents()
 
+	// start the pending transactions watcher
+	txPoolEventCh, unsubscribe, err := store.TxPoolSubscribe(&proto.SubscribeRequest{
+		Types: []proto.EventType{proto.EventType_ADDED, proto.EventType_PROMOTED},
+	})
+	if err != nil {
+		m.logger.Error("unable to subscribe to txpool events", "err", err)
+	} else {
+		m.txPoolEventCh = txPoolEventCh
+		m.txPoolUnsubscribe = unsubscribe
+	}
+
+	m.announcedTxs, _ = lru.New(announcedTxsCacheSize)
+
 	return m
 }
 
@@ -292,6 +460,9 @@ func (f *FilterManager) Run() {
 
 	var timeoutCh <-chan time.Time
 
+	syncingTicker := time.NewTicker(syncingPollInterval)
+	defer syncingTicker.Stop()
+
 	for {
 		// check for the next filter to be removed
 		filterID, filterExpiresAt := f.nextTimeoutFilter()
@@ -308,6 +479,25 @@ func (f *FilterManager) Run() {
 				f.logger.Error("failed to dispatch event", "err", err)
 			}
 
+		case txEvnt, ok := <-f.txPoolEventCh:
+			if !ok {
+				// txpool subscription has been closed
+				f.txPoolEventCh = nil
+
+				continue
+			}
+
+			// new txpool event
+			if err := f.dispatchTxPoolEvent(txEvnt); err != nil {
+				f.logger.Error("failed to dispatch txpool event", "err", err)
+			}
+
+		case <-syncingTicker.C:
+			// check the sync status for syncing filters
+			if err := f.dispatchSyncingStatus(); err != nil {
+				f.logger.Error("failed to dispatch syncing status", "err", err)
+			}
+
 		case <-timeoutCh:
 			// timeout for filter
 			// if filter still exists
@@ -327,6 +517,10 @@ func (f *FilterManager) Run() {
 
 // Close closed closeCh so that terminate worker
 func (f *FilterManager) Close() {
+	if f.txPoolUnsubscribe != nil {
+		f.txPoolUnsubscribe()
+	}
+
 	close(f.closeCh)
 }
 
@@ -358,6 +552,36 @@ func (f *FilterManager) NewLogFilter(logQuery *LogQuery, ws wsConn) string {
 	return f.addFilter(filter)
 }
 
+// NewPendingTxFilter adds new PendingTxFilter
+func (f *FilterManager) NewPendingTxFilter(fullTx bool, ws wsConn) string {
+	filter := &pendingTxFilter{
+		filterBase: newFilterBase(ws),
+		fullTx:     fullTx,
+		txs:        []transactionOrHash{},
+	}
+
+	if filter.hasWSConn() {
+		ws.SetFilterID(filter.id)
+	}
+
+	return f.addFilter(filter)
+}
+
+// NewSyncingFilter adds new SyncingFilter, which is only supported through web socket stream
+func (f *FilterManager) NewSyncingFilter(ws wsConn) (string, error) {
+	if ws == nil {
+		return "", ErrSyncingFilterRequiresWS
+	}
+
+	filter := &syncingFilter{
+		filterBase: newFilterBase(ws),
+	}
+
+	ws.SetFilterID(filter.id)
+
+	return f.addFilter(filter), nil
+}
+
 // Exists checks the filter with given ID exists
 func (f *FilterManager) Exists(id string) bool {
 	f.RLock()
@@ -547,6 +771,10 @@ func (f *FilterManager) removeFilterByID(id string) bool {
 
 	delete(f.filters, id)
 
+	if filter.hasWSConn() {
+		wsSubscriptions.Dec()
+	}
+
 	if removed := f.timeouts.removeFilter(filter.getFilterBase()); removed {
 		f.emitSignalToUpdateCh()
 	}
@@ -585,7 +813,9 @@ func (f *FilterManager) addFilter(filter filter) string {
 	f.filters[base.id] = filter
 
 	// Set timeout and add to heap if filter doesn't have web socket connection
-	if !filter.hasWSConn() {
+	if filter.hasWSConn() {
+		wsSubscriptions.Inc()
+	} else {
 		f.addFilterTimeout(base)
 	}
 
@@ -701,6 +931,105 @@ func (f *FilterManager) appendLogsToFilters(header *block) error {
 	return nil
 }
 
+// dispatchTxPoolEvent is an event handler for new txpool event
+func (f *FilterManager) dispatchTxPoolEvent(evnt *proto.TxPoolEvent) error {
+	// store new pending transaction in each filter
+	f.processTxPoolEvent(evnt)
+
+	// send data to web socket stream
+	return f.flushWsFilters()
+}
+
+// processTxPoolEvent makes each PendingTxFilter append the transaction from the event
+func (f *FilterManager) processTxPoolEvent(evnt *proto.TxPoolEvent) {
+	txHash := types.StringToHash(evnt.TxHash)
+
+	// the same transaction is signaled when added and when promoted
+	if known, _ := f.announcedTxs.ContainsOrAdd(txHash, struct{}{}); known {
+		return
+	}
+
+	f.RLock()
+	defer f.RUnlock()
+
+	var (
+		pendingTx *transaction
+		missing   bool
+	)
+
+	for _, filter := range f.filters {
+		txFilter, ok := filter.(*pendingTxFilter)
+		if !ok {
+			continue
+		}
+
+		if !txFilter.fullTx {
+			txFilter.appendTx(transactionHash(txHash))
+
+			continue
+		}
+
+		if missing {
+			continue
+		}
+
+		if pendingTx == nil {
+			tx, ok := f.store.GetPendingTx(txHash)
+			if !ok {
+				// transaction already left the pool, only the hash filters get it
+				missing = true
+
+				continue
+			}
+
+			pendingTx = toPendingTransaction(tx)
+		}
+
+		txFilter.appendTx(pendingTx)
+	}
+}
+
+// dispatchSyncingStatus updates the SyncingFilters with the current sync status
+func (f *FilterManager) dispatchSyncingStatus() error {
+	if !f.updateSyncingFilters() {
+		return nil
+	}
+
+	// send data to web socket stream
+	return f.flushWsFilters()
+}
+
+// updateSyncingFilters sets the current sync status to each SyncingFilter.
+// Returns false if there are no SyncingFilters
+func (f *FilterManager) updateSyncingFilters() bool {
+	f.RLock()
+	defer f.RUnlock()
+
+	var status []byte
+
+	for _, filter := range f.filters {
+		syncFilter, ok := filter.(*syncingFilter)
+		if !ok {
+			continue
+		}
+
+		if status == nil {
+			raw, err := json.Marshal(toSyncingStatus(f.store.GetSyncProgression()))
+			if err != nil {
+				f.logger.Error("unable to encode syncing status", "err", err)
+
+				return false
+			}
+
+			status = raw
+		}
+
+		syncFilter.setStatus(status)
+	}
+
+	return status != nil
+}
+
 // flushWsFilters make each filters with web socket connection write the updates to web socket stream
 // flushWsFilters also removes the filters if flushWsFilters notices the connection is closed
 func (f *FilterManager) flushWsFilters() error {
@@ -12,6 +12,8 @@ import (
 	"time"
 
 	"github.com/0xPolygon/polygon-edge/blockchain"
+	"github.com/0xPolygon/polygon-edge/helper/progress"
+	"github.com/0xPolygon/polygon-edge/txpool/proto"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/gorilla/websocket"
 	"github.com/hashicorp/go-hclog"
@@ -469,6 +471,153 @@ func TestFilterWebsocket(t *testing.T) {
 	}
 }
 
+func TestFilterPendingTx(t *testing.T) {
+	t.Parallel()
+
+	store := newMockStore()
+
+	m := NewFilterManager(hclog.NewNullLogger(), store, 1000)
+	defer m.Close()
+
+	go m.Run()
+
+	id := m.NewPendingTxFilter(false, nil)
+
+	tx := &types.Transaction{Hash: types.StringToHash("1")}
+
+	// the same transaction is signaled on addition and on promotion
+	store.emitTxPoolEvent(proto.EventType_ADDED, tx)
+	store.emitTxPoolEvent(proto.EventType_PROMOTED, tx)
+
+	time.Sleep(500 * time.Millisecond)
+
+	res, err := m.GetFilterChanges(id)
+	assert.NoError(t, err)
+
+	updates, ok := res.([]transactionOrHash)
+	assert.True(t, ok)
+	assert.Equal(t, []transactionOrHash{transactionHash(tx.Hash)}, updates)
+
+	// no new pending transactions since the last poll
+	res, err = m.GetFilterChanges(id)
+	assert.NoError(t, err)
+	assert.Empty(t, res)
+}
+
+func TestFilterPendingTx_LeftPool(t *testing.T) {
+	t.Parallel()
+
+	store := newMockStore()
+
+	m := NewFilterManager(hclog.NewNullLogger(), store, 1000)
+	defer m.Close()
+
+	go m.Run()
+
+	fullID := m.NewPendingTxFilter(true, nil)
+	hashID := m.NewPendingTxFilter(false, nil)
+
+	// the transaction left the pool before the event is processed
+	hash := types.StringToHash("1")
+	store.txPoolEventCh <- &proto.TxPoolEvent{
+		Type:   proto.EventType_ADDED,
+		TxHash: hash.String(),
+	}
+
+	time.Sleep(500 * time.Millisecond)
+
+	res, err := m.GetFilterChanges(hashID)
+	assert.NoError(t, err)
+	assert.Equal(t, []transactionOrHash{transactionHash(hash)}, res)
+
+	res, err = m.GetFilterChanges(fullID)
+	assert.NoError(t, err)
+	assert.Empty(t, res)
+}
+
+func TestFilterPendingTxWebsocket(t *testing.T) {
+	t.Parallel()
+
+	store := newMockStore()
+
+	mock, msgCh := newMockWsConnWithMsgCh()
+
+	m := NewFilterManager(hclog.NewNullLogger(), store, 1000)
+	defer m.Close()
+
+	go m.Run()
+
+	m.NewPendingTxFilter(true, mock)
+
+	tx := &types.Transaction{
+		Hash:     types.StringToHash("1"),
+		Nonce:    1,
+		GasPrice: big.NewInt(10),
+		Value:    big.NewInt(0),
+		V:        big.NewInt(1),
+		R:        big.NewInt(2),
+		S:        big.NewInt(3),
+	}
+
+	store.emitTxPoolEvent(proto.EventType_ADDED, tx)
+
+	select {
+	case msg := <-msgCh:
+		assert.Contains(t, string(msg), `"nonce":"0x1"`)
+		assert.Contains(t, string(msg), tx.Hash.String())
+	case <-time.After(2 * time.Second):
+		t.Fatal("pending transaction not received in 2 seconds")
+	}
+}
+
+func TestFilterSyncing(t *testing.T) {
+	t.Parallel()
+
+	store := newMockStore()
+
+	mock, msgCh := newMockWsConnWithMsgCh()
+
+	m := NewFilterManager(hclog.NewNullLogger(), store, 1000)
+	defer m.Close()
+
+	go m.Run()
+
+	// syncing filter requires a web socket connection
+	_, err := m.NewSyncingFilter(nil)
+	assert.ErrorIs(t, err, ErrSyncingFilterRequiresWS)
+
+	id, err := m.NewSyncingFilter(mock)
+	assert.NoError(t, err)
+	assert.True(t, m.Exists(id))
+
+	expectStatus := func(expected string) {
+		t.Helper()
+
+		select {
+		case msg := <-msgCh:
+			assert.Contains(t, string(msg), expected)
+		case <-time.After(2 * syncingPollInterval):
+			t.Fatal("syncing status not received")
+		}
+	}
+
+	// initial status is sent once
+	expectStatus(`"result": false`)
+
+	store.setSyncProgression(&progress.Progression{
+		SyncType:      progress.ChainSyncBulk,
+		StartingBlock: 1,
+		CurrentBlock:  5,
+		HighestBlock:  10,
+	})
+
+	expectStatus(`"syncing":true`)
+
+	store.setSyncProgression(nil)
+
+	expectStatus(`"result": false`)
+}
+
 type mockWsConn struct {
 	SetFilterIDFn  func(string)
 	GetFilterIDFn  func() string
@@ -0,0 +1,29 @@
+package jsonrpc
+
+// graphiQLPage is the GraphiQL IDE, sending the queries to the GraphQL endpoint next to it
+const graphiQLPage = `<!DOCTYPE html>
+<html lang="en">
+<head>
+	<meta charset="utf-8">
+	<title>GraphiQL</title>
+	<style>
+		body { height: 100vh; margin: 0; overflow: hidden; }
+		#graphiql { height: 100vh; }
+	</style>
+	<link rel="stylesheet" href="https://unpkg.com/graphiql@3/graphiql.min.css">
+	<script crossorigin src="https://unpkg.com/react@18/umd/react.production.min.js"></script>
+	<script crossorigin src="https://unpkg.com/react-dom@18/umd/react-dom.production.min.js"></script>
+	<script crossorigin src="https://unpkg.com/graphiql@3/graphiql.min.js"></script>
+</head>
+<body>
+	<div id="graphiql">Loading...</div>
+	<script>
+		const fetcher = GraphiQL.createFetcher({ url: new URL('../graphql', location.href).toString() });
+
+		ReactDOM.createRoot(document.getElementById('graphiql')).render(
+			React.createElement(GraphiQL, { fetcher: fetcher, defaultEditorToolsVisibility: true }),
+		);
+	</script>
+</body>
+</html>
+`
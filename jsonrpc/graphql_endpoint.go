@@ -0,0 +1,1155 @@
+package jsonrpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/graph-gophers/graphql-go"
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+)
+
+const (
+	// graphQLMethod is the name the GraphQL requests are rate limited under
+	graphQLMethod = "graphql"
+
+	// graphQLMaxDepth caps the nesting depth of the selected fields
+	graphQLMaxDepth = 10
+
+	// graphQLMaxNodes caps the number of the selected fields, counted with the fragments expanded
+	graphQLMaxNodes = 1000
+
+	// graphQLMaxAliases caps the number of the aliased fields, so a field can't be repeated cheaply
+	graphQLMaxAliases = 50
+)
+
+// graphQLFieldCosts are the rate limiting costs of the fields which read the chain or the state,
+// charged for every resolved field on top of the request cost. The other fields are free,
+// as they are read from the objects already loaded
+var graphQLFieldCosts = map[string]int{
+	"Query.block":                   1,
+	"Query.blocks":                  5,
+	"Query.transaction":             1,
+	"Query.logs":                    10,
+	"Block.parent":                  1,
+	"Block.logs":                    10,
+	"Block.call":                    5,
+	"Block.estimateGas":             5,
+	"Pending.call":                  5,
+	"Pending.estimateGas":           5,
+	"Transaction.block":             1,
+	"Transaction.status":            1,
+	"Transaction.gasUsed":           1,
+	"Transaction.cumulativeGasUsed": 1,
+	"Transaction.createdContract":   1,
+	"Transaction.logs":              1,
+	"Log.transaction":               1,
+	"Account.balance":               1,
+	"Account.transactionCount":      1,
+	"Account.code":                  1,
+	"Account.storage":               1,
+}
+
+var (
+	ErrGraphQLDisabled       = errors.New("graphql is disabled")
+	ErrInvalidGraphQLRequest = errors.New("invalid graphql request")
+	ErrGraphQLBatchTooLong   = errors.New("batch request length too long")
+)
+
+// graphQLStore provides access to the methods needed by the graphql endpoint
+type graphQLStore interface {
+	ethStore
+	txPoolStore
+}
+
+// graphQLRequest is the GraphQL request, as sent over HTTP
+type graphQLRequest struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// GraphQL serves the EIP-1767 queries, sharing the store and the helpers of the eth endpoint
+type GraphQL struct {
+	store           graphQLStore
+	eth             *Eth
+	blockRangeLimit uint64
+	schema          *graphql.Schema
+}
+
+func newGraphQL(store graphQLStore, eth *Eth, blockRangeLimit uint64) *GraphQL {
+	g := &GraphQL{
+		store:           store,
+		eth:             eth,
+		blockRangeLimit: blockRangeLimit,
+	}
+
+	g.schema = newEthGraphQLSchema(&gqlResolver{g})
+
+	return g
+}
+
+// Execute executes the GraphQL request within the size limits. The mutations are rejected unless allowed
+func (g *GraphQL) Execute(ctx context.Context, req *graphQLRequest, allowMutations bool) *graphql.Response {
+	if err := checkGraphQLRequest(req, allowMutations); err != nil {
+		return graphQLErrorResponse(err)
+	}
+
+	return g.schema.Exec(ctx, req.Query, req.OperationName, req.Variables)
+}
+
+// HandleGraphQL executes the GraphQL request or the batch of the requests
+func (d *Dispatcher) HandleGraphQL(reqBody []byte, allowMutations bool, scope *requestScope) ([]byte, error) {
+	if d.endpoints.GraphQL == nil {
+		return nil, ErrGraphQLDisabled
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(reqBody))
+	decoder.UseNumber()
+
+	if x := bytes.TrimLeft(reqBody, " \t\r\n"); len(x) == 0 || x[0] != '[' {
+		var req graphQLRequest
+		if err := decoder.Decode(&req); err != nil {
+			return json.Marshal(graphQLErrorResponse(ErrInvalidGraphQLRequest))
+		}
+
+		return json.Marshal(d.handleGraphQLReq(&req, allowMutations, scope))
+	}
+
+	var requests []*graphQLRequest
+	if err := decoder.Decode(&requests); err != nil {
+		return json.Marshal(graphQLErrorResponse(ErrInvalidGraphQLRequest))
+	}
+
+	// if not disabled, avoid handling long batch requests
+	if d.params.jsonRPCBatchLengthLimit != 0 && len(requests) > int(d.params.jsonRPCBatchLengthLimit) {
+		return json.Marshal(graphQLErrorResponse(ErrGraphQLBatchTooLong))
+	}
+
+	responses := make([]*graphql.Response, len(requests))
+	for i, req := range requests {
+		responses[i] = d.handleGraphQLReq(req, allowMutations, scope)
+	}
+
+	return json.Marshal(responses)
+}
+
+func (d *Dispatcher) handleGraphQLReq(
+	req *graphQLRequest,
+	allowMutations bool,
+	scope *requestScope,
+) *graphql.Response {
+	d.logger.Debug("graphql request", "operation", req.OperationName)
+
+	release, err := d.limit(graphQLMethod, scope)
+	if err != nil {
+		return graphQLErrorResponse(err)
+	}
+
+	defer release()
+
+	return d.endpoints.GraphQL.Execute(d.graphQLContext(scope), req, allowMutations)
+}
+
+// graphQLChargeKey is the context key of the charge of the resolved fields
+type graphQLChargeKey struct{}
+
+// graphQLContext returns the execution context, which charges the resolved fields
+// to the request sender if the rate limiting is enabled
+func (d *Dispatcher) graphQLContext(scope *requestScope) context.Context {
+	ctx := context.Background()
+
+	if d.rateLimiter == nil {
+		return ctx
+	}
+
+	client := scope.clientID()
+
+	return context.WithValue(ctx, graphQLChargeKey{}, func(cost int) error {
+		if err := d.rateLimiter.charge(client, graphQLMethod, cost, time.Now()); err != nil {
+			return err
+		}
+
+		return nil
+	})
+}
+
+// chargeGraphQLField charges the cost of the field, keyed by its type and name, before it's resolved
+func chargeGraphQLField(ctx context.Context, field string) error {
+	charge, ok := ctx.Value(graphQLChargeKey{}).(func(int) error)
+	if !ok {
+		return nil
+	}
+
+	cost, ok := graphQLFieldCosts[field]
+	if !ok {
+		return nil
+	}
+
+	return charge(cost)
+}
+
+func graphQLErrorResponse(err error) *graphql.Response {
+	return &graphql.Response{
+		Errors: []*gqlerrors.QueryError{{Message: err.Error()}},
+	}
+}
+
+// gqlResolver is the root resolver of the schema
+type gqlResolver struct {
+	g *GraphQL
+}
+
+// gqlBlockArgs are the arguments of the fields taking the optional block number
+type gqlBlockArgs struct {
+	Block *gqlLong
+}
+
+// number returns the block number of the arguments, or the default one if not set
+func (a gqlBlockArgs) number(defaultNumber BlockNumber) BlockNumber {
+	if a.Block == nil {
+		return defaultNumber
+	}
+
+	return BlockNumber(*a.Block)
+}
+
+// gqlCallData is the CallData input
+type gqlCallData struct {
+	From     *gqlAddress
+	To       *gqlAddress
+	Gas      *gqlLong
+	GasPrice *gqlBigInt
+	Value    *gqlBigInt
+	Data     *gqlBytes
+}
+
+// gqlBlockFilterCriteria is the BlockFilterCriteria input
+type gqlBlockFilterCriteria struct {
+	Addresses *[]gqlAddress
+	Topics    *[][]gqlBytes32
+}
+
+// gqlFilterCriteria is the FilterCriteria input
+type gqlFilterCriteria struct {
+	FromBlock *gqlLong
+	ToBlock   *gqlLong
+	Addresses *[]gqlAddress
+	Topics    *[][]gqlBytes32
+}
+
+func (r *gqlResolver) Block(ctx context.Context, args struct {
+	Number *gqlLong
+	Hash   *gqlBytes32
+}) (*gqlBlock, error) {
+	if err := chargeGraphQLField(ctx, "Query.block"); err != nil {
+		return nil, err
+	}
+
+	var (
+		block *types.Block
+		ok    bool
+	)
+
+	switch {
+	case args.Hash != nil:
+		block, ok = r.g.store.GetBlockByHash(types.Hash(*args.Hash), true)
+	case args.Number != nil:
+		block, ok = r.g.store.GetBlockByNumber(uint64(*args.Number), true)
+	default:
+		block, ok = r.g.store.GetBlockByNumber(r.g.store.Header().Number, true)
+	}
+
+	if !ok {
+		return nil, nil
+	}
+
+	return r.g.newBlock(block), nil
+}
+
+func (r *gqlResolver) Blocks(ctx context.Context, args struct {
+	From gqlLong
+	To   *gqlLong
+}) ([]*gqlBlock, error) {
+	if err := chargeGraphQLField(ctx, "Query.blocks"); err != nil {
+		return nil, err
+	}
+
+	from, to, err := r.g.blockRange(&args.From, args.To)
+	if err != nil {
+		return nil, err
+	}
+
+	blocks := make([]*gqlBlock, 0)
+
+	for i := from; i <= to; i++ {
+		block, ok := r.g.store.GetBlockByNumber(i, true)
+		if !ok {
+			break
+		}
+
+		blocks = append(blocks, r.g.newBlock(block))
+	}
+
+	return blocks, nil
+}
+
+func (r *gqlResolver) Pending() *gqlPending {
+	return &gqlPending{r.g}
+}
+
+func (r *gqlResolver) Transaction(ctx context.Context, args struct{ Hash gqlBytes32 }) (*gqlTransaction, error) {
+	if err := chargeGraphQLField(ctx, "Query.transaction"); err != nil {
+		return nil, err
+	}
+
+	return r.g.resolveTransaction(types.Hash(args.Hash)), nil
+}
+
+func (r *gqlResolver) Logs(ctx context.Context, args struct{ Filter gqlFilterCriteria }) ([]*gqlLog, error) {
+	if err := chargeGraphQLField(ctx, "Query.logs"); err != nil {
+		return nil, err
+	}
+
+	from, to, err := r.g.blockRange(args.Filter.FromBlock, args.Filter.ToBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	query := newGraphQLLogQuery(args.Filter.Addresses, args.Filter.Topics)
+	logs := make([]*gqlLog, 0)
+
+	for i := from; i <= to; i++ {
+		block, ok := r.g.store.GetBlockByNumber(i, true)
+		if !ok {
+			break
+		}
+
+		if len(block.Transactions) == 0 {
+			continue
+		}
+
+		blockLogs, err := r.g.newBlock(block).matchingLogs(query)
+		if err != nil {
+			return nil, err
+		}
+
+		logs = append(logs, blockLogs...)
+	}
+
+	return logs, nil
+}
+
+func (r *gqlResolver) GasPrice() (gqlBigInt, error) {
+	price, err := r.g.eth.GasPrice()
+	if err != nil {
+		return gqlBigInt{}, err
+	}
+
+	return newGQLBigInt(new(big.Int).SetUint64(uint64(price.(argUint64)))), nil //nolint:forcetypeassert
+}
+
+func (r *gqlResolver) ProtocolVersion() int32 {
+	return int32(ethProtocolVersion)
+}
+
+func (r *gqlResolver) Syncing() *gqlSyncState {
+	syncProgression := r.g.store.GetSyncProgression()
+	if syncProgression == nil {
+		return nil
+	}
+
+	return &gqlSyncState{
+		startingBlock: syncProgression.StartingBlock,
+		currentBlock:  syncProgression.CurrentBlock,
+		highestBlock:  syncProgression.HighestBlock,
+	}
+}
+
+func (r *gqlResolver) ChainID() gqlBigInt {
+	return newGQLBigInt(new(big.Int).SetUint64(r.g.eth.chainID))
+}
+
+func (r *gqlResolver) SendRawTransaction(args struct{ Data gqlBytes }) (gqlBytes32, error) {
+	hash, err := r.g.eth.SendRawTransaction(argBytes(args.Data))
+	if err != nil {
+		return gqlBytes32{}, err
+	}
+
+	return gqlBytes32(types.StringToHash(hash.(string))), nil //nolint:forcetypeassert
+}
+
+// blockRange returns the range of the block numbers, defaulting to the latest block.
+// The range ends at the latest block at most
+func (g *GraphQL) blockRange(rawFrom, rawTo *gqlLong) (uint64, uint64, error) {
+	latest := g.store.Header().Number
+	from, to := latest, latest
+
+	if rawFrom != nil {
+		from = uint64(*rawFrom)
+	}
+
+	if rawTo != nil {
+		to = uint64(*rawTo)
+	}
+
+	if to < from {
+		return 0, 0, ErrIncorrectBlockRange
+	}
+
+	// if not disabled, avoid handling large block ranges
+	if g.blockRangeLimit != 0 && to-from > g.blockRangeLimit {
+		return 0, 0, ErrBlockRangeTooHigh
+	}
+
+	if to > latest {
+		to = latest
+	}
+
+	return from, to, nil
+}
+
+// resolveTransaction returns the sealed or the pending transaction by its hash
+func (g *GraphQL) resolveTransaction(hash types.Hash) *gqlTransaction {
+	if blockHash, ok := g.store.ReadTxLookup(hash); ok {
+		if block, ok := g.store.GetBlockByHash(blockHash, true); ok {
+			for i, tx := range block.Transactions {
+				if tx.Hash == hash {
+					return g.newBlock(block).transaction(i)
+				}
+			}
+		}
+	}
+
+	if tx, ok := g.store.GetPendingTx(hash); ok {
+		return &gqlTransaction{g: g, tx: tx}
+	}
+
+	return nil
+}
+
+// account returns the account at the state of the block
+func (g *GraphQL) account(address types.Address, number BlockNumber) *gqlAccount {
+	return &gqlAccount{g: g, address: address, number: number}
+}
+
+// call executes the local call at the state of the block
+func (g *GraphQL) call(header *types.Header, data *gqlCallData) (*gqlCallResult, error) {
+	tx, err := DecodeTxn(data.txnArgs(), g.store)
+	if err != nil {
+		return nil, err
+	}
+
+	// the call is limited by the block gas limit, if the gas is not set
+	if tx.Gas == 0 {
+		tx.Gas = header.GasLimit
+	}
+
+	result, err := g.store.ApplyTxn(header, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	status := uint64(types.ReceiptSuccess)
+	if result.Failed() {
+		status = uint64(types.ReceiptFailed)
+	}
+
+	return &gqlCallResult{
+		data:    result.ReturnValue,
+		gasUsed: result.GasUsed,
+		status:  status,
+	}, nil
+}
+
+// estimateGas estimates the gas required by the call at the state of the block
+func (g *GraphQL) estimateGas(number BlockNumber, data *gqlCallData) (gqlLong, error) {
+	gas, err := g.eth.EstimateGas(data.txnArgs(), &number)
+	if err != nil {
+		return 0, err
+	}
+
+	return gqlLong(gas.(argUint64)), nil //nolint:forcetypeassert
+}
+
+// gqlBlock resolves the Block type. The receipts and the logs of the block are loaded once needed
+type gqlBlock struct {
+	g     *GraphQL
+	block *types.Block
+
+	logsLock sync.Mutex
+	logs     []*gqlLog
+}
+
+func (g *GraphQL) newBlock(block *types.Block) *gqlBlock {
+	return &gqlBlock{g: g, block: block}
+}
+
+func (b *gqlBlock) Number() gqlLong {
+	return gqlLong(b.block.Number())
+}
+
+func (b *gqlBlock) Hash() gqlBytes32 {
+	return gqlBytes32(b.block.Header.Hash)
+}
+
+func (b *gqlBlock) Parent(ctx context.Context) (*gqlBlock, error) {
+	if err := chargeGraphQLField(ctx, "Block.parent"); err != nil {
+		return nil, err
+	}
+
+	header := b.block.Header
+	if header.Number == 0 {
+		return nil, nil
+	}
+
+	parent, ok := b.g.store.GetBlockByHash(header.ParentHash, true)
+	if !ok {
+		return nil, nil
+	}
+
+	return b.g.newBlock(parent), nil
+}
+
+func (b *gqlBlock) Nonce() gqlBytes {
+	return gqlBytes(b.block.Header.Nonce[:])
+}
+
+func (b *gqlBlock) TransactionsRoot() gqlBytes32 {
+	return gqlBytes32(b.block.Header.TxRoot)
+}
+
+func (b *gqlBlock) TransactionCount() *gqlLong {
+	return newGQLLongPtr(uint64(len(b.block.Transactions)))
+}
+
+func (b *gqlBlock) StateRoot() gqlBytes32 {
+	return gqlBytes32(b.block.Header.StateRoot)
+}
+
+func (b *gqlBlock) ReceiptsRoot() gqlBytes32 {
+	return gqlBytes32(b.block.Header.ReceiptsRoot)
+}
+
+func (b *gqlBlock) Miner(args gqlBlockArgs) *gqlAccount {
+	header := b.block.Header
+
+	return b.g.account(types.BytesToAddress(header.Miner), args.number(BlockNumber(header.Number)))
+}
+
+func (b *gqlBlock) ExtraData() gqlBytes {
+	return gqlBytes(b.block.Header.ExtraData)
+}
+
+func (b *gqlBlock) GasLimit() gqlLong {
+	return gqlLong(b.block.Header.GasLimit)
+}
+
+func (b *gqlBlock) GasUsed() gqlLong {
+	return gqlLong(b.block.Header.GasUsed)
+}
+
+func (b *gqlBlock) Timestamp() gqlBigInt {
+	return newGQLBigInt(new(big.Int).SetUint64(b.block.Header.Timestamp))
+}
+
+func (b *gqlBlock) LogsBloom() gqlBytes {
+	return gqlBytes(b.block.Header.LogsBloom[:])
+}
+
+func (b *gqlBlock) MixHash() gqlBytes32 {
+	return gqlBytes32(b.block.Header.MixHash)
+}
+
+func (b *gqlBlock) Difficulty() gqlBigInt {
+	return newGQLBigInt(new(big.Int).SetUint64(b.block.Header.Difficulty))
+}
+
+// TotalDifficulty is not needed for POS, it's the same as in the eth namespace
+func (b *gqlBlock) TotalDifficulty() gqlBigInt {
+	return b.Difficulty()
+}
+
+func (b *gqlBlock) OmmerCount() *gqlLong {
+	return newGQLLongPtr(uint64(len(b.block.Uncles)))
+}
+
+func (b *gqlBlock) Ommers() *[]*gqlBlock {
+	ommers := make([]*gqlBlock, len(b.block.Uncles))
+	for i, uncle := range b.block.Uncles {
+		ommers[i] = b.g.newBlock(&types.Block{Header: uncle})
+	}
+
+	return &ommers
+}
+
+func (b *gqlBlock) OmmerAt(args struct{ Index gqlLong }) *gqlBlock {
+	if uint64(args.Index) >= uint64(len(b.block.Uncles)) {
+		return nil
+	}
+
+	return b.g.newBlock(&types.Block{Header: b.block.Uncles[args.Index]})
+}
+
+func (b *gqlBlock) OmmerHash() gqlBytes32 {
+	return gqlBytes32(b.block.Header.Sha3Uncles)
+}
+
+func (b *gqlBlock) Transactions() *[]*gqlTransaction {
+	txs := make([]*gqlTransaction, len(b.block.Transactions))
+	for i := range b.block.Transactions {
+		txs[i] = b.transaction(i)
+	}
+
+	return &txs
+}
+
+func (b *gqlBlock) TransactionAt(args struct{ Index gqlLong }) *gqlTransaction {
+	if uint64(args.Index) >= uint64(len(b.block.Transactions)) {
+		return nil
+	}
+
+	return b.transaction(int(args.Index))
+}
+
+func (b *gqlBlock) Logs(ctx context.Context, args struct{ Filter gqlBlockFilterCriteria }) ([]*gqlLog, error) {
+	if err := chargeGraphQLField(ctx, "Block.logs"); err != nil {
+		return nil, err
+	}
+
+	return b.matchingLogs(newGraphQLLogQuery(args.Filter.Addresses, args.Filter.Topics))
+}
+
+func (b *gqlBlock) Account(args struct{ Address gqlAddress }) *gqlAccount {
+	return b.g.account(types.Address(args.Address), BlockNumber(b.block.Number()))
+}
+
+func (b *gqlBlock) Call(ctx context.Context, args struct{ Data gqlCallData }) (*gqlCallResult, error) {
+	if err := chargeGraphQLField(ctx, "Block.call"); err != nil {
+		return nil, err
+	}
+
+	return b.g.call(b.block.Header, &args.Data)
+}
+
+func (b *gqlBlock) EstimateGas(ctx context.Context, args struct{ Data gqlCallData }) (gqlLong, error) {
+	if err := chargeGraphQLField(ctx, "Block.estimateGas"); err != nil {
+		return 0, err
+	}
+
+	return b.g.estimateGas(BlockNumber(b.block.Number()), &args.Data)
+}
+
+func (b *gqlBlock) transaction(index int) *gqlTransaction {
+	return &gqlTransaction{
+		g:     b.g,
+		tx:    b.block.Transactions[index],
+		block: b,
+		index: index,
+	}
+}
+
+// receipts returns the receipts of the block transactions
+func (b *gqlBlock) receipts() ([]*types.Receipt, error) {
+	receipts, err := b.g.store.GetReceiptsByHash(b.block.Hash())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(receipts) != len(b.block.Transactions) {
+		return nil, fmt.Errorf("receipts for block with hash [%s] not found", b.block.Hash())
+	}
+
+	return receipts, nil
+}
+
+// allLogs returns the logs of the block, indexed within the block.
+// The fields are resolved in parallel, so the loaded logs are guarded
+func (b *gqlBlock) allLogs() ([]*gqlLog, error) {
+	b.logsLock.Lock()
+	defer b.logsLock.Unlock()
+
+	if b.logs != nil {
+		return b.logs, nil
+	}
+
+	receipts, err := b.receipts()
+	if err != nil {
+		return nil, err
+	}
+
+	logs := make([]*gqlLog, 0)
+
+	for i, receipt := range receipts {
+		for _, log := range receipt.Logs {
+			logs = append(logs, &gqlLog{
+				g:     b.g,
+				log:   log,
+				tx:    b.transaction(i),
+				index: uint64(len(logs)),
+			})
+		}
+	}
+
+	b.logs = logs
+
+	return logs, nil
+}
+
+// matchingLogs returns the logs of the block matching the query
+func (b *gqlBlock) matchingLogs(query *LogQuery) ([]*gqlLog, error) {
+	logs, err := b.allLogs()
+	if err != nil {
+		return nil, err
+	}
+
+	matching := make([]*gqlLog, 0)
+
+	for _, log := range logs {
+		if query.Match(log.log) {
+			matching = append(matching, log)
+		}
+	}
+
+	return matching, nil
+}
+
+// gqlTransaction resolves the Transaction type. The block is nil if the transaction is pending
+type gqlTransaction struct {
+	g     *GraphQL
+	tx    *types.Transaction
+	block *gqlBlock
+	index int
+}
+
+func (t *gqlTransaction) Hash() gqlBytes32 {
+	return gqlBytes32(t.tx.Hash)
+}
+
+func (t *gqlTransaction) Nonce() gqlLong {
+	return gqlLong(t.tx.Nonce)
+}
+
+func (t *gqlTransaction) Index() *gqlLong {
+	if t.block == nil {
+		return nil
+	}
+
+	return newGQLLongPtr(uint64(t.index))
+}
+
+func (t *gqlTransaction) From(args gqlBlockArgs) *gqlAccount {
+	return t.g.account(t.tx.From, args.number(LatestBlockNumber))
+}
+
+func (t *gqlTransaction) To(args gqlBlockArgs) *gqlAccount {
+	if t.tx.To == nil {
+		return nil
+	}
+
+	return t.g.account(*t.tx.To, args.number(LatestBlockNumber))
+}
+
+func (t *gqlTransaction) Value() gqlBigInt {
+	return newGQLBigInt(t.tx.Value)
+}
+
+func (t *gqlTransaction) GasPrice() gqlBigInt {
+	return newGQLBigInt(t.tx.GasPrice)
+}
+
+func (t *gqlTransaction) Gas() gqlLong {
+	return gqlLong(t.tx.Gas)
+}
+
+func (t *gqlTransaction) InputData() gqlBytes {
+	return gqlBytes(t.tx.Input)
+}
+
+func (t *gqlTransaction) Block(ctx context.Context) (*gqlBlock, error) {
+	if err := chargeGraphQLField(ctx, "Transaction.block"); err != nil {
+		return nil, err
+	}
+
+	return t.block, nil
+}
+
+func (t *gqlTransaction) Status(ctx context.Context) (*gqlLong, error) {
+	receipt, err := t.receipt(ctx, "Transaction.status")
+	if receipt == nil || receipt.Status == nil {
+		return nil, err
+	}
+
+	return newGQLLongPtr(uint64(*receipt.Status)), nil
+}
+
+func (t *gqlTransaction) GasUsed(ctx context.Context) (*gqlLong, error) {
+	receipt, err := t.receipt(ctx, "Transaction.gasUsed")
+	if receipt == nil {
+		return nil, err
+	}
+
+	return newGQLLongPtr(receipt.GasUsed), nil
+}
+
+func (t *gqlTransaction) CumulativeGasUsed(ctx context.Context) (*gqlLong, error) {
+	receipt, err := t.receipt(ctx, "Transaction.cumulativeGasUsed")
+	if receipt == nil {
+		return nil, err
+	}
+
+	return newGQLLongPtr(receipt.CumulativeGasUsed), nil
+}
+
+func (t *gqlTransaction) CreatedContract(ctx context.Context, args gqlBlockArgs) (*gqlAccount, error) {
+	receipt, err := t.receipt(ctx, "Transaction.createdContract")
+	if receipt == nil || receipt.ContractAddress == nil {
+		return nil, err
+	}
+
+	return t.g.account(*receipt.ContractAddress, args.number(LatestBlockNumber)), nil
+}
+
+func (t *gqlTransaction) Logs(ctx context.Context) (*[]*gqlLog, error) {
+	receipt, err := t.receipt(ctx, "Transaction.logs")
+	if receipt == nil {
+		return nil, err
+	}
+
+	logs, err := t.block.allLogs()
+	if err != nil {
+		return nil, err
+	}
+
+	txLogs := make([]*gqlLog, 0, len(receipt.Logs))
+
+	for _, log := range logs {
+		if log.tx.index == t.index {
+			txLogs = append(txLogs, log)
+		}
+	}
+
+	return &txLogs, nil
+}
+
+func (t *gqlTransaction) R() gqlBigInt {
+	return newGQLBigInt(t.tx.R)
+}
+
+func (t *gqlTransaction) S() gqlBigInt {
+	return newGQLBigInt(t.tx.S)
+}
+
+func (t *gqlTransaction) V() gqlBigInt {
+	return newGQLBigInt(t.tx.V)
+}
+
+// receipt charges the field and returns the receipt of the transaction,
+// or nil if the transaction is pending and doesn't have one yet
+func (t *gqlTransaction) receipt(ctx context.Context, field string) (*types.Receipt, error) {
+	if err := chargeGraphQLField(ctx, field); err != nil {
+		return nil, err
+	}
+
+	if t.block == nil {
+		return nil, nil
+	}
+
+	receipts, err := t.block.receipts()
+	if err != nil {
+		return nil, err
+	}
+
+	return receipts[t.index], nil
+}
+
+// gqlLog resolves the Log type
+type gqlLog struct {
+	g     *GraphQL
+	log   *types.Log
+	tx    *gqlTransaction
+	index uint64
+}
+
+func (l *gqlLog) Index() gqlLong {
+	return gqlLong(l.index)
+}
+
+func (l *gqlLog) Account(args gqlBlockArgs) *gqlAccount {
+	return l.g.account(l.log.Address, args.number(LatestBlockNumber))
+}
+
+func (l *gqlLog) Topics() []gqlBytes32 {
+	topics := make([]gqlBytes32, len(l.log.Topics))
+	for i, topic := range l.log.Topics {
+		topics[i] = gqlBytes32(topic)
+	}
+
+	return topics
+}
+
+func (l *gqlLog) Data() gqlBytes {
+	return gqlBytes(l.log.Data)
+}
+
+func (l *gqlLog) Transaction(ctx context.Context) (*gqlTransaction, error) {
+	if err := chargeGraphQLField(ctx, "Log.transaction"); err != nil {
+		return nil, err
+	}
+
+	return l.tx, nil
+}
+
+// gqlAccount resolves the Account type
+type gqlAccount struct {
+	g       *GraphQL
+	address types.Address
+	number  BlockNumber
+}
+
+func (a *gqlAccount) Address() gqlAddress {
+	return gqlAddress(a.address)
+}
+
+func (a *gqlAccount) Balance(ctx context.Context) (gqlBigInt, error) {
+	if err := chargeGraphQLField(ctx, "Account.balance"); err != nil {
+		return gqlBigInt{}, err
+	}
+
+	header, err := a.header()
+	if err != nil {
+		return gqlBigInt{}, err
+	}
+
+	acc, err := a.g.store.GetAccount(header.StateRoot, a.address)
+	if errors.Is(err, ErrStateNotFound) {
+		return gqlBigInt{}, nil
+	} else if err != nil {
+		return gqlBigInt{}, err
+	}
+
+	return newGQLBigInt(acc.Balance), nil
+}
+
+func (a *gqlAccount) TransactionCount(ctx context.Context) (gqlLong, error) {
+	if err := chargeGraphQLField(ctx, "Account.transactionCount"); err != nil {
+		return 0, err
+	}
+
+	nonce, err := GetNextNonce(a.address, a.number, a.g.store)
+	if errors.Is(err, ErrStateNotFound) {
+		return 0, nil
+	} else if err != nil {
+		return 0, err
+	}
+
+	return gqlLong(nonce), nil
+}
+
+func (a *gqlAccount) Code(ctx context.Context) (gqlBytes, error) {
+	if err := chargeGraphQLField(ctx, "Account.code"); err != nil {
+		return nil, err
+	}
+
+	header, err := a.header()
+	if err != nil {
+		return nil, err
+	}
+
+	code, err := a.g.store.GetCode(header.StateRoot, a.address)
+	if errors.Is(err, ErrStateNotFound) {
+		return gqlBytes{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return gqlBytes(code), nil
+}
+
+func (a *gqlAccount) Storage(ctx context.Context, args struct{ Slot gqlBytes32 }) (gqlBytes32, error) {
+	if err := chargeGraphQLField(ctx, "Account.storage"); err != nil {
+		return gqlBytes32{}, err
+	}
+
+	value, err := a.g.eth.GetStorageAt(a.address, types.Hash(args.Slot), BlockNumberOrHash{BlockNumber: &a.number})
+	if err != nil {
+		return gqlBytes32{}, err
+	}
+
+	return gqlBytes32(types.BytesToHash(*value.(*argBytes))), nil //nolint:forcetypeassert
+}
+
+// header returns the header of the block the account state is read at
+func (a *gqlAccount) header() (*types.Header, error) {
+	return GetHeaderFromBlockNumberOrHash(BlockNumberOrHash{BlockNumber: &a.number}, a.g.store)
+}
+
+// gqlPending resolves the Pending type
+type gqlPending struct {
+	g *GraphQL
+}
+
+func (p *gqlPending) TransactionCount() gqlLong {
+	return gqlLong(len(p.transactions()))
+}
+
+func (p *gqlPending) Transactions() *[]*gqlTransaction {
+	txs := p.transactions()
+
+	return &txs
+}
+
+func (p *gqlPending) Account(args struct{ Address gqlAddress }) *gqlAccount {
+	return p.g.account(types.Address(args.Address), PendingBlockNumber)
+}
+
+func (p *gqlPending) Call(ctx context.Context, args struct{ Data gqlCallData }) (*gqlCallResult, error) {
+	if err := chargeGraphQLField(ctx, "Pending.call"); err != nil {
+		return nil, err
+	}
+
+	return p.g.call(p.g.store.Header(), &args.Data)
+}
+
+func (p *gqlPending) EstimateGas(ctx context.Context, args struct{ Data gqlCallData }) (gqlLong, error) {
+	if err := chargeGraphQLField(ctx, "Pending.estimateGas"); err != nil {
+		return 0, err
+	}
+
+	return p.g.estimateGas(LatestBlockNumber, &args.Data)
+}
+
+// transactions returns the pending transactions of the pool, ordered by the sender and the nonce
+func (p *gqlPending) transactions() []*gqlTransaction {
+	pending, _ := p.g.store.GetTxs(false)
+
+	senders := make([]types.Address, 0, len(pending))
+	for sender := range pending {
+		senders = append(senders, sender)
+	}
+
+	sort.Slice(senders, func(i, j int) bool {
+		return bytes.Compare(senders[i].Bytes(), senders[j].Bytes()) < 0
+	})
+
+	txs := make([]*gqlTransaction, 0)
+
+	for _, sender := range senders {
+		for _, tx := range pending[sender] {
+			txs = append(txs, &gqlTransaction{g: p.g, tx: tx})
+		}
+	}
+
+	return txs
+}
+
+// gqlCallResult resolves the CallResult type
+type gqlCallResult struct {
+	data    []byte
+	gasUsed uint64
+	status  uint64
+}
+
+func (r *gqlCallResult) Data() gqlBytes {
+	return gqlBytes(r.data)
+}
+
+func (r *gqlCallResult) GasUsed() gqlLong {
+	return gqlLong(r.gasUsed)
+}
+
+func (r *gqlCallResult) Status() gqlLong {
+	return gqlLong(r.status)
+}
+
+// gqlSyncState resolves the SyncState type
+type gqlSyncState struct {
+	startingBlock uint64
+	currentBlock  uint64
+	highestBlock  uint64
+}
+
+func (s *gqlSyncState) StartingBlock() gqlLong {
+	return gqlLong(s.startingBlock)
+}
+
+func (s *gqlSyncState) CurrentBlock() gqlLong {
+	return gqlLong(s.currentBlock)
+}
+
+func (s *gqlSyncState) HighestBlock() gqlLong {
+	return gqlLong(s.highestBlock)
+}
+
+func (s *gqlSyncState) PulledStates() *gqlLong {
+	return nil
+}
+
+func (s *gqlSyncState) KnownStates() *gqlLong {
+	return nil
+}
+
+// newGraphQLLogQuery converts the addresses and the topics of the filter criteria into the log query
+func newGraphQLLogQuery(addresses *[]gqlAddress, topics *[][]gqlBytes32) *LogQuery {
+	query := &LogQuery{}
+
+	if addresses != nil {
+		for _, address := range *addresses {
+			query.Addresses = append(query.Addresses, types.Address(address))
+		}
+	}
+
+	if topics != nil {
+		for _, rawSet := range *topics {
+			set := []types.Hash{}
+
+			for _, topic := range rawSet {
+				set = append(set, types.Hash(topic))
+			}
+
+			query.Topics = append(query.Topics, set)
+		}
+	}
+
+	return query
+}
+
+// txnArgs converts the CallData input into the transaction arguments
+func (c *gqlCallData) txnArgs() *txnArgs {
+	args := &txnArgs{}
+
+	if c.From != nil {
+		from := types.Address(*c.From)
+		args.From = &from
+	}
+
+	if c.To != nil {
+		to := types.Address(*c.To)
+		args.To = &to
+	}
+
+	if c.Gas != nil {
+		args.Gas = argUintPtr(uint64(*c.Gas))
+	}
+
+	if c.GasPrice != nil {
+		args.GasPrice = argBytesPtr(c.GasPrice.toBig().Bytes())
+	}
+
+	if c.Value != nil {
+		args.Value = argBytesPtr(c.Value.toBig().Bytes())
+	}
+
+	if c.Data != nil {
+		args.Data = argBytesPtr(*c.Data)
+	}
+
+	return args
+}
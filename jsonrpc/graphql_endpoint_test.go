@@ -0,0 +1,344 @@
+package jsonrpc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/graph-gophers/graphql-go"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// graphQLTestStore is the conformance chain with the pending transactions of the pool
+type graphQLTestStore struct {
+	*conformanceStore
+
+	pending map[types.Address][]*types.Transaction
+}
+
+func (s *graphQLTestStore) GetTxs(inclQueued bool) (
+	map[types.Address][]*types.Transaction,
+	map[types.Address][]*types.Transaction,
+) {
+	return s.pending, nil
+}
+
+func (s *graphQLTestStore) GetCapacity() (uint64, uint64) {
+	return 0, 0
+}
+
+func newTestGraphQLDispatcher(t *testing.T, params *dispatcherParams) (*Dispatcher, *graphQLTestStore) {
+	t.Helper()
+
+	store := &graphQLTestStore{
+		conformanceStore: newConformanceStore(),
+		pending: map[types.Address][]*types.Transaction{
+			addr2: {newTestTransaction(3, addr2)},
+			addr1: {newTestTransaction(5, addr1), newTestTransaction(6, addr1)},
+		},
+	}
+
+	d := &Dispatcher{
+		logger:      hclog.NewNullLogger(),
+		params:      params,
+		rateLimiter: newRateLimiter(params.rateLimit),
+	}
+	d.endpoints.Eth = newTestEthEndpoint(store)
+	d.endpoints.GraphQL = newGraphQL(store, d.endpoints.Eth, params.blockRangeLimit)
+
+	return d, store
+}
+
+func executeGraphQL(t *testing.T, d *Dispatcher, req *graphQLRequest, allowMutations bool) *graphql.Response {
+	t.Helper()
+
+	body, err := json.Marshal(req)
+	require.NoError(t, err)
+
+	data, err := d.HandleGraphQL(body, allowMutations, nil)
+	require.NoError(t, err)
+
+	var resp graphql.Response
+	require.NoError(t, json.Unmarshal(data, &resp))
+
+	return &resp
+}
+
+func TestGraphQL_Queries(t *testing.T) {
+	t.Parallel()
+
+	d, _ := newTestGraphQLDispatcher(t, &dispatcherParams{blockRangeLimit: 10})
+
+	cases := []struct {
+		name     string
+		query    string
+		expected string
+	}{
+		{
+			name: "block",
+			query: `{
+				block {
+					number
+					parent { number }
+					transactionCount
+					ommerCount
+					miner { address }
+					timestamp
+					transactions { index status gasUsed cumulativeGasUsed logs { index data } }
+				}
+			}`,
+			expected: `{"block": {
+				"number": "0x1",
+				"parent": {"number": "0x0"},
+				"transactionCount": "0x2",
+				"ommerCount": "0x1",
+				"miner": {"address": "` + addr0.String() + `"},
+				"timestamp": "0x3e8",
+				"transactions": [
+					{"index": "0x0", "status": "0x1", "gasUsed": "0x5208", "cumulativeGasUsed": "0x5208",
+						"logs": [{"index": "0x0", "data": "0x01"}]},
+					{"index": "0x1", "status": "0x1", "gasUsed": "0x5208", "cumulativeGasUsed": "0xa410",
+						"logs": [{"index": "0x1", "data": "0x"}, {"index": "0x2", "data": "0x02"}]}
+				]
+			}}`,
+		},
+		{
+			name:     "block by number without parent",
+			query:    `{ block(number: 0) { hash parent { number } } }`,
+			expected: `{"block": {"hash": "` + types.StringToHash("0x10").String() + `", "parent": null}}`,
+		},
+		{
+			name:     "unknown block",
+			query:    `{ block(number: 5) { number } }`,
+			expected: `{"block": null}`,
+		},
+		{
+			name:     "blocks",
+			query:    `{ blocks(from: 0) { number } }`,
+			expected: `{"blocks": [{"number": "0x0"}, {"number": "0x1"}]}`,
+		},
+		{
+			name: "logs",
+			query: `{ logs(filter: {fromBlock: 0, topics: [["` + hash1.String() + `"], ["` + hash2.String() + `"]]}) {
+				index
+				transaction { nonce }
+			} }`,
+			expected: `{"logs": [{"index": "0x1", "transaction": {"nonce": "0x2"}}]}`,
+		},
+		{
+			name: "pending",
+			query: `{ pending {
+				transactionCount
+				transactions { nonce index block { number } status from { address } }
+			} }`,
+			expected: `{"pending": {
+				"transactionCount": "0x3",
+				"transactions": [
+					{"nonce": "0x5", "index": null, "block": null, "status": null, "from": {"address": "` + addr1.String() + `"}},
+					{"nonce": "0x6", "index": null, "block": null, "status": null, "from": {"address": "` + addr1.String() + `"}},
+					{"nonce": "0x3", "index": null, "block": null, "status": null, "from": {"address": "` + addr2.String() + `"}}
+				]
+			}}`,
+		},
+		{
+			name:     "call",
+			query:    `{ block { call(data: {to: "` + addr1.String() + `", data: "0x01"}) { status data } } }`,
+			expected: `{"block": {"call": {"status": "0x1", "data": "0x"}}}`,
+		},
+		{
+			name:     "chain",
+			query:    `{ chainID protocolVersion syncing { currentBlock } }`,
+			expected: `{"chainID": "0x64", "protocolVersion": 65, "syncing": null}`,
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := executeGraphQL(t, d, &graphQLRequest{Query: c.query}, false)
+			require.Empty(t, resp.Errors)
+			assert.JSONEq(t, c.expected, string(resp.Data))
+		})
+	}
+}
+
+func TestGraphQL_Transaction(t *testing.T) {
+	t.Parallel()
+
+	d, store := newTestGraphQLDispatcher(t, &dispatcherParams{})
+
+	pending := newTestTransaction(7, addr2)
+	store.pendingTxns = []*types.Transaction{pending}
+
+	head, ok := store.GetBlockByNumber(1, true)
+	require.True(t, ok)
+
+	query := `query ($hash: Bytes32!) { transaction(hash: $hash) { nonce block { number } status } }`
+
+	resp := executeGraphQL(t, d, &graphQLRequest{
+		Query:     query,
+		Variables: map[string]interface{}{"hash": head.Transactions[1].Hash.String()},
+	}, false)
+	require.Empty(t, resp.Errors)
+	assert.JSONEq(t, `{"transaction": {"nonce": "0x2", "block": {"number": "0x1"}, "status": "0x1"}}`, string(resp.Data))
+
+	resp = executeGraphQL(t, d, &graphQLRequest{
+		Query:     query,
+		Variables: map[string]interface{}{"hash": pending.Hash.String()},
+	}, false)
+	require.Empty(t, resp.Errors)
+	assert.JSONEq(t, `{"transaction": {"nonce": "0x7", "block": null, "status": null}}`, string(resp.Data))
+
+	resp = executeGraphQL(t, d, &graphQLRequest{
+		Query:     query,
+		Variables: map[string]interface{}{"hash": "0x01"},
+	}, false)
+	require.Len(t, resp.Errors, 1)
+}
+
+func TestGraphQL_Call_Failed(t *testing.T) {
+	t.Parallel()
+
+	d, store := newTestGraphQLDispatcher(t, &dispatcherParams{})
+	store.ethCallError = errors.New("execution reverted")
+
+	resp := executeGraphQL(t, d, &graphQLRequest{
+		Query: `{ pending { call(data: {to: "` + addr1.String() + `"}) { status } } }`,
+	}, false)
+	require.Empty(t, resp.Errors)
+	assert.JSONEq(t, `{"pending": {"call": {"status": "0x0"}}}`, string(resp.Data))
+}
+
+func TestGraphQL_Limits(t *testing.T) {
+	t.Parallel()
+
+	d, _ := newTestGraphQLDispatcher(t, &dispatcherParams{
+		blockRangeLimit:         1,
+		jsonRPCBatchLengthLimit: 2,
+		rateLimit:               &RateLimitConfig{Rate: 0.001, Burst: 25},
+	})
+
+	resp := executeGraphQL(t, d, &graphQLRequest{Query: `{ logs(filter: {fromBlock: 0, toBlock: 5}) { index } }`}, false)
+	require.Len(t, resp.Errors, 1)
+	assert.Equal(t, ErrBlockRangeTooHigh.Error(), resp.Errors[0].Message)
+
+	resp = executeGraphQL(t, d, &graphQLRequest{Query: `{ blocks(from: 1, to: 0) { number } }`}, false)
+	require.Len(t, resp.Errors, 1)
+	assert.Equal(t, ErrIncorrectBlockRange.Error(), resp.Errors[0].Message)
+
+	data, err := d.HandleGraphQL([]byte(`[{"query": "{ gasPrice }"}, {"query": "{ gasPrice }"}, {"query": "{ gasPrice }"}]`), false, nil)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), ErrGraphQLBatchTooLong.Error())
+
+	// the requests above cost 17 units with their fields, the free fields cost only the request
+	resp = executeGraphQL(t, d, &graphQLRequest{Query: `{ gasPrice }`}, false)
+	require.Empty(t, resp.Errors)
+
+	// the request is admitted, but the remaining burst doesn't cover the logs
+	resp = executeGraphQL(t, d, &graphQLRequest{Query: `{ logs(filter: {fromBlock: 0, toBlock: 0}) { index } }`}, false)
+	require.Len(t, resp.Errors, 1)
+	assert.Equal(t, "request rate limit exceeded", resp.Errors[0].Message)
+	assert.Equal(t, []interface{}{"logs"}, resp.Errors[0].Path)
+}
+
+func TestGraphQL_SizeLimits(t *testing.T) {
+	t.Parallel()
+
+	d, _ := newTestGraphQLDispatcher(t, &dispatcherParams{})
+
+	query := "{ block { " + strings.Repeat("parent { ", graphQLMaxDepth) + "number" +
+		strings.Repeat(" }", graphQLMaxDepth) + " } }"
+
+	resp := executeGraphQL(t, d, &graphQLRequest{Query: query}, false)
+	require.Len(t, resp.Errors, 1)
+	assert.Contains(t, resp.Errors[0].Message, "query depth exceeds the limit")
+
+	aliases := make([]string, graphQLMaxAliases+1)
+	for i := range aliases {
+		aliases[i] = fmt.Sprintf("a%d: gasPrice", i)
+	}
+
+	resp = executeGraphQL(t, d, &graphQLRequest{Query: "{ " + strings.Join(aliases, " ") + " }"}, false)
+	require.Len(t, resp.Errors, 1)
+	assert.Contains(t, resp.Errors[0].Message, "query uses more than")
+
+	// the fragment cycles are not expanded by the limits, but rejected by the validation
+	resp = executeGraphQL(t, d, &graphQLRequest{
+		Query: "{ block { ...a } } fragment a on Block { parent { ...b } } fragment b on Block { parent { ...a } }",
+	}, false)
+	require.NotEmpty(t, resp.Errors)
+	assert.Nil(t, resp.Data)
+}
+
+func TestGraphQL_Mutation(t *testing.T) {
+	t.Parallel()
+
+	d, _ := newTestGraphQLDispatcher(t, &dispatcherParams{})
+
+	req := &graphQLRequest{Query: `mutation { sendRawTransaction(data: "0x01") }`}
+
+	resp := executeGraphQL(t, d, req, false)
+	require.Len(t, resp.Errors, 1)
+	assert.Equal(t, "mutation operations are not allowed", resp.Errors[0].Message)
+
+	// the transaction is decoded before it reaches the pool
+	resp = executeGraphQL(t, d, req, true)
+	require.Len(t, resp.Errors, 1)
+	assert.Equal(t, []interface{}{"sendRawTransaction"}, resp.Errors[0].Path)
+}
+
+func TestGraphQL_HTTP(t *testing.T) {
+	t.Parallel()
+
+	d, _ := newTestGraphQLDispatcher(t, &dispatcherParams{})
+
+	j := &JSONRPC{
+		logger:     hclog.NewNullLogger(),
+		config:     &Config{GraphQL: true, GraphiQL: true},
+		dispatcher: d,
+	}
+
+	l := &listener{name: publicListener, scope: &requestScope{}}
+	mux := http.NewServeMux()
+
+	j.registerGraphQL(mux, l)
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	query := url.Values{}
+	query.Set("query", `query ($n: Long) { block(number: $n) { number } }`)
+	query.Set("variables", `{"n": 0}`)
+
+	resp, err := http.Get(server.URL + "/graphql?" + query.Encode())
+	require.NoError(t, err)
+
+	var getResp graphql.Response
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&getResp))
+	require.NoError(t, resp.Body.Close())
+	assert.JSONEq(t, `{"block": {"number": "0x0"}}`, string(getResp.Data))
+
+	resp, err = http.Post(server.URL+"/graphql", "application/json", strings.NewReader(`{"query": "{ chainID }"}`))
+	require.NoError(t, err)
+
+	var postResp graphql.Response
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&postResp))
+	require.NoError(t, resp.Body.Close())
+	assert.JSONEq(t, `{"chainID": "0x64"}`, string(postResp.Data))
+
+	resp, err = http.Get(server.URL + "/graphql/ui")
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	assert.Equal(t, "text/html; charset=utf-8", resp.Header.Get("Content-Type"))
+}
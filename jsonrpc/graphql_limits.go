@@ -0,0 +1,112 @@
+package jsonrpc
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/parser"
+)
+
+var errGraphQLMutationsNotAllowed = errors.New("mutation operations are not allowed")
+
+// graphQLSize is the static size of the operation selection
+type graphQLSize struct {
+	depth   int
+	nodes   int
+	aliases int
+
+	// fragments are the fragments being expanded, as the fragment cycles are rejected
+	// only once the request is validated by the execution
+	fragments map[string]struct{}
+}
+
+// checkGraphQLRequest checks the operation of the request is allowed and its selection doesn't exceed the limits,
+// before the request is executed. The operations which are not found are left to the execution to reject
+func checkGraphQLRequest(req *graphQLRequest, allowMutations bool) error {
+	doc, err := parser.ParseQuery(&ast.Source{Input: req.Query})
+	if err != nil {
+		return err
+	}
+
+	op := doc.Operations.ForName(req.OperationName)
+	if op == nil {
+		return nil
+	}
+
+	if op.Operation == ast.Mutation && !allowMutations {
+		return errGraphQLMutationsNotAllowed
+	}
+
+	size := &graphQLSize{fragments: make(map[string]struct{})}
+
+	return size.measure(doc, op.SelectionSet, 1)
+}
+
+// measure adds the fields of the selection set at the given depth to the size.
+// The fragment spreads are expanded, so the walk is aborted as soon as the size
+// exceeds a limit, before the nested fragments can blow it up
+func (s *graphQLSize) measure(doc *ast.QueryDocument, selections ast.SelectionSet, depth int) error {
+	for _, selection := range selections {
+		switch selection := selection.(type) {
+		case *ast.Field:
+			s.nodes++
+
+			// the alias of the field is its name, unless it's aliased
+			if selection.Alias != selection.Name {
+				s.aliases++
+			}
+
+			if depth > s.depth {
+				s.depth = depth
+			}
+
+			if err := s.check(); err != nil {
+				return err
+			}
+
+			if err := s.measure(doc, selection.SelectionSet, depth+1); err != nil {
+				return err
+			}
+
+		case *ast.FragmentSpread:
+			fragment := doc.Fragments.ForName(selection.Name)
+			if fragment == nil {
+				continue
+			}
+
+			if _, ok := s.fragments[fragment.Name]; ok {
+				continue
+			}
+
+			s.fragments[fragment.Name] = struct{}{}
+
+			if err := s.measure(doc, fragment.SelectionSet, depth); err != nil {
+				return err
+			}
+
+			delete(s.fragments, fragment.Name)
+
+		case *ast.InlineFragment:
+			if err := s.measure(doc, selection.SelectionSet, depth); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// check returns the error if the size exceeds one of the limits
+func (s *graphQLSize) check() error {
+	switch {
+	case s.depth > graphQLMaxDepth:
+		return fmt.Errorf("query depth exceeds the limit of %d", graphQLMaxDepth)
+	case s.nodes > graphQLMaxNodes:
+		return fmt.Errorf("query selects more than %d fields", graphQLMaxNodes)
+	case s.aliases > graphQLMaxAliases:
+		return fmt.Errorf("query uses more than %d aliases", graphQLMaxAliases)
+	default:
+		return nil
+	}
+}
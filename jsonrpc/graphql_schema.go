@@ -0,0 +1,486 @@
+package jsonrpc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/graph-gophers/graphql-go"
+)
+
+// ethGraphQLSchema is the standard Ethereum GraphQL schema, as defined by EIP-1767
+const ethGraphQLSchema = `
+"Bytes32 is the 32 byte binary string, represented as the 0x-prefixed hexadecimal"
+scalar Bytes32
+
+"Address is the 20 byte Ethereum address, represented as the 0x-prefixed hexadecimal"
+scalar Address
+
+"Bytes is the arbitrary length binary string, represented as the 0x-prefixed hexadecimal"
+scalar Bytes
+
+"""
+BigInt is the large integer. The input is accepted as the JSON number or as the decimal
+or the 0x-prefixed hexadecimal string, the output is the 0x-prefixed hexadecimal
+"""
+scalar BigInt
+
+"""
+Long is the 64 bit unsigned integer. The input is accepted as the JSON number or as the decimal
+or the 0x-prefixed hexadecimal string, the output is the 0x-prefixed hexadecimal
+"""
+scalar Long
+
+schema {
+  query: Query
+  mutation: Mutation
+}
+
+"Account is the Ethereum account at the particular block"
+type Account {
+  "Address is the address owning the account"
+  address: Address!
+  "Balance is the balance of the account, in wei"
+  balance: BigInt!
+  "TransactionCount is the number of the transactions sent from the account, also known as the nonce"
+  transactionCount: Long!
+  "Code is the smart contract code of the account, if the account is a contract"
+  code: Bytes!
+  "Storage is the value of the contract storage slot"
+  storage(slot: Bytes32!): Bytes32!
+}
+
+"Log is the Ethereum event log"
+type Log {
+  "Index is the index of the log in the block"
+  index: Long!
+  "Account is the contract account which emitted the log"
+  account(block: Long): Account!
+  "Topics is the list of the 0-4 indexed topics of the log"
+  topics: [Bytes32!]!
+  "Data is the unindexed data of the log"
+  data: Bytes!
+  "Transaction is the transaction which emitted the log"
+  transaction: Transaction!
+}
+
+"Transaction is the Ethereum transaction"
+type Transaction {
+  "Hash is the hash of the transaction"
+  hash: Bytes32!
+  "Nonce is the nonce of the account the transaction was sent with"
+  nonce: Long!
+  "Index is the index of the transaction in its block, null if the transaction is pending"
+  index: Long
+  "From is the account which sent the transaction"
+  from(block: Long): Account!
+  "To is the account the transaction was sent to, null for the contract creations"
+  to(block: Long): Account
+  "Value is the value sent along with the transaction, in wei"
+  value: BigInt!
+  "GasPrice is the price offered for the gas, in wei per unit"
+  gasPrice: BigInt!
+  "Gas is the maximum amount of the gas the transaction can consume"
+  gas: Long!
+  "InputData is the data supplied to the target of the transaction"
+  inputData: Bytes!
+  "Block is the block the transaction was included in, null if the transaction is pending"
+  block: Block
+  "Status is 1 if the transaction succeeded and 0 if it failed, null if the transaction is pending"
+  status: Long
+  "GasUsed is the amount of the gas used by the transaction, null if the transaction is pending"
+  gasUsed: Long
+  "CumulativeGasUsed is the gas used by the block up to and including the transaction, null if pending"
+  cumulativeGasUsed: Long
+  "CreatedContract is the account created by the transaction, null if there is none or it is pending"
+  createdContract(block: Long): Account
+  "Logs is the list of the logs emitted by the transaction, null if the transaction is pending"
+  logs: [Log!]
+  "R is the R component of the transaction signature"
+  r: BigInt!
+  "S is the S component of the transaction signature"
+  s: BigInt!
+  "V is the V component of the transaction signature"
+  v: BigInt!
+}
+
+"BlockFilterCriteria is the criteria of the logs of the single block"
+input BlockFilterCriteria {
+  "Addresses is the list of the addresses of interest, the logs are not filtered by the address if empty"
+  addresses: [Address!]
+  """
+  Topics is the list of the alternative topics matched by the log topics at the same position.
+  The empty list matches any topic
+  """
+  topics: [[Bytes32!]!]
+}
+
+"Block is the Ethereum block"
+type Block {
+  "Number is the number of the block, starting at 0 for the genesis block"
+  number: Long!
+  "Hash is the hash of the block"
+  hash: Bytes32!
+  "Parent is the parent block of the block, null for the genesis block"
+  parent: Block
+  "Nonce is the 8 byte block nonce"
+  nonce: Bytes!
+  "TransactionsRoot is the root of the trie of the block transactions"
+  transactionsRoot: Bytes32!
+  "TransactionCount is the number of the transactions in the block"
+  transactionCount: Long
+  "StateRoot is the root of the state trie after the block was processed"
+  stateRoot: Bytes32!
+  "ReceiptsRoot is the root of the trie of the block transaction receipts"
+  receiptsRoot: Bytes32!
+  "Miner is the account which proposed the block"
+  miner(block: Long): Account!
+  "ExtraData is the arbitrary data supplied by the proposer"
+  extraData: Bytes!
+  "GasLimit is the maximum amount of the gas available to the block transactions"
+  gasLimit: Long!
+  "GasUsed is the amount of the gas used by the block transactions"
+  gasUsed: Long!
+  "Timestamp is the unix timestamp of the block"
+  timestamp: BigInt!
+  "LogsBloom is the bloom filter of the block logs"
+  logsBloom: Bytes!
+  "MixHash is the mix hash of the block header"
+  mixHash: Bytes32!
+  "Difficulty is the difficulty of the block"
+  difficulty: BigInt!
+  "TotalDifficulty is the sum of the difficulties up to and including the block"
+  totalDifficulty: BigInt!
+  "OmmerCount is the number of the ommers (uncles) of the block"
+  ommerCount: Long
+  "Ommers is the list of the ommers (uncles) of the block"
+  ommers: [Block]
+  "OmmerAt returns the ommer (uncle) at the index, null if the index is out of bounds"
+  ommerAt(index: Long!): Block
+  "OmmerHash is the hash of the ommers (uncles) of the block"
+  ommerHash: Bytes32!
+  "Transactions is the list of the block transactions"
+  transactions: [Transaction!]
+  "TransactionAt returns the transaction at the index, null if the index is out of bounds"
+  transactionAt(index: Long!): Transaction
+  "Logs returns the logs of the block matching the filter"
+  logs(filter: BlockFilterCriteria!): [Log!]!
+  "Account returns the account at the state of the block"
+  account(address: Address!): Account!
+  "Call executes the local call at the state of the block"
+  call(data: CallData!): CallResult
+  "EstimateGas estimates the gas required by the transaction at the state of the block"
+  estimateGas(data: CallData!): Long!
+}
+
+"CallData is the data of the local contract call"
+input CallData {
+  "From is the address making the call"
+  from: Address
+  "To is the address the call is sent to"
+  to: Address
+  "Gas is the amount of the gas sent with the call"
+  gas: Long
+  "GasPrice is the price offered for the gas, in wei per unit"
+  gasPrice: BigInt
+  "Value is the value sent along with the call, in wei"
+  value: BigInt
+  "Data is the data sent to the callee"
+  data: Bytes
+}
+
+"CallResult is the result of the local call"
+type CallResult {
+  "Data is the data returned by the called contract"
+  data: Bytes!
+  "GasUsed is the amount of the gas used by the call"
+  gasUsed: Long!
+  "Status is 1 if the call succeeded and 0 if it failed"
+  status: Long!
+}
+
+"FilterCriteria is the criteria of the logs searched in the range of the blocks"
+input FilterCriteria {
+  "FromBlock is the first block searched, the latest one if not set"
+  fromBlock: Long
+  "ToBlock is the last block searched, the latest one if not set"
+  toBlock: Long
+  "Addresses is the list of the addresses of interest, the logs are not filtered by the address if empty"
+  addresses: [Address!]
+  """
+  Topics is the list of the alternative topics matched by the log topics at the same position.
+  The empty list matches any topic
+  """
+  topics: [[Bytes32!]!]
+}
+
+"SyncState is the synchronisation state of the node"
+type SyncState {
+  "StartingBlock is the number of the block the synchronisation started at"
+  startingBlock: Long!
+  "CurrentBlock is the number of the block the synchronisation reached"
+  currentBlock: Long!
+  "HighestBlock is the number of the latest known block"
+  highestBlock: Long!
+  "PulledStates is the number of the state entries fetched so far, null if not known"
+  pulledStates: Long
+  "KnownStates is the number of the state entries known so far, null if not known"
+  knownStates: Long
+}
+
+"Pending is the pending state of the node"
+type Pending {
+  "TransactionCount is the number of the pending transactions"
+  transactionCount: Long!
+  "Transactions is the list of the pending transactions"
+  transactions: [Transaction!]
+  "Account returns the account at the pending state"
+  account(address: Address!): Account!
+  "Call executes the local call at the pending state"
+  call(data: CallData!): CallResult
+  "EstimateGas estimates the gas required by the transaction at the pending state"
+  estimateGas(data: CallData!): Long!
+}
+
+type Query {
+  "Block returns the block by its number or hash, the latest block if neither is set"
+  block(number: Long, hash: Bytes32): Block
+  "Blocks returns the blocks in the range, inclusive. The range ends at the latest block if to is not set"
+  blocks(from: Long!, to: Long): [Block!]!
+  "Pending returns the pending state of the node"
+  pending: Pending!
+  "Transaction returns the transaction by its hash"
+  transaction(hash: Bytes32!): Transaction
+  "Logs returns the logs matching the filter"
+  logs(filter: FilterCriteria!): [Log!]!
+  "GasPrice returns the gas price sufficient for the transaction to be included in a timely fashion"
+  gasPrice: BigInt!
+  "ProtocolVersion returns the version of the eth wire protocol"
+  protocolVersion: Int!
+  "Syncing returns the synchronisation state, null if the node is not syncing"
+  syncing: SyncState
+  "ChainID returns the chain id used for the transaction replay protection"
+  chainID: BigInt!
+}
+
+type Mutation {
+  "SendRawTransaction sends the RLP encoded transaction to the network"
+  sendRawTransaction(data: Bytes!): Bytes32!
+}
+`
+
+var (
+	errInvalidHexString = errors.New("expected 0x-prefixed hex string")
+	errInvalidBigInt    = errors.New("expected non-negative integer")
+	errInvalidLong      = errors.New("expected 64 bit unsigned integer")
+)
+
+// newEthGraphQLSchema parses the EIP-1767 schema and binds it to the root resolver
+func newEthGraphQLSchema(resolver *gqlResolver) *graphql.Schema {
+	schema, err := graphql.ParseSchema(ethGraphQLSchema, resolver, graphql.UseStringDescriptions())
+	if err != nil {
+		panic(fmt.Sprintf("jsonrpc: invalid graphql schema: %v", err))
+	}
+
+	return schema
+}
+
+// The scalars of the schema are bound to the types below. The input values are either the literals
+// of the query (strings and 32 bit integers), or the JSON decoded variables (strings and numbers)
+
+// gqlBytes32 is the Bytes32 scalar
+type gqlBytes32 types.Hash
+
+func (gqlBytes32) ImplementsGraphQLType(name string) bool {
+	return name == "Bytes32"
+}
+
+func (h *gqlBytes32) UnmarshalGraphQL(input interface{}) error {
+	buf, err := decodeGraphQLHex(input)
+	if err != nil {
+		return err
+	}
+
+	if len(buf) != types.HashLength {
+		return fmt.Errorf("expected %d bytes, got %d", types.HashLength, len(buf))
+	}
+
+	*h = gqlBytes32(types.BytesToHash(buf))
+
+	return nil
+}
+
+func (h gqlBytes32) MarshalJSON() ([]byte, error) {
+	return json.Marshal(types.Hash(h).String())
+}
+
+// gqlAddress is the Address scalar
+type gqlAddress types.Address
+
+func (gqlAddress) ImplementsGraphQLType(name string) bool {
+	return name == "Address"
+}
+
+func (a *gqlAddress) UnmarshalGraphQL(input interface{}) error {
+	buf, err := decodeGraphQLHex(input)
+	if err != nil {
+		return err
+	}
+
+	if len(buf) != types.AddressLength {
+		return fmt.Errorf("expected %d bytes, got %d", types.AddressLength, len(buf))
+	}
+
+	*a = gqlAddress(types.BytesToAddress(buf))
+
+	return nil
+}
+
+func (a gqlAddress) MarshalJSON() ([]byte, error) {
+	return json.Marshal(types.Address(a).String())
+}
+
+// gqlBytes is the Bytes scalar
+type gqlBytes []byte
+
+func (gqlBytes) ImplementsGraphQLType(name string) bool {
+	return name == "Bytes"
+}
+
+func (b *gqlBytes) UnmarshalGraphQL(input interface{}) error {
+	buf, err := decodeGraphQLHex(input)
+	if err != nil {
+		return err
+	}
+
+	*b = buf
+
+	return nil
+}
+
+func (b gqlBytes) MarshalJSON() ([]byte, error) {
+	return json.Marshal(hex.EncodeToHex(b))
+}
+
+// gqlBigInt is the BigInt scalar
+type gqlBigInt big.Int
+
+func newGQLBigInt(num *big.Int) gqlBigInt {
+	if num == nil {
+		return gqlBigInt{}
+	}
+
+	return gqlBigInt(*num)
+}
+
+func (gqlBigInt) ImplementsGraphQLType(name string) bool {
+	return name == "BigInt"
+}
+
+func (b *gqlBigInt) UnmarshalGraphQL(input interface{}) error {
+	var (
+		num = new(big.Int)
+		ok  bool
+	)
+
+	switch v := input.(type) {
+	case int32:
+		num.SetInt64(int64(v))
+
+		ok = true
+	case json.Number:
+		_, ok = num.SetString(string(v), 10)
+	case string:
+		if digits := strings.TrimPrefix(v, "0x"); digits != v {
+			_, ok = num.SetString(digits, 16)
+		} else {
+			_, ok = num.SetString(v, 10)
+		}
+	}
+
+	if !ok || num.Sign() < 0 {
+		return errInvalidBigInt
+	}
+
+	*b = gqlBigInt(*num)
+
+	return nil
+}
+
+func (b gqlBigInt) MarshalJSON() ([]byte, error) {
+	num := big.Int(b)
+
+	return json.Marshal("0x" + num.Text(16))
+}
+
+// toBig returns the integer as the big.Int
+func (b *gqlBigInt) toBig() *big.Int {
+	return (*big.Int)(b)
+}
+
+// gqlLong is the Long scalar
+type gqlLong uint64
+
+func (gqlLong) ImplementsGraphQLType(name string) bool {
+	return name == "Long"
+}
+
+func (l *gqlLong) UnmarshalGraphQL(input interface{}) error {
+	var (
+		num uint64
+		err error
+	)
+
+	switch v := input.(type) {
+	case int32:
+		if v < 0 {
+			return errInvalidLong
+		}
+
+		num = uint64(v)
+	case json.Number:
+		num, err = strconv.ParseUint(string(v), 10, 64)
+	case string:
+		if digits := strings.TrimPrefix(v, "0x"); digits != v {
+			num, err = strconv.ParseUint(digits, 16, 64)
+		} else {
+			num, err = strconv.ParseUint(v, 10, 64)
+		}
+	default:
+		return errInvalidLong
+	}
+
+	if err != nil {
+		return errInvalidLong
+	}
+
+	*l = gqlLong(num)
+
+	return nil
+}
+
+func (l gqlLong) MarshalJSON() ([]byte, error) {
+	return json.Marshal(fmt.Sprintf("0x%x", uint64(l)))
+}
+
+// newGQLLongPtr returns the pointer to the Long, for the nullable fields
+func newGQLLongPtr(n uint64) *gqlLong {
+	l := gqlLong(n)
+
+	return &l
+}
+
+// decodeGraphQLHex decodes the 0x-prefixed hex string
+func decodeGraphQLHex(value interface{}) ([]byte, error) {
+	str, ok := value.(string)
+	if !ok || !strings.HasPrefix(str, "0x") {
+		return nil, errInvalidHexString
+	}
+
+	return hex.DecodeHex(str)
+}
@@ -20,10 +20,29 @@ type latestHeaderGetter interface {
 	Header() *types.Header
 }
 
+// finalizedBlockGetter is implemented by the stores which finalize the blocks later than they are committed.
+// The committed blocks are final in the stores which don't implement it, as the BFT finality is instant
+type finalizedBlockGetter interface {
+	// FinalizedBlockNumber returns the number of the latest finalized block
+	FinalizedBlockNumber() (uint64, error)
+}
+
 // GetNumericBlockNumber returns block number based on current state or specified number
 func GetNumericBlockNumber(number BlockNumber, store latestHeaderGetter) (uint64, error) {
 	switch number {
-	case LatestBlockNumber, PendingBlockNumber:
+	case LatestBlockNumber, PendingBlockNumber, SafeBlockNumber:
+		latest := store.Header()
+		if latest == nil {
+			return 0, ErrLatestNotFound
+		}
+
+		return latest.Number, nil
+
+	case FinalizedBlockNumber:
+		if finality, ok := store.(finalizedBlockGetter); ok {
+			return finality.FinalizedBlockNumber()
+		}
+
 		latest := store.Header()
 		if latest == nil {
 			return 0, ErrLatestNotFound
@@ -51,9 +70,22 @@ type headerGetter interface {
 // GetBlockHeader returns a header using the provided number
 func GetBlockHeader(number BlockNumber, store headerGetter) (*types.Header, error) {
 	switch number {
-	case PendingBlockNumber, LatestBlockNumber:
+	case PendingBlockNumber, LatestBlockNumber, SafeBlockNumber:
 		return store.Header(), nil
 
+	case FinalizedBlockNumber:
+		num, err := GetNumericBlockNumber(number, store)
+		if err != nil {
+			return nil, err
+		}
+
+		header, ok := store.GetHeaderByNumber(num)
+		if !ok {
+			return nil, fmt.Errorf("error fetching finalized block number %d header", num)
+		}
+
+		return header, nil
+
 	case EarliestBlockNumber:
 		header, ok := store.GetHeaderByNumber(uint64(0))
 		if !ok {
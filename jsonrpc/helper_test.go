@@ -61,6 +61,17 @@ var (
 	}
 )
 
+// finalityMockStore finalizes the blocks later than they are committed
+type finalityMockStore struct {
+	*debugEndpointMockStore
+
+	finalized uint64
+}
+
+func (s *finalityMockStore) FinalizedBlockNumber() (uint64, error) {
+	return s.finalized, nil
+}
+
 func TestGetNumericBlockNumber(t *testing.T) {
 	t.Parallel()
 
@@ -139,9 +150,45 @@ func TestGetNumericBlockNumber(t *testing.T) {
 			expected: 10,
 			err:      nil,
 		},
+		{
+			name: "should return latest if safe is given",
+			num:  SafeBlockNumber,
+			store: &debugEndpointMockStore{
+				headerFn: func() *types.Header {
+					return &types.Header{
+						Number: 10,
+					}
+				},
+			},
+			expected: 10,
+			err:      nil,
+		},
+		{
+			name: "should return latest if finalized is given and the store finalizes the committed blocks",
+			num:  FinalizedBlockNumber,
+			store: &debugEndpointMockStore{
+				headerFn: func() *types.Header {
+					return &types.Header{
+						Number: 10,
+					}
+				},
+			},
+			expected: 10,
+			err:      nil,
+		},
+		{
+			name: "should return the finalized block number of the store if finalized is given",
+			num:  FinalizedBlockNumber,
+			store: &finalityMockStore{
+				debugEndpointMockStore: &debugEndpointMockStore{},
+				finalized:              7,
+			},
+			expected: 7,
+			err:      nil,
+		},
 		{
 			name:     "should return error if negative number is given",
-			num:      -5,
+			num:      -10,
 			store:    &debugEndpointMockStore{},
 			expected: 0,
 			err:      ErrNegativeBlockNumber,
@@ -190,6 +237,22 @@ func TestGetBlockHeader(t *testing.T) {
 			expected: testLatestHeader,
 			err:      nil,
 		},
+		{
+			name: "should return the finalized block if finalized is given",
+			num:  FinalizedBlockNumber,
+			store: &finalityMockStore{
+				debugEndpointMockStore: &debugEndpointMockStore{
+					getHeaderByNumberFn: func(num uint64) (*types.Header, bool) {
+						assert.Equal(t, testHeader10.Number, num)
+
+						return testHeader10, true
+					},
+				},
+				finalized: testHeader10.Number,
+			},
+			expected: testHeader10,
+			err:      nil,
+		},
 		{
 			name: "should return genesis block if Earliest is given",
 			num:  EarliestBlockNumber,
@@ -0,0 +1,137 @@
+package jsonrpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"os"
+	"sync"
+
+	"github.com/0xPolygon/polygon-edge/helper/ipc"
+)
+
+// DefaultIPCFileMode is the default access permission of the IPC socket file
+const DefaultIPCFileMode os.FileMode = 0600
+
+// ipcConn is a wrapping object for the IPC client connection, used as the subscriptions sink
+type ipcConn struct {
+	sync.Mutex
+
+	conn     net.Conn
+	filterID string
+}
+
+func (c *ipcConn) SetFilterID(filterID string) {
+	c.filterID = filterID
+}
+
+func (c *ipcConn) GetFilterID() string {
+	return c.filterID
+}
+
+// WriteMessage writes out the message to the IPC peer. The message type is ignored,
+// as the messages are framed by the JSON values themselves
+func (c *ipcConn) WriteMessage(_ int, data []byte) error {
+	c.Lock()
+	defer c.Unlock()
+
+	_, err := c.conn.Write(data)
+
+	return err
+}
+
+// setupIPC starts the IPC listener, which exposes every namespace including the subscriptions
+func (j *JSONRPC) setupIPC() error {
+	lis, err := ipc.Listen(j.config.IPCPath, j.config.IPCFileMode)
+	if err != nil {
+		return err
+	}
+
+	j.ipcListener = lis
+
+	j.logger.Info("ipc server started", "path", j.config.IPCPath)
+
+	// the IPC socket access is restricted by the file permissions, so its clients are authenticated
+	scope := newAuthenticatedScope(nil, true).withClient("ipc")
+
+	go func() {
+		for {
+			conn, err := lis.Accept()
+			if err != nil {
+				if !errors.Is(err, net.ErrClosed) {
+					j.logger.Error("closed ipc listener", "err", err)
+				}
+
+				return
+			}
+
+			go j.handleIPC(conn, scope)
+		}
+	}()
+
+	return nil
+}
+
+// closeIPC stops accepting the IPC connections, removing the unix socket file
+func (j *JSONRPC) closeIPC() {
+	if j.ipcListener == nil {
+		return
+	}
+
+	if err := j.ipcListener.Close(); err != nil {
+		j.logger.Error("unable to close ipc listener", "err", err)
+
+		return
+	}
+
+	j.logger.Info("ipc server stopped", "path", j.config.IPCPath)
+}
+
+// handleIPC serves the requests of the IPC client until the connection is closed
+func (j *JSONRPC) handleIPC(conn net.Conn, scope *requestScope) {
+	wrapConn := &ipcConn{conn: conn}
+
+	defer func() {
+		j.dispatcher.RemoveFilterByWs(wrapConn)
+
+		if err := conn.Close(); err != nil {
+			j.logger.Error("Unable to gracefully close IPC connection", "err", err)
+		}
+	}()
+
+	decoder := json.NewDecoder(conn)
+
+	for {
+		var message json.RawMessage
+		if err := decoder.Decode(&message); err != nil {
+			if !errors.Is(err, io.EOF) {
+				j.logger.Error("Unable to read IPC message", "err", err)
+			}
+
+			return
+		}
+
+		go func() {
+			resp, err := j.handleIPCMessage(message, wrapConn, scope)
+			if err != nil {
+				j.logger.Error("Unable to handle IPC request", "err", err)
+
+				return
+			}
+
+			_ = wrapConn.WriteMessage(0, resp)
+		}()
+	}
+}
+
+// handleIPCMessage dispatches the single or batch request read from the IPC connection
+func (j *JSONRPC) handleIPCMessage(message []byte, conn *ipcConn, scope *requestScope) ([]byte, error) {
+	// batch requests don't support the subscriptions, as over the WS connection
+	if bytes.HasPrefix(bytes.TrimLeft(message, " \t\r\n"), []byte("[")) {
+		return j.dispatcher.Handle(message, scope)
+	}
+
+	return j.dispatcher.HandleWs(message, conn, scope)
+}
@@ -0,0 +1,109 @@
+//go:build !windows
+// +build !windows
+
+package jsonrpc
+
+import (
+	"encoding/json"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/helper/ipc"
+	"github.com/0xPolygon/polygon-edge/helper/tests"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIPCServer(t *testing.T) {
+	t.Parallel()
+
+	store := newMockStore()
+
+	port, err := tests.GetFreePort()
+	require.NoError(t, err)
+
+	ipcPath := filepath.Join(t.TempDir(), "edge.ipc")
+
+	srv, err := NewJSONRPC(hclog.NewNullLogger(), &Config{
+		Store:       store,
+		Addr:        &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: port},
+		ChainID:     1,
+		IPCPath:     ipcPath,
+		IPCFileMode: 0640,
+	})
+	require.NoError(t, err)
+
+	info, err := os.Stat(ipcPath)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0640), info.Mode().Perm())
+
+	conn, err := ipc.Dial(ipcPath)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+
+	decoder := json.NewDecoder(conn)
+
+	read := func() json.RawMessage {
+		t.Helper()
+
+		require.NoError(t, conn.SetReadDeadline(time.Now().Add(2*time.Second)))
+
+		var message json.RawMessage
+		require.NoError(t, decoder.Decode(&message))
+
+		return message
+	}
+
+	// single request
+	_, err = conn.Write([]byte(`{"id": 1, "method": "eth_chainId", "params": []}`))
+	require.NoError(t, err)
+
+	var chainID string
+	require.NoError(t, expectJSONResult(read(), &chainID))
+	assert.Equal(t, "0x1", chainID)
+
+	// batch request
+	_, err = conn.Write([]byte(`[{"id": 1, "method": "eth_chainId"}, {"id": 2, "method": "net_version"}]`))
+	require.NoError(t, err)
+
+	var batchResp []SuccessResponse
+
+	require.NoError(t, expectBatchJSONResult(read(), &batchResp))
+	assert.Len(t, batchResp, 2)
+
+	// subscriptions
+	_, err = conn.Write([]byte(`{"id": 1, "method": "eth_subscribe", "params": ["newHeads"]}`))
+	require.NoError(t, err)
+
+	var filterID string
+	require.NoError(t, expectJSONResult(read(), &filterID))
+
+	store.emitEvent(&mockEvent{
+		NewChain: []*mockHeader{
+			{
+				header: &types.Header{
+					Hash: types.StringToHash("1"),
+				},
+			},
+		},
+	})
+
+	var notification map[string]interface{}
+
+	require.NoError(t, json.Unmarshal(read(), &notification))
+	assert.Equal(t, "eth_subscription", notification["method"])
+
+	// closing the server removes the socket
+	srv.Close()
+
+	_, err = os.Stat(ipcPath)
+	assert.True(t, os.IsNotExist(err))
+}
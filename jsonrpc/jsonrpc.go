@@ -1,11 +1,15 @@
 package jsonrpc
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net"
 	"net/http"
+	"net/url"
+	"os"
 	"sync"
 	"time"
 
@@ -40,12 +44,21 @@ type JSONRPC struct {
 	logger     hclog.Logger
 	config     *Config
 	dispatcher dispatcher
+	listeners  []*listener
+
+	// clientKeys are the API keys the clients are identified by, instead of their IP
+	clientKeys map[string]struct{}
+
+	// ipcListener is the IPC socket listener, nil if the IPC is disabled
+	ipcListener net.Listener
 }
 
 type dispatcher interface {
 	RemoveFilterByWs(conn wsConn)
-	HandleWs(reqBody []byte, conn wsConn) ([]byte, error)
-	Handle(reqBody []byte) ([]byte, error)
+	HandleWs(reqBody []byte, conn wsConn, scope *requestScope) ([]byte, error)
+	Handle(reqBody []byte, scope *requestScope) ([]byte, error)
+	HandleGraphQL(reqBody []byte, allowMutations bool, scope *requestScope) ([]byte, error)
+	Close()
 }
 
 // JSONRPCStore defines all the methods required
@@ -56,7 +69,9 @@ type JSONRPCStore interface {
 	txPoolStore
 	filterManagerStore
 	bridgeStore
+	polybftStore
 	debugStore
+	adminStore
 }
 
 type Config struct {
@@ -68,67 +83,357 @@ type Config struct {
 	PriceLimit               uint64
 	BatchLengthLimit         uint64
 	BlockRangeLimit          uint64
+
+	// Namespaces is the list of namespaces exposed on Addr, every registered namespace if empty
+	Namespaces []string
+
+	// JWTSecret enables the HS256 JWT authentication on Addr, if set
+	JWTSecret []byte
+
+	// Private is the optional listener for the admin and debug methods
+	Private *ListenerConfig
+
+	// RateLimit enables the per-client request rate limiting, if set
+	RateLimit *RateLimitConfig
+
+	// IPCPath is the path of the IPC socket, the IPC listener is disabled if empty
+	IPCPath string
+
+	// IPCFileMode is the access permission of the IPC socket file
+	IPCFileMode os.FileMode
+
+	// Accounts enables the node-managed accounts and the personal namespace, if set
+	Accounts AccountManager
+
+	// DevControls enables the evm and dev namespaces of the dev consensus test chain, if set
+	DevControls DevControls
+
+	// GraphQL enables the EIP-1767 GraphQL endpoint at /graphql, if set
+	GraphQL bool
+
+	// GraphiQL enables the GraphiQL page at /graphql/ui, if set along with GraphQL
+	GraphiQL bool
+
+	// SimulateGasCap is the gas budget of a single eth_simulateV1 request, unlimited if 0
+	SimulateGasCap uint64
+
+	// SimulateTimeout is the time budget of a single eth_simulateV1 request, unlimited if 0
+	SimulateTimeout time.Duration
+
+	// ResponseCacheSize is the number of the cached responses of the finalized historical queries, disabled if 0.
+	// The total size of the cached responses is capped regardless of their number
+	ResponseCacheSize int
+
+	// SlowRequestThreshold is the duration above which the requests are logged, disabled if 0
+	SlowRequestThreshold time.Duration
+}
+
+// ListenerConfig holds the config details of an additional JSON-RPC listener
+type ListenerConfig struct {
+	Addr *net.TCPAddr
+
+	// Namespaces is the list of namespaces exposed on the listener, every registered namespace if empty
+	Namespaces []string
+
+	// JWTSecret enables the HS256 JWT authentication on the listener, if set
+	JWTSecret []byte
+}
+
+const (
+	publicListener  = "public"
+	privateListener = "private"
+
+	// adminNamespace is the namespace which is served only on the authenticated listeners and over IPC
+	adminNamespace = "admin"
+
+	// personalNamespace is the namespace of the node-managed accounts,
+	// served only on the authenticated listeners and over IPC
+	personalNamespace = "personal"
+)
+
+// listener is a single HTTP and WS endpoint of the JSON-RPC server
+type listener struct {
+	name      string
+	addr      *net.TCPAddr
+	scope     *requestScope
+	jwtSecret []byte
+	handler   http.Handler
+
+	// srv and lis are the running HTTP server and its socket, nil if the listener is stopped
+	srv  *http.Server
+	lis  net.Listener
+	lock sync.Mutex
+}
+
+// isRunning checks if the listener accepts the requests
+func (l *listener) isRunning() bool {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	return l.srv != nil
 }
 
 // NewJSONRPC returns the JSONRPC http server
 func NewJSONRPC(logger hclog.Logger, config *Config) (*JSONRPC, error) {
-	srv := &JSONRPC{
-		logger: logger.Named("jsonrpc"),
-		config: config,
-		dispatcher: newDispatcher(
-			logger,
-			config.Store,
-			&dispatcherParams{
-				chainID:                 config.ChainID,
-				chainName:               config.ChainName,
-				priceLimit:              config.PriceLimit,
-				jsonRPCBatchLengthLimit: config.BatchLengthLimit,
-				blockRangeLimit:         config.BlockRangeLimit,
-			},
-		),
-	}
-
-	// start http server
-	if err := srv.setupHTTP(); err != nil {
+	if err := config.RateLimit.Validate(); err != nil {
 		return nil, err
 	}
 
-	return srv, nil
-}
+	d := newDispatcher(
+		logger,
+		config.Store,
+		&dispatcherParams{
+			chainID:                 config.ChainID,
+			chainName:               config.ChainName,
+			priceLimit:              config.PriceLimit,
+			jsonRPCBatchLengthLimit: config.BatchLengthLimit,
+			blockRangeLimit:         config.BlockRangeLimit,
+			rateLimit:               config.RateLimit,
+			accounts:                config.Accounts,
+			devControls:             config.DevControls,
+			graphQL:                 config.GraphQL,
+			simulateGasCap:          config.SimulateGasCap,
+			simulateTimeout:         config.SimulateTimeout,
+			responseCacheSize:       config.ResponseCacheSize,
+			slowRequestThreshold:    config.SlowRequestThreshold,
+		},
+	)
 
-func (j *JSONRPC) setupHTTP() error {
-	j.logger.Info("http server started", "addr", j.config.Addr.String())
+	listeners := []*listener{
+		{
+			name:      publicListener,
+			addr:      config.Addr,
+			jwtSecret: config.JWTSecret,
+		},
+	}
 
-	lis, err := net.Listen("tcp", j.config.Addr.String())
-	if err != nil {
-		return err
+	namespaces := [][]string{config.Namespaces}
+
+	if config.Private != nil {
+		listeners = append(listeners, &listener{
+			name:      privateListener,
+			addr:      config.Private.Addr,
+			jwtSecret: config.Private.JWTSecret,
+		})
+
+		namespaces = append(namespaces, config.Private.Namespaces)
+	}
+
+	for i, l := range listeners {
+		scope, err := d.listenerScope(l.name, namespaces[i], l.jwtSecret != nil)
+		if err != nil {
+			return nil, err
+		}
+
+		l.scope = scope
 	}
 
+	srv := &JSONRPC{
+		logger:     logger.Named("jsonrpc"),
+		config:     config,
+		dispatcher: d,
+		listeners:  listeners,
+		clientKeys: make(map[string]struct{}),
+	}
+
+	if config.RateLimit != nil {
+		for _, key := range config.RateLimit.ClientKeys {
+			srv.clientKeys[key] = struct{}{}
+		}
+	}
+
+	d.endpoints.Admin.rpc = srv
+
+	// start http servers
+	for _, l := range listeners {
+		if err := srv.setupHTTP(l); err != nil {
+			return nil, err
+		}
+	}
+
+	if config.IPCPath != "" {
+		if config.IPCFileMode == 0 {
+			config.IPCFileMode = DefaultIPCFileMode
+		}
+
+		if err := srv.setupIPC(); err != nil {
+			return nil, err
+		}
+	}
+
+	return srv, nil
+}
+
+func (j *JSONRPC) setupHTTP(l *listener) error {
 	// NewServeMux must be used, as it disables all debug features.
 	// For some strange reason, with DefaultServeMux debug/vars is always enabled (but not debug/pprof).
 	// If pprof need to be enabled, this should be DefaultServeMux
 	mux := http.NewServeMux()
 
 	// The middleware factory returns a handler, so we need to wrap the handler function properly.
-	jsonRPCHandler := http.HandlerFunc(j.handle)
+	jsonRPCHandler := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		j.handle(w, req, l.scope.withClient(j.clientID(req)))
+	})
 	mux.Handle("/", middlewareFactory(j.config)(jsonRPCHandler))
 
-	mux.HandleFunc("/ws", j.handleWs)
+	mux.HandleFunc("/ws", func(w http.ResponseWriter, req *http.Request) {
+		j.handleWs(w, req, l.scope.withClient(j.clientID(req)))
+	})
+
+	j.registerGraphQL(mux, l)
+
+	l.handler = mux
+	if l.jwtSecret != nil {
+		l.handler = jwtMiddleware(l.jwtSecret)(mux)
+	}
+
+	return j.startHTTP(l)
+}
 
-	srv := http.Server{
-		Handler:           mux,
+// registerGraphQL mounts the GraphQL endpoint and its GraphiQL page, if enabled.
+// The GraphQL queries are served from the eth namespace, so it must be enabled on the listener
+func (j *JSONRPC) registerGraphQL(mux *http.ServeMux, l *listener) {
+	if !j.config.GraphQL || !l.scope.isEnabled("eth") {
+		return
+	}
+
+	graphQLHandler := http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		j.handleGraphQL(w, req, l.scope.withClient(j.clientID(req)))
+	})
+	mux.Handle("/graphql", middlewareFactory(j.config)(graphQLHandler))
+
+	if j.config.GraphiQL {
+		mux.HandleFunc("/graphql/ui", j.handleGraphiQL)
+	}
+}
+
+// startHTTP starts serving the listener's requests
+func (j *JSONRPC) startHTTP(l *listener) error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if l.srv != nil {
+		return fmt.Errorf("%s listener is already running", l.name)
+	}
+
+	lis, err := net.Listen("tcp", l.addr.String())
+	if err != nil {
+		return err
+	}
+
+	j.logger.Info("http server started", "listener", l.name, "addr", l.addr.String())
+
+	srv := &http.Server{
+		Handler:           l.handler,
 		ReadHeaderTimeout: 60 * time.Second,
 	}
 
 	go func() {
-		if err := srv.Serve(lis); err != nil {
+		err := srv.Serve(lis)
+		if err != nil && !errors.Is(err, http.ErrServerClosed) && !errors.Is(err, net.ErrClosed) {
 			j.logger.Error("closed http connection", "err", err)
 		}
 	}()
 
+	l.srv = srv
+	l.lis = lis
+
+	return nil
+}
+
+// stopHTTP stops accepting the listener's requests. The pending requests are completed in the background,
+// as the request stopping the listener may be served by the listener itself
+func (j *JSONRPC) stopHTTP(l *listener) error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if l.srv == nil {
+		return fmt.Errorf("%s listener is not running", l.name)
+	}
+
+	srv := l.srv
+	l.srv = nil
+
+	// the listening socket is closed before returning, so that the listener can be restarted right away
+	if err := l.lis.Close(); err != nil {
+		return err
+	}
+
+	go func() {
+		if err := srv.Shutdown(context.Background()); err != nil {
+			j.logger.Error("unable to gracefully stop http server", "listener", l.name, "err", err)
+		}
+	}()
+
+	j.logger.Info("http server stopped", "listener", l.name, "addr", l.addr.String())
+
 	return nil
 }
 
+// Close stops the running HTTP listeners, the IPC listener and the dispatcher
+func (j *JSONRPC) Close() {
+	for _, l := range j.listeners {
+		if !l.isRunning() {
+			continue
+		}
+
+		if err := j.stopHTTP(l); err != nil {
+			j.logger.Error("unable to stop http server", "listener", l.name, "err", err)
+		}
+	}
+
+	j.closeIPC()
+	j.dispatcher.Close()
+}
+
+// getListener returns the listener with the given name
+func (j *JSONRPC) getListener(name string) (*listener, error) {
+	for _, l := range j.listeners {
+		if l.name == name {
+			return l, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unknown listener '%s'", name)
+}
+
+// StartListener starts the stopped JSON-RPC listener
+func (j *JSONRPC) StartListener(name string) error {
+	l, err := j.getListener(name)
+	if err != nil {
+		return err
+	}
+
+	return j.startHTTP(l)
+}
+
+// StopListener stops the JSON-RPC listener
+func (j *JSONRPC) StopListener(name string) error {
+	l, err := j.getListener(name)
+	if err != nil {
+		return err
+	}
+
+	return j.stopHTTP(l)
+}
+
+// clientID identifies the sender of the request by its API key, if configured and accepted, or by its IP
+func (j *JSONRPC) clientID(req *http.Request) string {
+	if rateLimit := j.config.RateLimit; rateLimit != nil && rateLimit.ClientKeyHeader != "" {
+		key := req.Header.Get(rateLimit.ClientKeyHeader)
+		if _, ok := j.clientKeys[key]; ok && key != "" {
+			return "key:" + key
+		}
+	}
+
+	host, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		return "ip:" + req.RemoteAddr
+	}
+
+	return "ip:" + host
+}
+
 // The middlewareFactory builds a middleware which enables CORS using the provided config.
 func middlewareFactory(config *Config) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
@@ -201,7 +506,7 @@ func isSupportedWSType(messageType int) bool {
 		messageType == websocket.BinaryMessage
 }
 
-func (j *JSONRPC) handleWs(w http.ResponseWriter, req *http.Request) {
+func (j *JSONRPC) handleWs(w http.ResponseWriter, req *http.Request, scope *requestScope) {
 	// CORS rule - Allow requests from anywhere
 	wsUpgrader.CheckOrigin = func(r *http.Request) bool { return true }
 
@@ -225,6 +530,9 @@ func (j *JSONRPC) handleWs(w http.ResponseWriter, req *http.Request) {
 
 	wrapConn := &wsWrapper{ws: ws, logger: j.logger}
 
+	wsConnections.Inc()
+	defer wsConnections.Dec()
+
 	j.logger.Info("Websocket connection established")
 	// Run the listen loop
 	for {
@@ -250,7 +558,7 @@ func (j *JSONRPC) handleWs(w http.ResponseWriter, req *http.Request) {
 
 		if isSupportedWSType(msgType) {
 			go func() {
-				resp, handleErr := j.dispatcher.HandleWs(message, wrapConn)
+				resp, handleErr := j.dispatcher.HandleWs(message, wrapConn, scope)
 				if handleErr != nil {
 					j.logger.Error(fmt.Sprintf("Unable to handle WS request, %s", handleErr.Error()))
 
@@ -266,7 +574,7 @@ func (j *JSONRPC) handleWs(w http.ResponseWriter, req *http.Request) {
 	}
 }
 
-func (j *JSONRPC) handle(w http.ResponseWriter, req *http.Request) {
+func (j *JSONRPC) handle(w http.ResponseWriter, req *http.Request, scope *requestScope) {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS")
 	w.Header().Set(
@@ -276,7 +584,7 @@ func (j *JSONRPC) handle(w http.ResponseWriter, req *http.Request) {
 
 	switch req.Method {
 	case "POST":
-		j.handleJSONRPCRequest(w, req)
+		j.handleJSONRPCRequest(w, req, scope)
 	case "GET":
 		j.handleGetRequest(w)
 	case "OPTIONS":
@@ -286,7 +594,7 @@ func (j *JSONRPC) handle(w http.ResponseWriter, req *http.Request) {
 	}
 }
 
-func (j *JSONRPC) handleJSONRPCRequest(w http.ResponseWriter, req *http.Request) {
+func (j *JSONRPC) handleJSONRPCRequest(w http.ResponseWriter, req *http.Request, scope *requestScope) {
 	data, err := io.ReadAll(req.Body)
 	if err != nil {
 		_, _ = w.Write([]byte(err.Error()))
@@ -297,7 +605,7 @@ func (j *JSONRPC) handleJSONRPCRequest(w http.ResponseWriter, req *http.Request)
 	// log request
 	j.logger.Debug("handle", "request", string(data))
 
-	resp, err := j.dispatcher.Handle(data)
+	resp, err := j.dispatcher.Handle(data, scope)
 
 	if err != nil {
 		_, _ = w.Write([]byte(err.Error()))
@@ -330,3 +638,81 @@ func (j *JSONRPC) handleGetRequest(writer io.Writer) {
 		_, _ = writer.Write([]byte(err.Error()))
 	}
 }
+
+func (j *JSONRPC) handleGraphQL(w http.ResponseWriter, req *http.Request, scope *requestScope) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Access-Control-Allow-Methods", "GET, POST, OPTIONS")
+	w.Header().Set(
+		"Access-Control-Allow-Headers",
+		"Accept, Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization",
+	)
+
+	switch req.Method {
+	case "POST":
+		data, err := io.ReadAll(req.Body)
+		if err != nil {
+			_, _ = w.Write([]byte(err.Error()))
+
+			return
+		}
+
+		j.writeGraphQLResponse(w, data, true, scope)
+	case "GET":
+		// the GET requests must not change the state, so the mutations are rejected
+		data, err := graphQLRequestFromQuery(req.URL.Query())
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(err.Error()))
+
+			return
+		}
+
+		j.writeGraphQLResponse(w, data, false, scope)
+	case "OPTIONS":
+		// nothing to return
+	default:
+		_, _ = w.Write([]byte("method " + req.Method + " not allowed"))
+	}
+}
+
+func (j *JSONRPC) writeGraphQLResponse(w http.ResponseWriter, data []byte, allowMutations bool, scope *requestScope) {
+	j.logger.Debug("handle graphql", "request", string(data))
+
+	resp, err := j.dispatcher.HandleGraphQL(data, allowMutations, scope)
+	if err != nil {
+		_, _ = w.Write([]byte(err.Error()))
+	} else {
+		_, _ = w.Write(resp)
+	}
+
+	j.logger.Debug("handle graphql", "response", string(resp))
+}
+
+// graphQLRequestFromQuery builds the JSON encoded GraphQL request from the URL query parameters
+func graphQLRequestFromQuery(query url.Values) ([]byte, error) {
+	req := map[string]interface{}{
+		"query":         query.Get("query"),
+		"operationName": query.Get("operationName"),
+	}
+
+	if variables := query.Get("variables"); variables != "" {
+		if !json.Valid([]byte(variables)) {
+			return nil, errors.New("invalid variables")
+		}
+
+		req["variables"] = json.RawMessage(variables)
+	}
+
+	return json.Marshal(req)
+}
+
+func (j *JSONRPC) handleGraphiQL(w http.ResponseWriter, req *http.Request) {
+	if req.Method != "GET" {
+		_, _ = w.Write([]byte("method " + req.Method + " not allowed"))
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	_, _ = w.Write([]byte(graphiQLPage))
+}
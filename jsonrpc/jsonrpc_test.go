@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"encoding/json"
 	"net"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/0xPolygon/polygon-edge/helper/tests"
@@ -66,3 +68,47 @@ func Test_handleGetRequest(t *testing.T) {
 		response,
 	)
 }
+
+func TestHTTPServer_UnknownNamespace(t *testing.T) {
+	port, portErr := tests.GetFreePort()
+	if portErr != nil {
+		t.Fatalf("Unable to fetch free port, %v", portErr)
+	}
+
+	config := &Config{
+		Store:      newMockStore(),
+		Addr:       &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: port},
+		Namespaces: []string{"eth", "unknown"},
+	}
+
+	_, err := NewJSONRPC(hclog.NewNullLogger(), config)
+	assert.ErrorContains(t, err, "unknown namespace 'unknown'")
+}
+
+func TestJSONRPC_ClientID(t *testing.T) {
+	t.Parallel()
+
+	j := &JSONRPC{
+		config: &Config{
+			RateLimit: &RateLimitConfig{ClientKeyHeader: "X-Api-Key"},
+		},
+		clientKeys: map[string]struct{}{"known": {}},
+	}
+
+	newRequest := func(key string) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/", nil)
+		req.RemoteAddr = "10.0.0.1:1234"
+
+		if key != "" {
+			req.Header.Set("X-Api-Key", key)
+		}
+
+		return req
+	}
+
+	assert.Equal(t, "key:known", j.clientID(newRequest("known")))
+
+	// the unknown keys don't get their own buckets
+	assert.Equal(t, "ip:10.0.0.1", j.clientID(newRequest("unknown")))
+	assert.Equal(t, "ip:10.0.0.1", j.clientID(newRequest("")))
+}
@@ -0,0 +1,177 @@
+package jsonrpc
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+)
+
+const (
+	// jwtAlgorithm is the only signing algorithm accepted for the tokens
+	jwtAlgorithm = "HS256"
+
+	// jwtIssuedAtDrift is the max allowed difference between the token's issued-at claim
+	// and the local time (as per engine API authentication spec)
+	jwtIssuedAtDrift = 60 * time.Second
+
+	// jwtSecretLength is the required length of the shared secret in bytes
+	jwtSecretLength = 32
+)
+
+var (
+	ErrJWTMissing          = errors.New("missing token")
+	ErrJWTMalformed        = errors.New("malformed token")
+	ErrJWTInvalidAlgorithm = errors.New("invalid token signing algorithm")
+	ErrJWTInvalidSignature = errors.New("invalid token signature")
+	ErrJWTMissingIssuedAt  = errors.New("missing token issued-at claim")
+	ErrJWTStale            = errors.New("stale token")
+	ErrJWTSecretLength     = fmt.Errorf("jwt secret must be %d bytes long", jwtSecretLength)
+)
+
+type jwtHeader struct {
+	Alg string `json:"alg"`
+	Typ string `json:"typ"`
+}
+
+type jwtClaims struct {
+	IssuedAt *int64 `json:"iat"`
+}
+
+// ParseJWTSecret decodes the hex encoded JWT secret
+func ParseJWTSecret(raw string) ([]byte, error) {
+	secret, err := hex.DecodeHex(strings.TrimSpace(raw))
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode jwt secret: %w", err)
+	}
+
+	if len(secret) != jwtSecretLength {
+		return nil, ErrJWTSecretLength
+	}
+
+	return secret, nil
+}
+
+// verifyJWT checks that the token is HS256 signed with the given secret
+// and that it has been issued recently enough
+func verifyJWT(token string, secret []byte, now time.Time) error {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return ErrJWTMalformed
+	}
+
+	var header jwtHeader
+	if err := decodeJWTSegment(parts[0], &header); err != nil {
+		return err
+	}
+
+	if header.Alg != jwtAlgorithm {
+		return ErrJWTInvalidAlgorithm
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return ErrJWTMalformed
+	}
+
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(parts[0] + "." + parts[1]))
+
+	if !hmac.Equal(signature, mac.Sum(nil)) {
+		return ErrJWTInvalidSignature
+	}
+
+	var claims jwtClaims
+	if err := decodeJWTSegment(parts[1], &claims); err != nil {
+		return err
+	}
+
+	if claims.IssuedAt == nil {
+		return ErrJWTMissingIssuedAt
+	}
+
+	drift := now.Sub(time.Unix(*claims.IssuedAt, 0))
+	if drift > jwtIssuedAtDrift || drift < -jwtIssuedAtDrift {
+		return ErrJWTStale
+	}
+
+	return nil
+}
+
+// decodeJWTSegment decodes the base64url encoded JSON segment of the token
+func decodeJWTSegment(segment string, v interface{}) error {
+	raw, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return ErrJWTMalformed
+	}
+
+	if err := json.Unmarshal(raw, v); err != nil {
+		return ErrJWTMalformed
+	}
+
+	return nil
+}
+
+// jwtMiddleware builds a middleware which rejects the requests that don't carry
+// a valid bearer token in the Authorization header
+func jwtMiddleware(secret []byte) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// CORS preflight requests don't carry credentials
+			if r.Method == http.MethodOptions {
+				next.ServeHTTP(w, r)
+
+				return
+			}
+
+			if err := authenticateRequest(r, secret); err != nil {
+				writeUnauthorized(w, err)
+
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// authenticateRequest verifies the bearer token of the request
+func authenticateRequest(r *http.Request, secret []byte) error {
+	auth := r.Header.Get("Authorization")
+	if auth == "" {
+		return ErrJWTMissing
+	}
+
+	token := strings.TrimPrefix(auth, "Bearer ")
+	if token == auth {
+		return ErrJWTMalformed
+	}
+
+	return verifyJWT(token, secret, time.Now())
+}
+
+// writeUnauthorized writes the JSON-RPC error response for the unauthenticated request
+func writeUnauthorized(w http.ResponseWriter, err error) {
+	resp, marshalErr := NewRPCResponse(
+		nil,
+		"2.0",
+		nil,
+		NewInvalidRequestError(fmt.Sprintf("unauthorized: %s", err.Error())),
+	).Bytes()
+	if marshalErr != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusUnauthorized)
+	_, _ = w.Write(resp)
+}
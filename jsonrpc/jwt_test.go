@@ -0,0 +1,146 @@
+package jsonrpc
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testJWTSecret = []byte("0123456789abcdef0123456789abcdef")
+
+func signTestJWT(t *testing.T, alg string, claims string, secret []byte) string {
+	t.Helper()
+
+	header := base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf(`{"alg":"%s","typ":"JWT"}`, alg)))
+	payload := base64.RawURLEncoding.EncodeToString([]byte(claims))
+
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(header + "." + payload))
+
+	return header + "." + payload + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func TestParseJWTSecret(t *testing.T) {
+	t.Parallel()
+
+	secret, err := ParseJWTSecret("0x" + strings.Repeat("ab", jwtSecretLength) + "\n")
+	require.NoError(t, err)
+	assert.Len(t, secret, jwtSecretLength)
+
+	_, err = ParseJWTSecret("0xabcd")
+	assert.ErrorIs(t, err, ErrJWTSecretLength)
+
+	_, err = ParseJWTSecret("not hex")
+	assert.Error(t, err)
+}
+
+func TestVerifyJWT(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	iat := func(at time.Time) string {
+		return fmt.Sprintf(`{"iat":%d}`, at.Unix())
+	}
+
+	cases := []struct {
+		name  string
+		token string
+		err   error
+	}{
+		{
+			"valid token",
+			signTestJWT(t, jwtAlgorithm, iat(now), testJWTSecret),
+			nil,
+		},
+		{
+			"valid token within the allowed drift",
+			signTestJWT(t, jwtAlgorithm, iat(now.Add(-30*time.Second)), testJWTSecret),
+			nil,
+		},
+		{
+			"stale token",
+			signTestJWT(t, jwtAlgorithm, iat(now.Add(-2*jwtIssuedAtDrift)), testJWTSecret),
+			ErrJWTStale,
+		},
+		{
+			"token issued in the future",
+			signTestJWT(t, jwtAlgorithm, iat(now.Add(2*jwtIssuedAtDrift)), testJWTSecret),
+			ErrJWTStale,
+		},
+		{
+			"missing issued-at claim",
+			signTestJWT(t, jwtAlgorithm, `{}`, testJWTSecret),
+			ErrJWTMissingIssuedAt,
+		},
+		{
+			"wrong secret",
+			signTestJWT(t, jwtAlgorithm, iat(now), []byte("wrong")),
+			ErrJWTInvalidSignature,
+		},
+		{
+			"unsupported algorithm",
+			signTestJWT(t, "none", iat(now), testJWTSecret),
+			ErrJWTInvalidAlgorithm,
+		},
+		{
+			"malformed token",
+			"abc.def",
+			ErrJWTMalformed,
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.ErrorIs(t, verifyJWT(c.token, testJWTSecret, now), c.err)
+		})
+	}
+}
+
+func TestJWTMiddleware(t *testing.T) {
+	t.Parallel()
+
+	handler := jwtMiddleware(testJWTSecret)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	serve := func(method, auth string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, "/", nil)
+		if auth != "" {
+			req.Header.Set("Authorization", auth)
+		}
+
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		return rec
+	}
+
+	token := signTestJWT(t, jwtAlgorithm, fmt.Sprintf(`{"iat":%d}`, time.Now().Unix()), testJWTSecret)
+
+	assert.Equal(t, http.StatusOK, serve(http.MethodPost, "Bearer "+token).Code)
+	assert.Equal(t, http.StatusOK, serve(http.MethodOptions, "").Code)
+
+	rec := serve(http.MethodPost, "")
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	var res interface{}
+
+	err := expectJSONResult(rec.Body.Bytes(), &res)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), ErrJWTMissing.Error())
+
+	assert.Equal(t, http.StatusUnauthorized, serve(http.MethodPost, token).Code)
+}
@@ -0,0 +1,134 @@
+package jsonrpc
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	jsonRPCMetricsNamespace = "edge"
+	jsonRPCMetricsSubsystem = "jsonrpc"
+
+	// unknownMethod is the method label of the requests for the methods which are not served,
+	// so that the arbitrary method names sent by the clients don't grow the label set
+	unknownMethod = "unknown"
+
+	// slowRequestParamsLimit is the max length of the request params printed in the slow request log
+	slowRequestParamsLimit = 256
+)
+
+// redactedParamsPrefixes are the prefixes of the methods whose params are never logged,
+// since they carry the passwords, the data to be signed or the signed transactions
+var redactedParamsPrefixes = []string{"personal_", "eth_sign", "eth_send"}
+
+// transports the requests are received over
+const (
+	transportHTTP  = "http"
+	transportWS    = "ws"
+	transportIPC   = "ipc"
+	transportBatch = "batch"
+)
+
+var (
+	requestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: jsonRPCMetricsNamespace,
+		Subsystem: jsonRPCMetricsSubsystem,
+		Name:      "request_duration_seconds",
+		Help:      "Duration of the JSON-RPC requests",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "transport"})
+
+	requestsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: jsonRPCMetricsNamespace,
+		Subsystem: jsonRPCMetricsSubsystem,
+		Name:      "requests_total",
+		Help:      "Number of the handled JSON-RPC requests",
+	}, []string{"method", "transport"})
+
+	requestErrorsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: jsonRPCMetricsNamespace,
+		Subsystem: jsonRPCMetricsSubsystem,
+		Name:      "request_errors_total",
+		Help:      "Number of the JSON-RPC requests which returned an error",
+	}, []string{"method", "transport"})
+
+	wsConnections = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: jsonRPCMetricsNamespace,
+		Subsystem: jsonRPCMetricsSubsystem,
+		Name:      "ws_connections",
+		Help:      "Number of the active WebSocket connections",
+	})
+
+	wsSubscriptions = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: jsonRPCMetricsNamespace,
+		Subsystem: jsonRPCMetricsSubsystem,
+		Name:      "ws_subscriptions",
+		Help:      "Number of the active subscriptions over the WebSocket and IPC connections",
+	})
+)
+
+// RegisterMetrics registers the JSON-RPC metrics with the given registerer
+func RegisterMetrics(registerer prometheus.Registerer) error {
+	collectors := []prometheus.Collector{
+		requestDuration,
+		requestsTotal,
+		requestErrorsTotal,
+		wsConnections,
+		wsSubscriptions,
+	}
+
+	for _, collector := range collectors {
+		if err := registerer.Register(collector); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// observeRequest records the metrics of the handled request and logs it if it was slow
+func (d *Dispatcher) observeRequest(req Request, transport string, duration time.Duration, err Error) {
+	method := req.Method
+
+	var notFoundErr *methodNotFoundError
+	if errors.As(err, &notFoundErr) {
+		method = unknownMethod
+	}
+
+	requestDuration.WithLabelValues(method, transport).Observe(duration.Seconds())
+	requestsTotal.WithLabelValues(method, transport).Inc()
+
+	if err != nil {
+		requestErrorsTotal.WithLabelValues(method, transport).Inc()
+	}
+
+	if d.params.slowRequestThreshold > 0 && duration >= d.params.slowRequestThreshold {
+		d.logger.Warn("slow request",
+			"method", req.Method,
+			"transport", transport,
+			"duration", duration,
+			"params_size", len(req.Params),
+			"params", loggedParams(req),
+		)
+	}
+}
+
+// loggedParams returns the truncated params of the request to be logged,
+// or a placeholder if the params of the method are sensitive
+func loggedParams(req Request) string {
+	for _, prefix := range redactedParamsPrefixes {
+		if strings.HasPrefix(req.Method, prefix) {
+			return "<redacted>"
+		}
+	}
+
+	params := req.Params
+	if len(params) > slowRequestParamsLimit {
+		params = params[:slowRequestParamsLimit]
+	}
+
+	return string(params)
+}
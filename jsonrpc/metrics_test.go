@@ -0,0 +1,74 @@
+package jsonrpc
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+type metricsMockService struct{}
+
+func (m *metricsMockService) Ok() (interface{}, error) {
+	return "ok", nil
+}
+
+func (m *metricsMockService) Fail() (interface{}, error) {
+	return nil, errors.New("failed")
+}
+
+func TestDispatcher_RequestMetrics(t *testing.T) {
+	t.Parallel()
+
+	dispatcher := newDispatcher(hclog.NewNullLogger(), newMockStore(), &dispatcherParams{})
+	dispatcher.registerService("metrics", &metricsMockService{})
+
+	_, err := dispatcher.Handle([]byte(`{"method": "metrics_ok", "params": []}`), nil)
+	require.NoError(t, err)
+
+	_, err = dispatcher.Handle([]byte(`[
+		{"method": "metrics_ok", "params": []},
+		{"method": "metrics_fail", "params": []}
+	]`), nil)
+	require.NoError(t, err)
+
+	require.Equal(t, float64(1), testutil.ToFloat64(requestsTotal.WithLabelValues("metrics_ok", transportHTTP)))
+	require.Equal(t, float64(1), testutil.ToFloat64(requestsTotal.WithLabelValues("metrics_ok", transportBatch)))
+	require.Equal(t, float64(1), testutil.ToFloat64(requestsTotal.WithLabelValues("metrics_fail", transportBatch)))
+	require.Equal(t, float64(0), testutil.ToFloat64(requestErrorsTotal.WithLabelValues("metrics_ok", transportBatch)))
+	require.Equal(t, float64(1), testutil.ToFloat64(requestErrorsTotal.WithLabelValues("metrics_fail", transportBatch)))
+	require.GreaterOrEqual(t, testutil.CollectAndCount(requestDuration), 3)
+
+	// the methods which are not served share a single label
+	_, err = dispatcher.Handle([]byte(`{"method": "metrics_missing", "params": []}`), nil)
+	require.NoError(t, err)
+
+	require.Equal(t, float64(0), testutil.ToFloat64(requestsTotal.WithLabelValues("metrics_missing", transportHTTP)))
+	require.GreaterOrEqual(t, testutil.ToFloat64(requestErrorsTotal.WithLabelValues(unknownMethod, transportHTTP)), float64(1))
+}
+
+func TestLoggedParams(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		method   string
+		params   string
+		expected string
+	}{
+		{"eth_getBalance", `["0x1", "latest"]`, `["0x1", "latest"]`},
+		{"eth_call", `["` + strings.Repeat("a", 2*slowRequestParamsLimit) + `"]`,
+			`["` + strings.Repeat("a", slowRequestParamsLimit-2)},
+		{"personal_unlockAccount", `["0x1", "password"]`, "<redacted>"},
+		{"eth_sign", `["0x1", "0x2"]`, "<redacted>"},
+		{"eth_signTypedData_v4", `["0x1", {}]`, "<redacted>"},
+		{"eth_sendRawTransaction", `["0x1"]`, "<redacted>"},
+		{"eth_sendTransaction", `[{}]`, "<redacted>"},
+	}
+
+	for _, c := range cases {
+		require.Equal(t, c.expected, loggedParams(Request{Method: c.method, Params: []byte(c.params)}), c.method)
+	}
+}
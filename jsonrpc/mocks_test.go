@@ -5,6 +5,8 @@ import (
 	"sync"
 
 	"github.com/0xPolygon/polygon-edge/blockchain"
+	"github.com/0xPolygon/polygon-edge/helper/progress"
+	"github.com/0xPolygon/polygon-edge/txpool/proto"
 	"github.com/0xPolygon/polygon-edge/types"
 )
 
@@ -52,13 +54,21 @@ type mockStore struct {
 
 	// headers is the list of historical headers
 	historicalHeaders []*types.Header
+
+	txPoolEventCh   chan *proto.TxPoolEvent
+	pendingTxsLock  sync.Mutex
+	pendingTxs      map[types.Hash]*types.Transaction
+	syncLock        sync.Mutex
+	syncProgression *progress.Progression
 }
 
 func newMockStore() *mockStore {
 	m := &mockStore{
-		header:       &types.Header{Number: 0},
-		subscription: blockchain.NewMockSubscription(),
-		accounts:     map[types.Address]*Account{},
+		header:        &types.Header{Number: 0},
+		subscription:  blockchain.NewMockSubscription(),
+		accounts:      map[types.Address]*Account{},
+		txPoolEventCh: make(chan *proto.TxPoolEvent),
+		pendingTxs:    map[types.Hash]*types.Transaction{},
 	}
 	m.addHeader(m.header)
 
@@ -108,6 +118,44 @@ func (m *mockStore) emitEvent(evnt *mockEvent) {
 	m.subscription.Push(bEvnt)
 }
 
+func (m *mockStore) emitTxPoolEvent(eventType proto.EventType, tx *types.Transaction) {
+	m.pendingTxsLock.Lock()
+	m.pendingTxs[tx.Hash] = tx
+	m.pendingTxsLock.Unlock()
+
+	m.txPoolEventCh <- &proto.TxPoolEvent{
+		Type:   eventType,
+		TxHash: tx.Hash.String(),
+	}
+}
+
+func (m *mockStore) setSyncProgression(syncProgression *progress.Progression) {
+	m.syncLock.Lock()
+	defer m.syncLock.Unlock()
+
+	m.syncProgression = syncProgression
+}
+
+func (m *mockStore) TxPoolSubscribe(request *proto.SubscribeRequest) (<-chan *proto.TxPoolEvent, func(), error) {
+	return m.txPoolEventCh, func() {}, nil
+}
+
+func (m *mockStore) GetPendingTx(txHash types.Hash) (*types.Transaction, bool) {
+	m.pendingTxsLock.Lock()
+	defer m.pendingTxsLock.Unlock()
+
+	tx, ok := m.pendingTxs[txHash]
+
+	return tx, ok
+}
+
+func (m *mockStore) GetSyncProgression() *progress.Progression {
+	m.syncLock.Lock()
+	defer m.syncLock.Unlock()
+
+	return m.syncProgression
+}
+
 func (m *mockStore) GetAccount(root types.Hash, addr types.Address) (*Account, error) {
 	if acc, ok := m.accounts[addr]; ok {
 		return acc, nil
@@ -0,0 +1,79 @@
+package jsonrpc
+
+import (
+	"errors"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+var (
+	ErrAccountsDisabled = errors.New("node-managed accounts are disabled, use eth_sendRawTransaction instead")
+)
+
+// AccountManager manages the accounts held by the node, used for signing on behalf of the clients
+type AccountManager interface {
+	// Accounts returns the addresses of the managed accounts
+	Accounts() ([]types.Address, error)
+
+	// NewAccount creates a new account protected by the password
+	NewAccount(password string) (types.Address, error)
+
+	// Unlock keeps the account unlocked for the given duration, or for the default one if nil
+	Unlock(addr types.Address, password string, duration *time.Duration) error
+
+	// Lock locks the account
+	Lock(addr types.Address) error
+
+	// SignHash signs the hash with the unlocked account
+	SignHash(addr types.Address, hash []byte) ([]byte, error)
+
+	// SignTx signs the transaction with the unlocked account
+	SignTx(addr types.Address, tx *types.Transaction, signer crypto.TxSigner) (*types.Transaction, error)
+}
+
+// Personal is the personal jsonrpc endpoint
+type Personal struct {
+	accounts AccountManager
+}
+
+// NewAccount creates a new account protected by the password and returns its address
+func (p *Personal) NewAccount(password string) (interface{}, error) {
+	return p.accounts.NewAccount(password)
+}
+
+// ListAccounts returns the addresses of the managed accounts
+func (p *Personal) ListAccounts() (interface{}, error) {
+	addrs, err := p.accounts.Accounts()
+	if err != nil {
+		return nil, err
+	}
+
+	return addrs, nil
+}
+
+// UnlockAccount unlocks the account for the given number of seconds, or for the default duration if not specified
+func (p *Personal) UnlockAccount(addr types.Address, password string, seconds *argUint64) (interface{}, error) {
+	var duration *time.Duration
+
+	if seconds != nil {
+		d := time.Duration(*seconds) * time.Second
+		duration = &d
+	}
+
+	if err := p.accounts.Unlock(addr, password, duration); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// LockAccount locks the account, removing its key from memory
+func (p *Personal) LockAccount(addr types.Address) (interface{}, error) {
+	if err := p.accounts.Lock(addr); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
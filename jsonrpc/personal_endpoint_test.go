@@ -0,0 +1,260 @@
+package jsonrpc
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/accounts"
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// mockAccountManager holds a single account, unlocked by the "secret" password
+type mockAccountManager struct {
+	key      *ecdsa.PrivateKey
+	unlocked bool
+	duration *time.Duration
+}
+
+func newMockAccountManager(t *testing.T) *mockAccountManager {
+	t.Helper()
+
+	key, err := crypto.GenerateECDSAKey()
+	require.NoError(t, err)
+
+	return &mockAccountManager{key: key}
+}
+
+func (m *mockAccountManager) address() types.Address {
+	return crypto.PubKeyToAddress(&m.key.PublicKey)
+}
+
+func (m *mockAccountManager) Accounts() ([]types.Address, error) {
+	return []types.Address{m.address()}, nil
+}
+
+func (m *mockAccountManager) NewAccount(_ string) (types.Address, error) {
+	return types.ZeroAddress, accounts.ErrNewAccountNotSupported
+}
+
+func (m *mockAccountManager) Unlock(addr types.Address, password string, duration *time.Duration) error {
+	if addr != m.address() {
+		return accounts.ErrUnknownAccount
+	}
+
+	if password != "secret" {
+		return accounts.ErrAccountLocked
+	}
+
+	m.unlocked = true
+	m.duration = duration
+
+	return nil
+}
+
+func (m *mockAccountManager) Lock(_ types.Address) error {
+	m.unlocked = false
+
+	return nil
+}
+
+func (m *mockAccountManager) SignHash(addr types.Address, hash []byte) ([]byte, error) {
+	if addr != m.address() || !m.unlocked {
+		return nil, accounts.ErrAccountLocked
+	}
+
+	return crypto.Sign(m.key, hash)
+}
+
+func (m *mockAccountManager) SignTx(
+	addr types.Address,
+	tx *types.Transaction,
+	signer crypto.TxSigner,
+) (*types.Transaction, error) {
+	if addr != m.address() || !m.unlocked {
+		return nil, accounts.ErrAccountLocked
+	}
+
+	return signer.SignTx(tx, m.key)
+}
+
+// mockAccountsStore is the store used for filling in and sending the signed transactions
+type mockAccountsStore struct {
+	ethStore
+
+	nonce uint64
+	added []*types.Transaction
+}
+
+func (m *mockAccountsStore) Header() *types.Header {
+	return &types.Header{Number: 10}
+}
+
+func (m *mockAccountsStore) GetNonce(_ types.Address) uint64 {
+	return m.nonce
+}
+
+func (m *mockAccountsStore) GetAvgGasPrice() *big.Int {
+	return big.NewInt(7)
+}
+
+func (m *mockAccountsStore) GetForksInTime(_ uint64) chain.ForksInTime {
+	return chain.AllForksEnabled.At(0)
+}
+
+func (m *mockAccountsStore) AddTx(tx *types.Transaction) error {
+	m.added = append(m.added, tx)
+
+	return nil
+}
+
+func TestPersonal_UnlockAccount(t *testing.T) {
+	t.Parallel()
+
+	manager := newMockAccountManager(t)
+	personal := &Personal{accounts: manager}
+
+	res, err := personal.ListAccounts()
+	require.NoError(t, err)
+	assert.Equal(t, []types.Address{manager.address()}, res)
+
+	_, err = personal.UnlockAccount(manager.address(), "wrong", nil)
+	assert.ErrorIs(t, err, accounts.ErrAccountLocked)
+
+	seconds := argUint64(60)
+
+	res, err = personal.UnlockAccount(manager.address(), "secret", &seconds)
+	require.NoError(t, err)
+	assert.Equal(t, true, res)
+	assert.True(t, manager.unlocked)
+	assert.Equal(t, time.Minute, *manager.duration)
+
+	_, err = personal.LockAccount(manager.address())
+	require.NoError(t, err)
+	assert.False(t, manager.unlocked)
+}
+
+func TestEth_Sign(t *testing.T) {
+	t.Parallel()
+
+	manager := newMockAccountManager(t)
+	eth := newTestEthEndpoint(&mockAccountsStore{})
+
+	_, err := eth.Sign(manager.address(), argBytes("hello"))
+	assert.ErrorIs(t, err, ErrAccountsDisabled)
+
+	eth.accounts = manager
+
+	_, err = eth.Sign(manager.address(), argBytes("hello"))
+	assert.ErrorIs(t, err, accounts.ErrAccountLocked)
+
+	manager.unlocked = true
+
+	res, err := eth.Sign(manager.address(), argBytes("hello"))
+	require.NoError(t, err)
+
+	sig, ok := res.(argBytes)
+	require.True(t, ok)
+	require.Len(t, sig, 65)
+	assert.Contains(t, []byte{27, 28}, sig[64])
+
+	sig[64] -= 27
+
+	pub, err := crypto.RecoverPubkey(sig, accounts.TextHash([]byte("hello")))
+	require.NoError(t, err)
+	assert.Equal(t, manager.address(), crypto.PubKeyToAddress(pub))
+}
+
+func TestEth_SendTransaction(t *testing.T) {
+	t.Parallel()
+
+	manager := newMockAccountManager(t)
+	manager.unlocked = true
+
+	store := &mockAccountsStore{nonce: 3}
+	eth := newTestEthEndpoint(store)
+	eth.accounts = manager
+
+	_, err := eth.SendTransaction(&txnArgs{To: &addr1, Gas: argUintPtr(21000)})
+	assert.ErrorIs(t, err, ErrMissingSender)
+
+	from := manager.address()
+
+	res, err := eth.SendTransaction(&txnArgs{
+		From:  &from,
+		To:    &addr1,
+		Gas:   argUintPtr(21000),
+		Value: argBytesPtr([]byte{0x1}),
+	})
+	require.NoError(t, err)
+	require.Len(t, store.added, 1)
+
+	tx := store.added[0]
+	assert.Equal(t, tx.Hash.String(), res)
+
+	// the nonce and the gas price are filled in
+	assert.Equal(t, uint64(3), tx.Nonce)
+	assert.Equal(t, big.NewInt(7), tx.GasPrice)
+	assert.Equal(t, uint64(21000), tx.Gas)
+
+	sender, err := crypto.NewSigner(chain.AllForksEnabled.At(0), 100).Sender(tx)
+	require.NoError(t, err)
+	assert.Equal(t, from, sender)
+}
+
+func TestDispatcher_PersonalNamespace(t *testing.T) {
+	t.Parallel()
+
+	d := newDispatcher(hclog.NewNullLogger(), newMockStore(), &dispatcherParams{})
+
+	_, ok := d.serviceMap[personalNamespace]
+	assert.False(t, ok)
+
+	d = newDispatcher(hclog.NewNullLogger(), newMockStore(), &dispatcherParams{
+		accounts: newMockAccountManager(t),
+	})
+
+	_, err := d.listenerScope(publicListener, []string{"eth", personalNamespace}, false)
+	assert.ErrorContains(t, err, "requires jwt authentication")
+
+	scope, err := d.listenerScope(publicListener, nil, false)
+	require.NoError(t, err)
+	assert.False(t, scope.isEnabled(personalNamespace))
+
+	scope, err = d.listenerScope(publicListener, nil, true)
+	require.NoError(t, err)
+	assert.True(t, scope.isEnabled(personalNamespace))
+}
+
+func TestDispatcher_SigningMethodsRequireAuthentication(t *testing.T) {
+	t.Parallel()
+
+	d := newDispatcher(hclog.NewNullLogger(), newMockStore(), &dispatcherParams{
+		accounts: newMockAccountManager(t),
+	})
+
+	public, err := d.listenerScope(publicListener, []string{"eth"}, false)
+	require.NoError(t, err)
+
+	private, err := d.listenerScope(privateListener, []string{"eth"}, true)
+	require.NoError(t, err)
+
+	for method := range restrictedMethods {
+		_, _, err := d.getFnHandler(Request{Method: method}, public)
+		assert.Error(t, err, method)
+
+		_, _, err = d.getFnHandler(Request{Method: method}, private)
+		assert.Nil(t, err, method)
+	}
+
+	// the other methods of the namespace are served on the unauthenticated listeners
+	_, _, handlerErr := d.getFnHandler(Request{Method: "eth_chainId"}, public)
+	assert.Nil(t, handlerErr)
+}
@@ -0,0 +1,226 @@
+package jsonrpc
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+var (
+	// ErrPolyBFTNotRunning is returned by the polybft endpoint when the node doesn't run polybft consensus
+	ErrPolyBFTNotRunning = errors.New("polybft consensus is not running")
+)
+
+// polybftStore provides access to the polybft consensus runtime and state
+type polybftStore interface {
+	// GetPolyBFTEpoch returns the epoch currently being processed
+	GetPolyBFTEpoch() (*PolyBFTEpoch, error)
+
+	// GetPolyBFTValidators returns the validator set of the given epoch
+	GetPolyBFTValidators(epoch uint64) ([]*PolyBFTValidator, error)
+
+	// GetPolyBFTProposer calculates the proposer for the given height and round
+	GetPolyBFTProposer(height, round uint64) (types.Address, error)
+
+	// GetPolyBFTLastCheckpoint returns the latest block checkpointed to the rootchain
+	GetPolyBFTLastCheckpoint() (uint64, error)
+
+	// GetPolyBFTCommitment returns the commitment which contains given state sync event
+	GetPolyBFTCommitment(stateSyncID uint64) (*PolyBFTCommitment, error)
+
+	// GetPolyBFTExitEvents returns the exit events emitted in the given epoch
+	GetPolyBFTExitEvents(epoch uint64) ([]*PolyBFTExitEvent, error)
+}
+
+// PolyBFTEpoch holds the details of the epoch currently being processed
+type PolyBFTEpoch struct {
+	Number         uint64
+	FirstBlock     uint64
+	EpochSize      uint64
+	SprintSize     uint64
+	LastBuiltBlock uint64
+}
+
+// PolyBFTValidator holds the details of a single validator
+type PolyBFTValidator struct {
+	Address     types.Address
+	BlsKey      []byte
+	VotingPower *big.Int
+}
+
+// PolyBFTCommitment holds the details of a signed state sync commitment
+type PolyBFTCommitment struct {
+	StartID             *big.Int
+	EndID               *big.Int
+	Root                types.Hash
+	AggregatedSignature []byte
+	Bitmap              []byte
+}
+
+// PolyBFTExitEvent holds the details of a single exit event
+type PolyBFTExitEvent struct {
+	ID          uint64
+	Sender      types.Address
+	Receiver    types.Address
+	Data        []byte
+	EpochNumber uint64
+	BlockNumber uint64
+}
+
+// PolyBFT is the polybft jsonrpc endpoint
+type PolyBFT struct {
+	store polybftStore
+}
+
+type polybftEpoch struct {
+	Number     argUint64 `json:"number"`
+	FirstBlock argUint64 `json:"firstBlock"`
+	LastBlock  argUint64 `json:"lastBlock"`
+	EpochSize  argUint64 `json:"epochSize"`
+	SprintSize argUint64 `json:"sprintSize"`
+}
+
+type polybftValidator struct {
+	Address     types.Address `json:"address"`
+	BlsKey      argBytes      `json:"blsKey"`
+	VotingPower argBig        `json:"votingPower"`
+}
+
+type polybftSprint struct {
+	Number     argUint64 `json:"number"`
+	Epoch      argUint64 `json:"epoch"`
+	FirstBlock argUint64 `json:"firstBlock"`
+	LastBlock  argUint64 `json:"lastBlock"`
+	SprintSize argUint64 `json:"sprintSize"`
+}
+
+type polybftCommitment struct {
+	StartID             argBig     `json:"startId"`
+	EndID               argBig     `json:"endId"`
+	Root                types.Hash `json:"root"`
+	AggregatedSignature argBytes   `json:"aggregatedSignature"`
+	Bitmap              argBytes   `json:"bitmap"`
+}
+
+type polybftExitEvent struct {
+	ID          argUint64     `json:"id"`
+	Sender      types.Address `json:"sender"`
+	Receiver    types.Address `json:"receiver"`
+	Data        argBytes      `json:"data"`
+	EpochNumber argUint64     `json:"epochNumber"`
+	BlockNumber argUint64     `json:"blockNumber"`
+}
+
+// GetEpoch returns the epoch currently being processed
+func (p *PolyBFT) GetEpoch() (interface{}, error) {
+	epoch, err := p.store.GetPolyBFTEpoch()
+	if err != nil {
+		return nil, err
+	}
+
+	return &polybftEpoch{
+		Number:     argUint64(epoch.Number),
+		FirstBlock: argUint64(epoch.FirstBlock),
+		LastBlock:  argUint64(epoch.FirstBlock + epoch.EpochSize - 1),
+		EpochSize:  argUint64(epoch.EpochSize),
+		SprintSize: argUint64(epoch.SprintSize),
+	}, nil
+}
+
+// GetValidators returns the validators of the given epoch along with their voting power and BLS keys
+func (p *PolyBFT) GetValidators(epoch argUint64) (interface{}, error) {
+	validators, err := p.store.GetPolyBFTValidators(uint64(epoch))
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*polybftValidator, len(validators))
+
+	for i, v := range validators {
+		result[i] = &polybftValidator{
+			Address:     v.Address,
+			BlsKey:      argBytes(v.BlsKey),
+			VotingPower: argBig(*v.VotingPower),
+		}
+	}
+
+	return result, nil
+}
+
+// GetProposer returns the proposer of the given height and round
+func (p *PolyBFT) GetProposer(height, round argUint64) (interface{}, error) {
+	return p.store.GetPolyBFTProposer(uint64(height), uint64(round))
+}
+
+// GetSprint returns the sprint the next block is going to be built in
+func (p *PolyBFT) GetSprint() (interface{}, error) {
+	epoch, err := p.store.GetPolyBFTEpoch()
+	if err != nil {
+		return nil, err
+	}
+
+	if epoch.SprintSize == 0 {
+		return nil, errors.New("sprint size is not configured")
+	}
+
+	number := (epoch.LastBuiltBlock + 1 - epoch.FirstBlock) / epoch.SprintSize
+	firstBlock := epoch.FirstBlock + number*epoch.SprintSize
+
+	return &polybftSprint{
+		Number:     argUint64(number),
+		Epoch:      argUint64(epoch.Number),
+		FirstBlock: argUint64(firstBlock),
+		LastBlock:  argUint64(firstBlock + epoch.SprintSize - 1),
+		SprintSize: argUint64(epoch.SprintSize),
+	}, nil
+}
+
+// GetLastCheckpoint returns the latest block checkpointed to the rootchain
+func (p *PolyBFT) GetLastCheckpoint() (interface{}, error) {
+	block, err := p.store.GetPolyBFTLastCheckpoint()
+	if err != nil {
+		return nil, err
+	}
+
+	return argUint64(block), nil
+}
+
+// GetCommitment returns the signed commitment which contains given state sync event
+func (p *PolyBFT) GetCommitment(stateSyncID argUint64) (interface{}, error) {
+	commitment, err := p.store.GetPolyBFTCommitment(uint64(stateSyncID))
+	if err != nil {
+		return nil, err
+	}
+
+	return &polybftCommitment{
+		StartID:             argBig(*commitment.StartID),
+		EndID:               argBig(*commitment.EndID),
+		Root:                commitment.Root,
+		AggregatedSignature: argBytes(commitment.AggregatedSignature),
+		Bitmap:              argBytes(commitment.Bitmap),
+	}, nil
+}
+
+// GetExitEvents returns the exit events emitted in the given epoch
+func (p *PolyBFT) GetExitEvents(epoch argUint64) (interface{}, error) {
+	events, err := p.store.GetPolyBFTExitEvents(uint64(epoch))
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*polybftExitEvent, len(events))
+
+	for i, e := range events {
+		result[i] = &polybftExitEvent{
+			ID:          argUint64(e.ID),
+			Sender:      e.Sender,
+			Receiver:    e.Receiver,
+			Data:        argBytes(e.Data),
+			EpochNumber: argUint64(e.EpochNumber),
+			BlockNumber: argUint64(e.BlockNumber),
+		}
+	}
+
+	return result, nil
+}
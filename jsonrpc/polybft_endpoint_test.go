@@ -0,0 +1,182 @@
+package jsonrpc
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/require"
+)
+
+type polybftEndpointMockStore struct {
+	epoch       *PolyBFTEpoch
+	validators  map[uint64][]*PolyBFTValidator
+	proposer    types.Address
+	checkpoint  uint64
+	commitments map[uint64]*PolyBFTCommitment
+	exitEvents  map[uint64][]*PolyBFTExitEvent
+	err         error
+}
+
+func (m *polybftEndpointMockStore) GetPolyBFTEpoch() (*PolyBFTEpoch, error) {
+	return m.epoch, m.err
+}
+
+func (m *polybftEndpointMockStore) GetPolyBFTValidators(epoch uint64) ([]*PolyBFTValidator, error) {
+	return m.validators[epoch], m.err
+}
+
+func (m *polybftEndpointMockStore) GetPolyBFTProposer(height, round uint64) (types.Address, error) {
+	return m.proposer, m.err
+}
+
+func (m *polybftEndpointMockStore) GetPolyBFTLastCheckpoint() (uint64, error) {
+	return m.checkpoint, m.err
+}
+
+func (m *polybftEndpointMockStore) GetPolyBFTCommitment(stateSyncID uint64) (*PolyBFTCommitment, error) {
+	return m.commitments[stateSyncID], m.err
+}
+
+func (m *polybftEndpointMockStore) GetPolyBFTExitEvents(epoch uint64) ([]*PolyBFTExitEvent, error) {
+	return m.exitEvents[epoch], m.err
+}
+
+func TestPolyBFTEndpoint(t *testing.T) {
+	t.Parallel()
+
+	store := &polybftEndpointMockStore{
+		epoch: &PolyBFTEpoch{
+			Number:         3,
+			FirstBlock:     21,
+			EpochSize:      10,
+			SprintSize:     5,
+			LastBuiltBlock: 25,
+		},
+		validators: map[uint64][]*PolyBFTValidator{
+			2: {
+				{Address: types.StringToAddress("1"), BlsKey: []byte{0x1, 0x2}, VotingPower: big.NewInt(100)},
+			},
+		},
+		proposer:   types.StringToAddress("2"),
+		checkpoint: 20,
+		commitments: map[uint64]*PolyBFTCommitment{
+			7: {
+				StartID:             big.NewInt(5),
+				EndID:               big.NewInt(9),
+				Root:                types.StringToHash("3"),
+				AggregatedSignature: []byte{0x4},
+				Bitmap:              []byte{0x5},
+			},
+		},
+		exitEvents: map[uint64][]*PolyBFTExitEvent{
+			2: {
+				{ID: 1, Sender: types.StringToAddress("4"), Receiver: types.StringToAddress("5"), EpochNumber: 2, BlockNumber: 15},
+			},
+		},
+	}
+
+	endpoint := &PolyBFT{store: store}
+
+	t.Run("GetEpoch", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := endpoint.GetEpoch()
+		require.NoError(t, err)
+		require.Equal(t, &polybftEpoch{
+			Number:     3,
+			FirstBlock: 21,
+			LastBlock:  30,
+			EpochSize:  10,
+			SprintSize: 5,
+		}, res)
+	})
+
+	t.Run("GetSprint", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := endpoint.GetSprint()
+		require.NoError(t, err)
+		require.Equal(t, &polybftSprint{
+			Number:     1,
+			Epoch:      3,
+			FirstBlock: 26,
+			LastBlock:  30,
+			SprintSize: 5,
+		}, res)
+	})
+
+	t.Run("GetValidators", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := endpoint.GetValidators(2)
+		require.NoError(t, err)
+		require.Equal(t, []*polybftValidator{
+			{Address: types.StringToAddress("1"), BlsKey: argBytes{0x1, 0x2}, VotingPower: argBig(*big.NewInt(100))},
+		}, res)
+	})
+
+	t.Run("GetProposer", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := endpoint.GetProposer(26, 0)
+		require.NoError(t, err)
+		require.Equal(t, types.StringToAddress("2"), res)
+	})
+
+	t.Run("GetLastCheckpoint", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := endpoint.GetLastCheckpoint()
+		require.NoError(t, err)
+		require.Equal(t, argUint64(20), res)
+	})
+
+	t.Run("GetCommitment", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := endpoint.GetCommitment(7)
+		require.NoError(t, err)
+		require.Equal(t, &polybftCommitment{
+			StartID:             argBig(*big.NewInt(5)),
+			EndID:               argBig(*big.NewInt(9)),
+			Root:                types.StringToHash("3"),
+			AggregatedSignature: argBytes{0x4},
+			Bitmap:              argBytes{0x5},
+		}, res)
+	})
+
+	t.Run("GetExitEvents", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := endpoint.GetExitEvents(2)
+		require.NoError(t, err)
+		require.Equal(t, []*polybftExitEvent{
+			{
+				ID:          1,
+				Sender:      types.StringToAddress("4"),
+				Receiver:    types.StringToAddress("5"),
+				EpochNumber: 2,
+				BlockNumber: 15,
+			},
+		}, res)
+	})
+}
+
+func TestPolyBFTEndpoint_NotRunning(t *testing.T) {
+	t.Parallel()
+
+	endpoint := &PolyBFT{store: &polybftEndpointMockStore{err: ErrPolyBFTNotRunning}}
+
+	_, err := endpoint.GetEpoch()
+	require.ErrorIs(t, err, ErrPolyBFTNotRunning)
+
+	_, err = endpoint.GetSprint()
+	require.ErrorIs(t, err, ErrPolyBFTNotRunning)
+
+	_, err = endpoint.GetValidators(1)
+	require.ErrorIs(t, err, ErrPolyBFTNotRunning)
+
+	_, err = endpoint.GetCommitment(1)
+	require.ErrorIs(t, err, ErrPolyBFTNotRunning)
+}
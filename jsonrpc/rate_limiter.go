@@ -0,0 +1,217 @@
+package jsonrpc
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/armon/go-metrics"
+	lru "github.com/hashicorp/golang-lru"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// rateLimiterMetrics is a prefix used for the JSON-RPC rate limiting metrics
+	rateLimiterMetrics = "jsonrpc"
+
+	// rateLimiterClientsCacheSize is the max number of tracked clients,
+	// the least recently seen client buckets are evicted first
+	rateLimiterClientsCacheSize = 10000
+
+	// defaultMethodCost is the cost of the methods without an explicit weight
+	defaultMethodCost = 1
+)
+
+var (
+	ErrInvalidRateLimit      = errors.New("rate limit must not be negative")
+	ErrInvalidRateLimitBurst = errors.New("rate limit burst must be at least 1 if the rate limit is set")
+)
+
+// defaultMethodCosts are the weights of the methods which are heavier than the regular lookups
+var defaultMethodCosts = map[string]int{
+	"eth_call":                 5,
+	"eth_estimateGas":          5,
+	"eth_getLogs":              10,
+	"eth_getFilterLogs":        10,
+	"debug_traceBlockByNumber": 20,
+	"debug_traceBlockByHash":   20,
+	"debug_traceBlock":         20,
+	"debug_traceTransaction":   20,
+	"debug_traceCall":          20,
+	"debug_getRawBlock":        5,
+	"debug_getRawReceipts":     5,
+}
+
+// RateLimitConfig holds the config details for the per-client request rate limiting
+type RateLimitConfig struct {
+	// Rate is the amount of cost units refilled per second in the bucket of every client,
+	// the limiting is disabled if it is 0
+	Rate float64
+
+	// Burst is the capacity of the client buckets
+	Burst int
+
+	// MethodCosts overrides the cost weights of the methods
+	MethodCosts map[string]int
+
+	// MethodConcurrency caps the number of concurrent executions of the methods, across all clients
+	MethodConcurrency map[string]int
+
+	// ClientKeyHeader is the HTTP header carrying the client API key. If it is set,
+	// clients sending one of the ClientKeys in the header are limited by the key instead of their IP
+	ClientKeyHeader string
+
+	// ClientKeys are the API keys accepted in ClientKeyHeader, the requests with the other keys
+	// are limited by their IP, so the clients can't get a fresh bucket by changing the key
+	ClientKeys []string
+}
+
+// Validate checks the config limits the requests. A bucket with no capacity would let
+// every request through, as the method costs are capped at the capacity
+func (c *RateLimitConfig) Validate() error {
+	if c == nil {
+		return nil
+	}
+
+	if c.Rate < 0 {
+		return fmt.Errorf("%w: %v", ErrInvalidRateLimit, c.Rate)
+	}
+
+	if c.Rate > 0 && c.Burst < 1 {
+		return fmt.Errorf("%w: %d", ErrInvalidRateLimitBurst, c.Burst)
+	}
+
+	return nil
+}
+
+// rateLimiter enforces the token bucket limits per client
+// and the concurrency caps per method
+type rateLimiter struct {
+	rate  rate.Limit
+	burst int
+
+	costs   map[string]int
+	clients *lru.Cache
+
+	// slots are the buffered channels used as semaphores for the capped methods
+	slots map[string]chan struct{}
+
+	lock sync.Mutex
+}
+
+// newRateLimiter creates the rate limiter from the given config, or nil if the limiting is disabled
+func newRateLimiter(config *RateLimitConfig) *rateLimiter {
+	if config == nil || (config.Rate == 0 && len(config.MethodConcurrency) == 0) {
+		return nil
+	}
+
+	clients, _ := lru.New(rateLimiterClientsCacheSize)
+
+	r := &rateLimiter{
+		rate:    rate.Limit(config.Rate),
+		burst:   config.Burst,
+		costs:   make(map[string]int, len(defaultMethodCosts)+len(config.MethodCosts)),
+		clients: clients,
+		slots:   make(map[string]chan struct{}, len(config.MethodConcurrency)),
+	}
+
+	if r.rate == 0 {
+		r.rate = rate.Inf
+	}
+
+	for method, cost := range defaultMethodCosts {
+		r.costs[method] = cost
+	}
+
+	for method, cost := range config.MethodCosts {
+		r.costs[method] = cost
+	}
+
+	for method, limit := range config.MethodConcurrency {
+		if limit > 0 {
+			r.slots[method] = make(chan struct{}, limit)
+		}
+	}
+
+	return r
+}
+
+// methodCost returns the cost weight of the method
+func (r *rateLimiter) methodCost(method string) int {
+	cost, ok := r.costs[method]
+	if !ok {
+		cost = defaultMethodCost
+	}
+
+	// a cost above the bucket capacity would reject the method forever
+	if cost > r.burst {
+		return r.burst
+	}
+
+	return cost
+}
+
+// clientLimiter returns the token bucket of the client, creating it if needed
+func (r *rateLimiter) clientLimiter(client string) *rate.Limiter {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if limiter, ok := r.clients.Get(client); ok {
+		return limiter.(*rate.Limiter) //nolint:forcetypeassert
+	}
+
+	limiter := rate.NewLimiter(r.rate, r.burst)
+	r.clients.Add(client, limiter)
+
+	return limiter
+}
+
+// acquire charges the client for the method call and takes the method's
+// concurrency slot. The returned release function must be called once the call is done
+func (r *rateLimiter) acquire(client, method string, now time.Time) (func(), Error) {
+	if err := r.charge(client, method, r.methodCost(method), now); err != nil {
+		return nil, err
+	}
+
+	slots, ok := r.slots[method]
+	if !ok {
+		return func() {}, nil
+	}
+
+	select {
+	case slots <- struct{}{}:
+		return func() { <-slots }, nil
+	default:
+		reportRateLimited(method, "concurrency")
+
+		return nil, NewLimitExceededError("too many concurrent requests")
+	}
+}
+
+// charge takes the cost units from the client's bucket, the cost is capped by the bucket capacity
+func (r *rateLimiter) charge(client, method string, cost int, now time.Time) Error {
+	if cost > r.burst {
+		cost = r.burst
+	}
+
+	if !r.clientLimiter(client).AllowN(now, cost) {
+		reportRateLimited(method, "rate")
+
+		return NewLimitExceededError("request rate limit exceeded")
+	}
+
+	return nil
+}
+
+// reportRateLimited increments the counter of the rejected requests
+func reportRateLimited(method, reason string) {
+	metrics.IncrCounterWithLabels(
+		[]string{rateLimiterMetrics, "rate_limited_requests"},
+		1,
+		[]metrics.Label{
+			{Name: "method", Value: method},
+			{Name: "reason", Value: reason},
+		},
+	)
+}
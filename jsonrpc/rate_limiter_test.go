@@ -0,0 +1,108 @@
+package jsonrpc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimiter_Disabled(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, newRateLimiter(nil))
+	assert.Nil(t, newRateLimiter(&RateLimitConfig{}))
+}
+
+func TestRateLimitConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	var config *RateLimitConfig
+	assert.NoError(t, config.Validate())
+
+	assert.NoError(t, (&RateLimitConfig{}).Validate())
+	assert.NoError(t, (&RateLimitConfig{Rate: 10, Burst: 1}).Validate())
+
+	// the burst is not used without the rate
+	assert.NoError(t, (&RateLimitConfig{MethodConcurrency: map[string]int{"eth_call": 1}}).Validate())
+
+	assert.ErrorIs(t, (&RateLimitConfig{Rate: 10}).Validate(), ErrInvalidRateLimitBurst)
+	assert.ErrorIs(t, (&RateLimitConfig{Rate: 10, Burst: -1}).Validate(), ErrInvalidRateLimitBurst)
+	assert.ErrorIs(t, (&RateLimitConfig{Rate: -1, Burst: 10}).Validate(), ErrInvalidRateLimit)
+
+	_, err := NewJSONRPC(hclog.NewNullLogger(), &Config{
+		Store:     newMockStore(),
+		RateLimit: &RateLimitConfig{Rate: 10},
+	})
+	assert.ErrorIs(t, err, ErrInvalidRateLimitBurst)
+}
+
+func TestRateLimiter_MethodCosts(t *testing.T) {
+	t.Parallel()
+
+	limiter := newRateLimiter(&RateLimitConfig{
+		Rate:  1,
+		Burst: 12,
+		MethodCosts: map[string]int{
+			"eth_getLogs": 8,
+		},
+	})
+	require.NotNil(t, limiter)
+
+	assert.Equal(t, defaultMethodCost, limiter.methodCost("eth_blockNumber"))
+	assert.Equal(t, 8, limiter.methodCost("eth_getLogs"))
+	assert.Equal(t, defaultMethodCosts["eth_call"], limiter.methodCost("eth_call"))
+
+	// the costs above the bucket capacity are capped
+	assert.Equal(t, 12, limiter.methodCost("debug_traceCall"))
+
+	now := time.Now()
+
+	release, err := limiter.acquire("client", "eth_getLogs", now)
+	require.Nil(t, err)
+	release()
+
+	// 12 - 8 units left, not enough for a trace
+	_, err = limiter.acquire("client", "debug_traceCall", now)
+	require.NotNil(t, err)
+	assert.Equal(t, -32005, err.ErrorCode())
+
+	// the bucket is refilled over time
+	release, err = limiter.acquire("client", "debug_traceCall", now.Add(8*time.Second))
+	require.Nil(t, err)
+	release()
+}
+
+func TestRateLimiter_MethodConcurrency(t *testing.T) {
+	t.Parallel()
+
+	limiter := newRateLimiter(&RateLimitConfig{
+		MethodConcurrency: map[string]int{
+			"debug_traceBlock": 1,
+		},
+	})
+	require.NotNil(t, limiter)
+
+	now := time.Now()
+
+	release, err := limiter.acquire("alice", "debug_traceBlock", now)
+	require.Nil(t, err)
+
+	// the cap is shared by all the clients
+	_, err = limiter.acquire("bob", "debug_traceBlock", now)
+	require.NotNil(t, err)
+	assert.Equal(t, -32005, err.ErrorCode())
+
+	// other methods are not capped
+	otherRelease, err := limiter.acquire("bob", "debug_traceCall", now)
+	require.Nil(t, err)
+	otherRelease()
+
+	release()
+
+	release, err = limiter.acquire("bob", "debug_traceBlock", now)
+	require.Nil(t, err)
+	release()
+}
@@ -0,0 +1,285 @@
+package jsonrpc
+
+import (
+	"encoding/json"
+	"sync/atomic"
+
+	"github.com/0xPolygon/polygon-edge/blockchain"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/armon/go-metrics"
+	"github.com/hashicorp/go-hclog"
+	lru "github.com/hashicorp/golang-lru"
+)
+
+const (
+	responseCacheMetrics = "json_rpc_response_cache"
+
+	// responseCacheMaxBytes caps the total size of the cached responses
+	responseCacheMaxBytes = 64 * 1024 * 1024
+
+	// responseCacheMaxEntryBytes caps the size of a single cached response,
+	// the larger ones (e.g. the traces of the heavy transactions) are not cached
+	responseCacheMaxEntryBytes = 1024 * 1024
+)
+
+// responseCacheStore provides the methods needed by the response cache
+type responseCacheStore interface {
+	latestHeaderGetter
+
+	// SubscribeEvents subscribes for chain head events
+	SubscribeEvents() blockchain.Subscription
+
+	// ReadTxLookup returns a block hash in which a given txn was mined
+	ReadTxLookup(txnHash types.Hash) (types.Hash, bool)
+
+	// GetBlockByHash gets a block using the provided hash
+	GetBlockByHash(hash types.Hash, full bool) (*types.Block, bool)
+}
+
+// cachedBlockResolver returns the block the result of the request belongs to,
+// or false if the result must not be cached
+type cachedBlockResolver func(
+	store responseCacheStore,
+	inputs []interface{},
+	result interface{},
+) (uint64, types.Hash, bool)
+
+// cachedMethods are the methods whose results are immutable once their block is final
+var cachedMethods = map[string]cachedBlockResolver{
+	"eth_getBlockByNumber":      resolveBlockByNumber,
+	"eth_getBlockByHash":        resolveBlockByHash,
+	"eth_getTransactionReceipt": resolveReceipt,
+	"debug_traceTransaction":    resolveTransactionTrace,
+}
+
+func resolveBlockByNumber(_ responseCacheStore, inputs []interface{}, result interface{}) (uint64, types.Hash, bool) {
+	// the results of the block tags change along with the chain head
+	if number, ok := inputs[0].(*BlockNumber); !ok || *number < 0 {
+		return 0, types.ZeroHash, false
+	}
+
+	return resolveBlockByHash(nil, inputs, result)
+}
+
+func resolveBlockByHash(_ responseCacheStore, _ []interface{}, result interface{}) (uint64, types.Hash, bool) {
+	b, ok := result.(*block)
+	if !ok {
+		return 0, types.ZeroHash, false
+	}
+
+	return uint64(b.Number), b.Hash, true
+}
+
+func resolveReceipt(_ responseCacheStore, _ []interface{}, result interface{}) (uint64, types.Hash, bool) {
+	r, ok := result.(*receipt)
+	if !ok {
+		return 0, types.ZeroHash, false
+	}
+
+	return uint64(r.BlockNumber), r.BlockHash, true
+}
+
+func resolveTransactionTrace(store responseCacheStore, inputs []interface{}, _ interface{}) (uint64, types.Hash, bool) {
+	hash, ok := inputs[0].(*types.Hash)
+	if !ok {
+		return 0, types.ZeroHash, false
+	}
+
+	blockHash, ok := store.ReadTxLookup(*hash)
+	if !ok {
+		return 0, types.ZeroHash, false
+	}
+
+	txBlock, ok := store.GetBlockByHash(blockHash, false)
+	if !ok {
+		return 0, types.ZeroHash, false
+	}
+
+	return txBlock.Number(), txBlock.Hash(), true
+}
+
+// cachedResponse is the marshaled result of a request along with the hash of the block it belongs to
+type cachedResponse struct {
+	data      []byte
+	blockHash types.Hash
+}
+
+// responseCache is a cache of the marshaled results of the requests for the finalized blocks,
+// bounded both by the number of the entries and by their total size.
+// The entries belonging to the blocks removed from the canonical chain are dropped on reorgs
+type responseCache struct {
+	logger       hclog.Logger
+	store        responseCacheStore
+	cache        *lru.Cache
+	subscription blockchain.Subscription
+
+	// bytes is the total size of the cached responses
+	bytes int64
+
+	// maxBytes caps the total size of the cached responses
+	maxBytes int64
+
+	// maxEntryBytes caps the size of a single cached response
+	maxEntryBytes int
+}
+
+func newResponseCache(logger hclog.Logger, store responseCacheStore, size int) (*responseCache, error) {
+	c := &responseCache{
+		logger:        logger.Named("response-cache"),
+		store:         store,
+		maxBytes:      responseCacheMaxBytes,
+		maxEntryBytes: responseCacheMaxEntryBytes,
+	}
+
+	cache, err := lru.NewWithEvict(size, c.onEvicted)
+	if err != nil {
+		return nil, err
+	}
+
+	c.cache = cache
+	c.subscription = store.SubscribeEvents()
+
+	return c, nil
+}
+
+// onEvicted releases the size of the response removed from the cache
+func (c *responseCache) onEvicted(_, value interface{}) {
+	atomic.AddInt64(&c.bytes, -int64(len(value.(*cachedResponse).data))) //nolint:forcetypeassert
+}
+
+// isCached returns true if the results of the method are cached
+func (c *responseCache) isCached(method string) bool {
+	_, ok := cachedMethods[method]
+
+	return ok
+}
+
+// key builds the cache key of the request out of the method and the canonical encoding of its params
+func (c *responseCache) key(method string, inputs []interface{}) (string, bool) {
+	params, err := json.Marshal(inputs)
+	if err != nil {
+		return "", false
+	}
+
+	return method + string(params), true
+}
+
+// get returns the cached response of the request, if any
+func (c *responseCache) get(method string, inputs []interface{}) ([]byte, bool) {
+	key, ok := c.key(method, inputs)
+	if !ok {
+		return nil, false
+	}
+
+	value, ok := c.cache.Get(key)
+	if !ok {
+		reportResponseCache(method, "misses")
+
+		return nil, false
+	}
+
+	reportResponseCache(method, "hits")
+
+	return value.(*cachedResponse).data, true //nolint:forcetypeassert
+}
+
+// put caches the response of the request if it belongs to a finalized block
+func (c *responseCache) put(method string, inputs []interface{}, result interface{}, data []byte) {
+	resolve, ok := cachedMethods[method]
+	if !ok || result == nil || len(data) > c.maxEntryBytes {
+		return
+	}
+
+	number, blockHash, ok := resolve(c.store, inputs, result)
+	if !ok {
+		return
+	}
+
+	finalized, err := GetNumericBlockNumber(FinalizedBlockNumber, c.store)
+	if err != nil || number > finalized {
+		return
+	}
+
+	key, ok := c.key(method, inputs)
+	if !ok {
+		return
+	}
+
+	// the response of the same request is immutable, so the cached one is kept
+	if exists, _ := c.cache.ContainsOrAdd(key, &cachedResponse{
+		data:      data,
+		blockHash: blockHash,
+	}); exists {
+		return
+	}
+
+	atomic.AddInt64(&c.bytes, int64(len(data)))
+
+	// evict the least recently used responses until the total size fits
+	for atomic.LoadInt64(&c.bytes) > c.maxBytes {
+		if _, _, ok := c.cache.RemoveOldest(); !ok {
+			return
+		}
+	}
+}
+
+// Run drops the cached responses of the blocks removed from the canonical chain
+func (c *responseCache) Run() {
+	for {
+		evnt := c.subscription.GetEvent()
+		if evnt == nil {
+			return
+		}
+
+		if evnt.Type == blockchain.EventReorg {
+			c.invalidate(evnt.OldChain)
+		}
+	}
+}
+
+// Close stops the invalidation of the cached responses
+func (c *responseCache) Close() {
+	c.subscription.Close()
+}
+
+// invalidate drops the cached responses belonging to the given blocks
+func (c *responseCache) invalidate(headers []*types.Header) {
+	if len(headers) == 0 {
+		return
+	}
+
+	removed := make(map[types.Hash]struct{}, len(headers))
+	for _, header := range headers {
+		removed[header.Hash] = struct{}{}
+	}
+
+	dropped := 0
+
+	for _, key := range c.cache.Keys() {
+		value, ok := c.cache.Peek(key)
+		if !ok {
+			continue
+		}
+
+		if _, ok := removed[value.(*cachedResponse).blockHash]; ok { //nolint:forcetypeassert
+			c.cache.Remove(key)
+
+			dropped++
+		}
+	}
+
+	if dropped > 0 {
+		c.logger.Debug("dropped the cached responses of the reorged blocks", "count", dropped)
+	}
+}
+
+// reportResponseCache increments the counter of the cache hits or misses
+func reportResponseCache(method, result string) {
+	metrics.IncrCounterWithLabels(
+		[]string{responseCacheMetrics, result},
+		1,
+		[]metrics.Label{
+			{Name: "method", Value: method},
+		},
+	)
+}
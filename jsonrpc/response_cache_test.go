@@ -0,0 +1,170 @@
+package jsonrpc
+
+import (
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/blockchain"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+)
+
+type responseCacheMockStore struct {
+	header    *types.Header
+	txLookups map[types.Hash]types.Hash
+	blocks    map[types.Hash]*types.Block
+}
+
+func (m *responseCacheMockStore) Header() *types.Header {
+	return m.header
+}
+
+func (m *responseCacheMockStore) SubscribeEvents() blockchain.Subscription {
+	return blockchain.NewMockSubscription()
+}
+
+func (m *responseCacheMockStore) ReadTxLookup(txnHash types.Hash) (types.Hash, bool) {
+	hash, ok := m.txLookups[txnHash]
+
+	return hash, ok
+}
+
+func (m *responseCacheMockStore) GetBlockByHash(hash types.Hash, full bool) (*types.Block, bool) {
+	block, ok := m.blocks[hash]
+
+	return block, ok
+}
+
+func newTestResponseCache(t *testing.T, store responseCacheStore) *responseCache {
+	t.Helper()
+
+	cache, err := newResponseCache(hclog.NewNullLogger(), store, 16)
+	require.NoError(t, err)
+
+	return cache
+}
+
+func TestResponseCache_GetBlockByNumber(t *testing.T) {
+	t.Parallel()
+
+	cache := newTestResponseCache(t, &responseCacheMockStore{header: &types.Header{Number: 10}})
+
+	blockInputs := func(number BlockNumber, fullTx bool) []interface{} {
+		return []interface{}{&number, &fullTx}
+	}
+
+	finalized := &block{Number: 10, Hash: types.StringToHash("10")}
+	cache.put("eth_getBlockByNumber", blockInputs(10, false), finalized, []byte("finalized"))
+
+	data, ok := cache.get("eth_getBlockByNumber", blockInputs(10, false))
+	require.True(t, ok)
+	require.Equal(t, []byte("finalized"), data)
+
+	// the same block requested with the full transactions is a different request
+	_, ok = cache.get("eth_getBlockByNumber", blockInputs(10, true))
+	require.False(t, ok)
+
+	// the blocks above the finalized head are not cached
+	cache.put("eth_getBlockByNumber", blockInputs(11, false), &block{Number: 11}, []byte("pending"))
+
+	_, ok = cache.get("eth_getBlockByNumber", blockInputs(11, false))
+	require.False(t, ok)
+
+	// the block tags are never cached
+	cache.put("eth_getBlockByNumber", blockInputs(LatestBlockNumber, false), finalized, []byte("latest"))
+
+	_, ok = cache.get("eth_getBlockByNumber", blockInputs(LatestBlockNumber, false))
+	require.False(t, ok)
+}
+
+func TestResponseCache_TraceTransaction(t *testing.T) {
+	t.Parallel()
+
+	var (
+		minedTx   = types.StringToHash("1")
+		unknownTx = types.StringToHash("2")
+		header    = &types.Header{Number: 5}
+	)
+
+	header.ComputeHash()
+
+	cache := newTestResponseCache(t, &responseCacheMockStore{
+		header:    &types.Header{Number: 10},
+		txLookups: map[types.Hash]types.Hash{minedTx: header.Hash},
+		blocks:    map[types.Hash]*types.Block{header.Hash: {Header: header}},
+	})
+
+	cache.put("debug_traceTransaction", []interface{}{&minedTx, &TraceConfig{}}, "trace", []byte("trace"))
+	cache.put("debug_traceTransaction", []interface{}{&unknownTx, &TraceConfig{}}, "trace", []byte("trace"))
+
+	data, ok := cache.get("debug_traceTransaction", []interface{}{&minedTx, &TraceConfig{}})
+	require.True(t, ok)
+	require.Equal(t, []byte("trace"), data)
+
+	_, ok = cache.get("debug_traceTransaction", []interface{}{&unknownTx, &TraceConfig{}})
+	require.False(t, ok)
+}
+
+func TestResponseCache_InvalidateOnReorg(t *testing.T) {
+	t.Parallel()
+
+	cache := newTestResponseCache(t, &responseCacheMockStore{header: &types.Header{Number: 10}})
+
+	var (
+		reorgedTx   = types.StringToHash("1")
+		canonicalTx = types.StringToHash("2")
+		reorged     = &types.Header{Number: 9, Hash: types.StringToHash("9")}
+	)
+
+	cache.put("eth_getTransactionReceipt", []interface{}{&reorgedTx},
+		&receipt{BlockNumber: 9, BlockHash: reorged.Hash}, []byte("reorged"))
+	cache.put("eth_getTransactionReceipt", []interface{}{&canonicalTx},
+		&receipt{BlockNumber: 8, BlockHash: types.StringToHash("8")}, []byte("canonical"))
+
+	cache.invalidate([]*types.Header{reorged})
+
+	_, ok := cache.get("eth_getTransactionReceipt", []interface{}{&reorgedTx})
+	require.False(t, ok)
+
+	data, ok := cache.get("eth_getTransactionReceipt", []interface{}{&canonicalTx})
+	require.True(t, ok)
+	require.Equal(t, []byte("canonical"), data)
+}
+
+func TestResponseCache_SizeLimits(t *testing.T) {
+	t.Parallel()
+
+	cache := newTestResponseCache(t, &responseCacheMockStore{header: &types.Header{Number: 10}})
+	cache.maxBytes = 8
+	cache.maxEntryBytes = 6
+
+	put := func(number uint64, data string) {
+		hash := types.StringToHash(data)
+		cache.put("eth_getTransactionReceipt", []interface{}{&hash},
+			&receipt{BlockNumber: argUint64(number), BlockHash: hash}, []byte(data))
+	}
+
+	cached := func(data string) bool {
+		hash := types.StringToHash(data)
+		_, ok := cache.get("eth_getTransactionReceipt", []interface{}{&hash})
+
+		return ok
+	}
+
+	// the responses above the entry limit are not cached
+	put(1, "1234567")
+	require.False(t, cached("1234567"))
+
+	// the least recently used responses are evicted once the total size exceeds the limit
+	put(1, "1111")
+	put(2, "2222")
+	require.True(t, cached("1111"))
+	require.True(t, cached("2222"))
+	require.Equal(t, int64(8), cache.bytes)
+
+	put(3, "3333")
+	require.False(t, cached("1111"))
+	require.True(t, cached("2222"))
+	require.True(t, cached("3333"))
+	require.Equal(t, int64(8), cache.bytes)
+}
@@ -168,6 +168,56 @@ func toBlock(b *types.Block, fullTx bool) *block {
 	return res
 }
 
+// toReceipt converts the receipt of the transaction at the given index of the block,
+// logIndex being the index of the receipt's first log within the block
+func toReceipt(
+	raw *types.Receipt,
+	txn *types.Transaction,
+	txIndex uint64,
+	header *types.Header,
+	logIndex int,
+) *receipt {
+	logs := make([]*Log, len(raw.Logs))
+	for indx, elem := range raw.Logs {
+		logs[indx] = &Log{
+			Address:     elem.Address,
+			Topics:      elem.Topics,
+			Data:        argBytes(elem.Data),
+			BlockHash:   header.Hash,
+			BlockNumber: argUint64(header.Number),
+			TxHash:      txn.Hash,
+			TxIndex:     argUint64(txIndex),
+			LogIndex:    argUint64(logIndex + indx),
+			Removed:     false,
+		}
+	}
+
+	res := &receipt{
+		Root:              raw.Root,
+		CumulativeGasUsed: argUint64(raw.CumulativeGasUsed),
+		LogsBloom:         raw.LogsBloom,
+		TxHash:            txn.Hash,
+		TxIndex:           argUint64(txIndex),
+		BlockHash:         header.Hash,
+		BlockNumber:       argUint64(header.Number),
+		GasUsed:           argUint64(raw.GasUsed),
+		ContractAddress:   raw.ContractAddress,
+		FromAddr:          txn.From,
+		ToAddr:            txn.To,
+		Logs:              logs,
+	}
+
+	if raw.Status != nil {
+		res.Status = argUint64(*raw.Status)
+	}
+
+	if len(raw.RevertReason) > 0 {
+		res.RevertReason = argBytesPtr(raw.RevertReason)
+	}
+
+	return res
+}
+
 type receipt struct {
 	Root              types.Hash     `json:"root"`
 	CumulativeGasUsed argUint64      `json:"cumulativeGasUsed"`
@@ -182,6 +232,7 @@ type receipt struct {
 	ContractAddress   *types.Address `json:"contractAddress"`
 	FromAddr          types.Address  `json:"from"`
 	ToAddr            *types.Address `json:"to"`
+	RevertReason      *argBytes      `json:"revertReason,omitempty"`
 }
 
 type Log struct {
@@ -317,6 +368,31 @@ type txnArgs struct {
 	Nonce    *argUint64
 }
 
+// privateTxArgs is the argument of eth_sendPrivateTransaction
+type privateTxArgs struct {
+	Tx             argBytes   `json:"tx"`
+	MaxBlockNumber *argUint64 `json:"maxBlockNumber"`
+}
+
+// bundleArgs is the argument of eth_sendBundle
+type bundleArgs struct {
+	Txs               []argBytes   `json:"txs"`
+	MinBlockNumber    *argUint64   `json:"minBlockNumber"`
+	MaxBlockNumber    *argUint64   `json:"maxBlockNumber"`
+	RevertingTxHashes []types.Hash `json:"revertingTxHashes"`
+}
+
+// sendBundleResult is the result of eth_sendBundle
+type sendBundleResult struct {
+	BundleHash types.Hash `json:"bundleHash"`
+}
+
+// signTransactionResult is the result of eth_signTransaction
+type signTransactionResult struct {
+	Raw argBytes     `json:"raw"`
+	Tx  *transaction `json:"tx"`
+}
+
 type progression struct {
 	Type          string    `json:"type"`
 	StartingBlock argUint64 `json:"startingBlock"`
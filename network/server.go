@@ -88,6 +88,8 @@ type Server struct {
 	temporaryDials sync.Map // map of temporary connections; peerID -> bool
 
 	bootnodes *bootnodesWrapper // reference of all bootnodes for the node
+
+	trustedPeers *trustedPeersWrapper // reference of all trusted peers of the node
 }
 
 // NewServer returns a new instance of the networking server
@@ -150,6 +152,9 @@ func NewServer(logger hclog.Logger, config *Config) (*Server, error) {
 			bootnodesMap:      make(map[peer.ID]*peer.AddrInfo),
 			bootnodeConnCount: 0,
 		},
+		trustedPeers: &trustedPeersWrapper{
+			trustedPeers: make(map[peer.ID]*peer.AddrInfo),
+		},
 		connectionCounts: NewBlankConnectionInfo(
 			config.MaxInboundPeers,
 			config.MaxOutboundPeers,
@@ -319,7 +324,8 @@ func (s *Server) setupBootnodes() error {
 }
 
 // keepAliveMinimumPeerConnections will attempt to make new connections
-// if the active peer count is lesser than the specified limit.
+// if the active peer count is lesser than the specified limit,
+// as well as to reconnect the disconnected trusted peers.
 func (s *Server) keepAliveMinimumPeerConnections() {
 	for {
 		select {
@@ -328,6 +334,12 @@ func (s *Server) keepAliveMinimumPeerConnections() {
 			return
 		}
 
+		for _, peerInfo := range s.trustedPeers.getAll() {
+			if !s.hasPeer(peerInfo.ID) {
+				s.addToDialQueue(peerInfo, common.PriorityRequestedDial)
+			}
+		}
+
 		if s.numPeers() < MinimumPeerConnections {
 			if s.config.NoDiscover || !s.bootnodes.hasBootnodes() {
 				// dial unconnected peer
@@ -554,14 +566,7 @@ var (
 
 // JoinPeer attempts to add a new peer to the networking server
 func (s *Server) JoinPeer(rawPeerMultiaddr string) error {
-	// Parse the raw string to a MultiAddr format
-	parsedMultiaddr, err := multiaddr.NewMultiaddr(rawPeerMultiaddr)
-	if err != nil {
-		return err
-	}
-
-	// Extract the peer info from the Multiaddr
-	peerInfo, err := peer.AddrInfoFromP2pAddr(parsedMultiaddr)
+	peerInfo, err := peerInfoFromMultiaddr(rawPeerMultiaddr)
 	if err != nil {
 		return err
 	}
@@ -572,6 +577,18 @@ func (s *Server) JoinPeer(rawPeerMultiaddr string) error {
 	return nil
 }
 
+// peerInfoFromMultiaddr extracts the peer info from the raw p2p multiaddr
+func peerInfoFromMultiaddr(rawPeerMultiaddr string) (*peer.AddrInfo, error) {
+	// Parse the raw string to a MultiAddr format
+	parsedMultiaddr, err := multiaddr.NewMultiaddr(rawPeerMultiaddr)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extract the peer info from the Multiaddr
+	return peer.AddrInfoFromP2pAddr(parsedMultiaddr)
+}
+
 // joinPeer creates a new dial task for the peer (for async joining)
 func (s *Server) joinPeer(peerInfo *peer.AddrInfo) {
 	s.logger.Info("Join request", "addr", peerInfo.String())
@@ -583,6 +600,68 @@ func (s *Server) joinPeer(peerInfo *peer.AddrInfo) {
 	s.addToDialQueue(peerInfo, common.PriorityRequestedDial)
 }
 
+// AddTrustedPeer marks the peer as trusted, protecting its connection
+// and redialing it once disconnected, and attempts to add it to the networking server
+func (s *Server) AddTrustedPeer(rawPeerMultiaddr string) error {
+	peerInfo, err := peerInfoFromMultiaddr(rawPeerMultiaddr)
+	if err != nil {
+		return err
+	}
+
+	s.trustedPeers.add(peerInfo)
+	s.host.ConnManager().Protect(peerInfo.ID, trustedPeerTag)
+
+	if !s.IsConnected(peerInfo.ID) {
+		s.joinPeer(peerInfo)
+	}
+
+	return nil
+}
+
+// IsTrustedPeer checks if the peer is marked as trusted
+func (s *Server) IsTrustedPeer(peerID peer.ID) bool {
+	return s.trustedPeers.get(peerID) != nil
+}
+
+// RemovePeer removes the trusted mark from the peer and disconnects from it
+func (s *Server) RemovePeer(peerID peer.ID, reason string) {
+	if s.trustedPeers.remove(peerID) {
+		s.host.ConnManager().Unprotect(peerID, trustedPeerTag)
+	}
+
+	s.dialQueue.DeleteTask(peerID)
+	s.DisconnectFromPeer(peerID, reason)
+}
+
+// GetPeerDirection returns the direction of the initial connection to the peer, if it is connected [Thread safe]
+func (s *Server) GetPeerDirection(peerID peer.ID) (network.Direction, bool) {
+	s.peersLock.Lock()
+	defer s.peersLock.Unlock()
+
+	connectionInfo, ok := s.peers[peerID]
+	if !ok {
+		return network.DirUnknown, false
+	}
+
+	for _, direction := range []network.Direction{network.DirOutbound, network.DirInbound} {
+		if connectionInfo.connDirections[direction] {
+			return direction, true
+		}
+	}
+
+	return network.DirUnknown, false
+}
+
+// GetPeerLatency returns the moving average of the peer round trip latency
+func (s *Server) GetPeerLatency(peerID peer.ID) time.Duration {
+	return s.host.Peerstore().LatencyEWMA(peerID)
+}
+
+// Protocols returns the list of protocols supported by the node
+func (s *Server) Protocols() []string {
+	return s.host.Mux().Protocols()
+}
+
 func (s *Server) Close() error {
 	err := s.host.Close()
 	s.dialQueue.Close()
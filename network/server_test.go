@@ -1152,3 +1152,44 @@ func generateRandomPeers(t *testing.T, count int) ([]*randomPeer, error) {
 
 	return randomPeers, nil
 }
+
+func TestTrustedPeer(t *testing.T) {
+	servers, createErr := createServers(2, nil)
+	if createErr != nil {
+		t.Fatalf("Unable to create servers, %v", createErr)
+	}
+
+	t.Cleanup(func() {
+		closeTestServers(t, servers)
+	})
+
+	trustedID := servers[1].AddrInfo().ID
+
+	assert.NoError(t, servers[0].AddTrustedPeer(common.AddrInfoToString(servers[1].AddrInfo())))
+	assert.True(t, servers[0].IsTrustedPeer(trustedID))
+
+	connectCtx, connectFn := context.WithTimeout(context.Background(), DefaultJoinTimeout)
+	defer connectFn()
+
+	connected, err := WaitUntilPeerConnectsTo(connectCtx, servers[0], trustedID)
+	assert.NoError(t, err)
+	assert.True(t, connected)
+
+	direction, ok := servers[0].GetPeerDirection(trustedID)
+	assert.True(t, ok)
+	assert.Equal(t, network.DirOutbound, direction)
+
+	// the trusted peer is redialed after the disconnect
+	assert.NoError(t, DisconnectAndWait(servers[0], trustedID, DefaultLeaveTimeout))
+
+	reconnectCtx, reconnectFn := context.WithTimeout(context.Background(), DefaultJoinTimeout)
+	defer reconnectFn()
+
+	reconnected, err := WaitUntilPeerConnectsTo(reconnectCtx, servers[0], trustedID)
+	assert.NoError(t, err)
+	assert.True(t, reconnected)
+
+	// removed peers are not trusted anymore
+	servers[0].RemovePeer(trustedID, "test")
+	assert.False(t, servers[0].IsTrustedPeer(trustedID))
+}
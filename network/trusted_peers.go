@@ -0,0 +1,58 @@
+package network
+
+import (
+	"sync"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+// trustedPeerTag is the connection manager tag protecting the trusted peer connections
+const trustedPeerTag = "trusted"
+
+type trustedPeersWrapper struct {
+	// trustedPeers is the map of trusted peer addresses,
+	// which are kept connected by redialing them once disconnected
+	trustedPeers map[peer.ID]*peer.AddrInfo
+
+	lock sync.RWMutex
+}
+
+// add marks the peer as trusted [Thread safe]
+func (tw *trustedPeersWrapper) add(peerInfo *peer.AddrInfo) {
+	tw.lock.Lock()
+	defer tw.lock.Unlock()
+
+	tw.trustedPeers[peerInfo.ID] = peerInfo
+}
+
+// remove removes the trusted mark from the peer, returning false if it wasn't trusted [Thread safe]
+func (tw *trustedPeersWrapper) remove(peerID peer.ID) bool {
+	tw.lock.Lock()
+	defer tw.lock.Unlock()
+
+	_, ok := tw.trustedPeers[peerID]
+	delete(tw.trustedPeers, peerID)
+
+	return ok
+}
+
+// get returns the address info of the trusted peer, or nil if the peer is not trusted [Thread safe]
+func (tw *trustedPeersWrapper) get(peerID peer.ID) *peer.AddrInfo {
+	tw.lock.RLock()
+	defer tw.lock.RUnlock()
+
+	return tw.trustedPeers[peerID]
+}
+
+// getAll returns the address info of all the trusted peers [Thread safe]
+func (tw *trustedPeersWrapper) getAll() []*peer.AddrInfo {
+	tw.lock.RLock()
+	defer tw.lock.RUnlock()
+
+	peers := make([]*peer.AddrInfo, 0, len(tw.trustedPeers))
+	for _, peerInfo := range tw.trustedPeers {
+		peers = append(peers, peerInfo)
+	}
+
+	return peers
+}
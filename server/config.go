@@ -2,12 +2,16 @@ package server
 
 import (
 	"net"
+	"os"
+	"time"
 
 	"github.com/hashicorp/go-hclog"
 
 	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/jsonrpc"
 	"github.com/0xPolygon/polygon-edge/network"
 	"github.com/0xPolygon/polygon-edge/secrets"
+	"github.com/libp2p/go-libp2p/core/peer"
 )
 
 const DefaultGRPCPort int = 9632
@@ -24,8 +28,23 @@ type Config struct {
 	PriceLimit         uint64
 	MaxAccountEnqueued uint64
 	MaxSlots           uint64
+	PriceBump          uint64
 	BlockTime          uint64
 
+	// JournalPath is the path of the local transactions journal, the journal is disabled if empty
+	JournalPath       string
+	RejournalInterval time.Duration
+
+	// TxLifetime is the max time the enqueued transactions stay in the pool for, the expiry is disabled if zero
+	TxLifetime         time.Duration
+	TxLifetimePromoted bool
+	TxLifetimeLocals   bool
+
+	// PrivateTxMaxBlocks is the number of blocks a private transaction without a max block can be included in
+	PrivateTxMaxBlocks uint64
+	// PrivateTxPeers are the trusted peers the private transactions are forwarded to and accepted from
+	PrivateTxPeers []peer.ID
+
 	Telemetry *Telemetry
 	Network   *network.Config
 
@@ -43,6 +62,8 @@ type Config struct {
 	LogFilePath string
 
 	Relayer bool
+
+	StoreRevertReasons bool
 }
 
 // Telemetry holds the config details for metric services
@@ -56,4 +77,62 @@ type JSONRPC struct {
 	AccessControlAllowOrigin []string
 	BatchLengthLimit         uint64
 	BlockRangeLimit          uint64
+	Namespaces               []string
+	JWTSecret                []byte
+
+	// Private is the optional listener for the admin and debug methods
+	Private *JSONRPCListener
+
+	// RateLimit is the optional per-client request rate limiting
+	RateLimit *jsonrpc.RateLimitConfig
+
+	// IPCPath is the path of the IPC socket, the IPC listener is disabled if empty
+	IPCPath     string
+	IPCFileMode os.FileMode
+
+	// Accounts enables the node-managed accounts, if set
+	Accounts *JSONRPCAccounts
+
+	// GraphQL enables the GraphQL endpoint, GraphiQL its web page
+	GraphQL  bool
+	GraphiQL bool
+
+	// SimulateGasCap and SimulateTimeout are the budgets of a single simulation request
+	SimulateGasCap  uint64
+	SimulateTimeout time.Duration
+
+	// CheckpointFinality finalizes the blocks once they are checkpointed to the rootchain, instead of once committed
+	CheckpointFinality bool
+
+	// ResponseCacheSize is the number of the cached responses of the finalized historical queries
+	ResponseCacheSize int
+
+	// SlowRequestThreshold is the duration above which the requests are logged
+	SlowRequestThreshold time.Duration
+}
+
+// AccountsBackend is the storage of the node-managed account keys
+type AccountsBackend string
+
+const (
+	// KeystoreAccountsBackend stores the keys as the encrypted key files
+	KeystoreAccountsBackend AccountsBackend = "keystore"
+
+	// SecretsAccountsBackend exposes the validator key of the secrets manager, unlocked by the configured password
+	SecretsAccountsBackend AccountsBackend = "secrets"
+)
+
+// JSONRPCAccounts holds the config details for the node-managed accounts
+type JSONRPCAccounts struct {
+	Backend           AccountsBackend
+	KeystoreDir       string
+	Password          string
+	MaxUnlockDuration time.Duration
+}
+
+// JSONRPCListener holds the config details for an additional JSON-RPC listener
+type JSONRPCListener struct {
+	Addr       *net.TCPAddr
+	Namespaces []string
+	JWTSecret  []byte
 }
@@ -9,12 +9,17 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"strings"
+	"sync/atomic"
 	"time"
 
+	"github.com/0xPolygon/polygon-edge/accounts"
 	"github.com/0xPolygon/polygon-edge/archive"
 	"github.com/0xPolygon/polygon-edge/blockchain"
 	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/consensus"
+	consensusDev "github.com/0xPolygon/polygon-edge/consensus/dev"
+	consensusPolyBFT "github.com/0xPolygon/polygon-edge/consensus/polybft"
 	bls "github.com/0xPolygon/polygon-edge/consensus/polybft/signer"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/statesyncrelayer"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/wallet"
@@ -34,6 +39,7 @@ import (
 	"github.com/0xPolygon/polygon-edge/txpool"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/hashicorp/go-hclog"
+	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/umbracle/ethgo"
@@ -193,6 +199,11 @@ func NewServer(config *Config) (*Server, error) {
 	m.state = st
 
 	m.executor = state.NewExecutor(config.Chain.Params, st, logger)
+	m.executor.StoreRevertReasons = config.StoreRevertReasons
+
+	if m.executor.TxPolicy, err = state.NewTxPolicy(config.Chain.Params.TxPolicy); err != nil {
+		return nil, err
+	}
 
 	// custom write genesis hook per consensus engine
 	engineName := m.config.Chain.Params.GetEngine()
@@ -207,8 +218,15 @@ func NewServer(config *Config) (*Server, error) {
 	// use the eip155 signer
 	signer := crypto.NewEIP155Signer(chain.AllForksEnabled.At(0), uint64(m.config.Chain.Params.ChainID))
 
+	// the dev chains recover the senders of the impersonated accounts' unsigned transactions
+	// written to the blocks too, the pool keeps accepting only the signed ones
+	var chainSigner blockchain.TxSigner = signer
+	if ConsensusType(engineName) == DevConsensus {
+		chainSigner = consensusDev.NewTxSigner(signer)
+	}
+
 	// blockchain object
-	m.blockchain, err = blockchain.NewBlockchain(logger, m.config.DataDir, config.Chain, nil, m.executor, signer)
+	m.blockchain, err = blockchain.NewBlockchain(logger, m.config.DataDir, config.Chain, nil, m.executor, chainSigner)
 	if err != nil {
 		return nil, err
 	}
@@ -226,6 +244,12 @@ func NewServer(config *Config) (*Server, error) {
 			return nil, err
 		}
 
+		// the ordering policy comes from the chain config, so all the validators build blocks alike
+		txOrdering, err := txpool.NewTxOrderingPolicy(config.Chain.Params.TxOrdering)
+		if err != nil {
+			return nil, err
+		}
+
 		// start transaction pool
 		m.txpool, err = txpool.NewTxPool(
 			logger,
@@ -238,6 +262,16 @@ func NewServer(config *Config) (*Server, error) {
 				PriceLimit:          m.config.PriceLimit,
 				MaxAccountEnqueued:  m.config.MaxAccountEnqueued,
 				DeploymentWhitelist: deploymentWhitelist,
+				PriceBump:           m.config.PriceBump,
+				JournalPath:         m.config.JournalPath,
+				RejournalInterval:   m.config.RejournalInterval,
+				Lifetime:            m.config.TxLifetime,
+				LifetimePromoted:    m.config.TxLifetimePromoted,
+				LifetimeLocals:      m.config.TxLifetimeLocals,
+				TxPolicy:            m.executor.TxPolicy,
+				TxOrdering:          txOrdering,
+				PrivateTxMaxBlocks:  m.config.PrivateTxMaxBlocks,
+				PrivateTxPeers:      m.config.PrivateTxPeers,
 			},
 		)
 		if err != nil {
@@ -363,6 +397,24 @@ func (t *txpoolHub) GetBalance(root types.Hash, addr types.Address) (*big.Int, e
 	return account.Balance, nil
 }
 
+func (t *txpoolHub) GetStorage(root types.Hash, addr types.Address, slot types.Hash) (types.Hash, error) {
+	account, err := getAccountImpl(t.state, root, addr)
+	if err != nil {
+		if errors.Is(err, jsonrpc.ErrStateNotFound) {
+			return types.ZeroHash, nil
+		}
+
+		return types.ZeroHash, err
+	}
+
+	snap, err := t.state.NewSnapshotAt(root)
+	if err != nil {
+		return types.ZeroHash, err
+	}
+
+	return snap.GetStorage(addr, account.Root, slot), nil
+}
+
 // setupSecretsManager sets up the secrets manager
 func (s *Server) setupSecretsManager() error {
 	secretsManagerConfig := s.config.SecretsManager
@@ -477,6 +529,8 @@ func (s *Server) setupRelayer() error {
 type jsonRPCHub struct {
 	state              state.State
 	restoreProgression *progress.ProgressionWrapper
+	checkpointFinality bool
+	polybft            *consensusPolyBFT.Polybft
 
 	*blockchain.Blockchain
 	*txpool.TxPool
@@ -486,10 +540,214 @@ type jsonRPCHub struct {
 	consensus.BridgeDataProvider
 }
 
+// FinalizedBlockNumber returns the number of the latest finalized block. The committed blocks are final,
+// unless the finality is tied to the checkpoints to the rootchain
+func (j *jsonRPCHub) FinalizedBlockNumber() (uint64, error) {
+	if !j.checkpointFinality || j.BridgeDataProvider == nil {
+		return j.Blockchain.Header().Number, nil
+	}
+
+	return j.BridgeDataProvider.GetLatestCheckpointBlock()
+}
+
+// polybftDataProvider returns the data provider of the running polybft consensus
+func (j *jsonRPCHub) polybftDataProvider() (consensusPolyBFT.DataProvider, error) {
+	if j.polybft == nil {
+		return nil, jsonrpc.ErrPolyBFTNotRunning
+	}
+
+	provider := j.polybft.GetDataProvider()
+	if provider == nil {
+		return nil, jsonrpc.ErrPolyBFTNotRunning
+	}
+
+	return provider, nil
+}
+
+// GetPolyBFTEpoch returns the epoch currently being processed by the polybft consensus
+func (j *jsonRPCHub) GetPolyBFTEpoch() (*jsonrpc.PolyBFTEpoch, error) {
+	provider, err := j.polybftDataProvider()
+	if err != nil {
+		return nil, err
+	}
+
+	epoch, err := provider.GetEpoch()
+	if err != nil {
+		return nil, err
+	}
+
+	return &jsonrpc.PolyBFTEpoch{
+		Number:         epoch.Number,
+		FirstBlock:     epoch.FirstBlock,
+		EpochSize:      epoch.EpochSize,
+		SprintSize:     epoch.SprintSize,
+		LastBuiltBlock: epoch.LastBuiltBlock,
+	}, nil
+}
+
+// GetPolyBFTValidators returns the polybft validator set of the given epoch
+func (j *jsonRPCHub) GetPolyBFTValidators(epoch uint64) ([]*jsonrpc.PolyBFTValidator, error) {
+	provider, err := j.polybftDataProvider()
+	if err != nil {
+		return nil, err
+	}
+
+	validators, err := provider.GetEpochValidators(epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*jsonrpc.PolyBFTValidator, len(validators))
+
+	for i, v := range validators {
+		result[i] = &jsonrpc.PolyBFTValidator{
+			Address:     v.Address,
+			BlsKey:      v.BlsKey.Marshal(),
+			VotingPower: v.VotingPower,
+		}
+	}
+
+	return result, nil
+}
+
+// GetPolyBFTProposer calculates the polybft proposer for the given height and round
+func (j *jsonRPCHub) GetPolyBFTProposer(height, round uint64) (types.Address, error) {
+	provider, err := j.polybftDataProvider()
+	if err != nil {
+		return types.ZeroAddress, err
+	}
+
+	return provider.GetProposer(height, round)
+}
+
+// GetPolyBFTLastCheckpoint returns the latest block checkpointed to the rootchain
+func (j *jsonRPCHub) GetPolyBFTLastCheckpoint() (uint64, error) {
+	provider, err := j.polybftDataProvider()
+	if err != nil {
+		return 0, err
+	}
+
+	return provider.GetLatestCheckpointBlock()
+}
+
+// GetPolyBFTCommitment returns the signed commitment which contains given state sync event
+func (j *jsonRPCHub) GetPolyBFTCommitment(stateSyncID uint64) (*jsonrpc.PolyBFTCommitment, error) {
+	provider, err := j.polybftDataProvider()
+	if err != nil {
+		return nil, err
+	}
+
+	commitment, err := provider.GetCommitment(stateSyncID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &jsonrpc.PolyBFTCommitment{
+		StartID:             commitment.Message.StartID,
+		EndID:               commitment.Message.EndID,
+		Root:                commitment.Message.Root,
+		AggregatedSignature: commitment.AggSignature.AggregatedSignature,
+		Bitmap:              commitment.AggSignature.Bitmap,
+	}, nil
+}
+
+// GetPolyBFTExitEvents returns the exit events emitted in the given epoch
+func (j *jsonRPCHub) GetPolyBFTExitEvents(epoch uint64) ([]*jsonrpc.PolyBFTExitEvent, error) {
+	provider, err := j.polybftDataProvider()
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := provider.GetExitEvents(epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*jsonrpc.PolyBFTExitEvent, len(events))
+
+	for i, e := range events {
+		result[i] = &jsonrpc.PolyBFTExitEvent{
+			ID:          e.ID,
+			Sender:      types.Address(e.Sender),
+			Receiver:    types.Address(e.Receiver),
+			Data:        e.Data,
+			EpochNumber: e.EpochNumber,
+			BlockNumber: e.BlockNumber,
+		}
+	}
+
+	return result, nil
+}
+
 func (j *jsonRPCHub) GetPeers() int {
 	return len(j.Server.Peers())
 }
 
+// GetNodeInfo returns the networking details of the local node
+func (j *jsonRPCHub) GetNodeInfo() *jsonrpc.NodeInfo {
+	addrInfo := j.Server.AddrInfo()
+
+	addrs := make([]string, 0, len(addrInfo.Addrs))
+	for _, addr := range addrInfo.Addrs {
+		addrs = append(addrs, fmt.Sprintf("%s/p2p/%s", addr.String(), addrInfo.ID.String()))
+	}
+
+	return &jsonrpc.NodeInfo{
+		ID:        addrInfo.ID.String(),
+		Addrs:     addrs,
+		Protocols: j.Server.Protocols(),
+	}
+}
+
+// GetPeersInfo returns the details of the connected peers
+func (j *jsonRPCHub) GetPeersInfo() []*jsonrpc.PeerInfo {
+	peers := j.Server.Peers()
+	infos := make([]*jsonrpc.PeerInfo, 0, len(peers))
+
+	for _, p := range peers {
+		id := p.Info.ID
+
+		// the peer might have disconnected in the meantime
+		direction, ok := j.Server.GetPeerDirection(id)
+		if !ok {
+			continue
+		}
+
+		protocols, err := j.Server.GetProtocols(id)
+		if err != nil {
+			protocols = []string{}
+		}
+
+		addrs := make([]string, 0, len(p.Info.Addrs))
+		for _, addr := range p.Info.Addrs {
+			addrs = append(addrs, addr.String())
+		}
+
+		infos = append(infos, &jsonrpc.PeerInfo{
+			ID:        id.String(),
+			Addrs:     addrs,
+			Direction: strings.ToLower(direction.String()),
+			Protocols: protocols,
+			Latency:   j.Server.GetPeerLatency(id).String(),
+			Trusted:   j.Server.IsTrustedPeer(id),
+		})
+	}
+
+	return infos
+}
+
+// RemovePeer removes the trusted mark from the peer and disconnects from it
+func (j *jsonRPCHub) RemovePeer(rawPeerID string) error {
+	peerID, err := peer.Decode(rawPeerID)
+	if err != nil {
+		return err
+	}
+
+	j.Server.RemovePeer(peerID, "removed by admin")
+
+	return nil
+}
+
 func (j *jsonRPCHub) GetAccount(root types.Hash, addr types.Address) (*jsonrpc.Account, error) {
 	acct, err := getAccountImpl(j.state, root, addr)
 	if err != nil {
@@ -558,6 +816,172 @@ func (j *jsonRPCHub) ApplyTxn(
 	return
 }
 
+// BeginSimulation starts the simulation on top of the state of the header
+func (j *jsonRPCHub) BeginSimulation(header *types.Header) (jsonrpc.Simulation, error) {
+	blockCreator, err := j.GetConsensus().GetBlockCreator(header)
+	if err != nil {
+		return nil, err
+	}
+
+	transition, err := j.BeginTxn(header.StateRoot, header, blockCreator)
+	if err != nil {
+		return nil, err
+	}
+
+	return &simulation{
+		transition:   transition,
+		executor:     j.Executor,
+		blockCreator: blockCreator,
+	}, nil
+}
+
+// simulation runs the simulated calls on the scratch transition, which is never committed
+type simulation struct {
+	transition   *state.Transition
+	executor     *state.Executor
+	blockCreator types.Address
+}
+
+func (s *simulation) SetBlock(header *types.Header) {
+	coinbase := s.blockCreator
+	if len(header.Miner) != 0 {
+		coinbase = types.BytesToAddress(header.Miner)
+	}
+
+	s.transition.SetBlockContext(header, coinbase, s.executor.GetForksInTime(header.Number))
+}
+
+func (s *simulation) OverrideAccount(addr types.Address, override *jsonrpc.AccountOverride) {
+	txn := s.transition.Txn()
+
+	if override.Nonce != nil {
+		txn.SetNonce(addr, *override.Nonce)
+	}
+
+	if override.Code != nil {
+		txn.SetCode(addr, override.Code)
+	}
+
+	if override.Balance != nil {
+		txn.SetBalance(addr, override.Balance)
+	}
+
+	if override.State != nil {
+		txn.ResetStorage(addr)
+
+		for key, value := range override.State {
+			txn.SetState(addr, key, value)
+		}
+	}
+
+	for key, value := range override.StateDiff {
+		txn.SetState(addr, key, value)
+	}
+}
+
+func (s *simulation) GetNonce(addr types.Address) uint64 {
+	return s.transition.GetNonce(addr)
+}
+
+func (s *simulation) Apply(
+	ctx context.Context,
+	txn *types.Transaction,
+) (*runtime.ExecutionResult, []*types.Log, error) {
+	if ctx.Done() != nil {
+		halt := &haltTracer{}
+		stop := halt.haltOnDone(ctx)
+
+		s.transition.SetTracer(halt)
+
+		defer func() {
+			stop()
+			s.transition.SetTracer(nil)
+		}()
+	}
+
+	result, err := s.transition.Apply(txn)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// the halted call result is partial
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	logs := s.transition.Txn().Logs()
+
+	// the suicided accounts are set as deleted for the next calls
+	s.transition.Txn().CleanDeleteObjects(true)
+
+	return result, logs, nil
+}
+
+// haltTracer halts the EVM execution once cancelled, without tracing anything
+type haltTracer struct {
+	halted uint32
+}
+
+// haltOnDone cancels the tracer once the context is done. The returned function stops the watch
+func (h *haltTracer) haltOnDone(ctx context.Context) func() {
+	stopCh := make(chan struct{})
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			h.Cancel(ctx.Err())
+		case <-stopCh:
+		}
+	}()
+
+	return func() { close(stopCh) }
+}
+
+func (h *haltTracer) Cancel(error) {
+	atomic.StoreUint32(&h.halted, 1)
+}
+
+func (h *haltTracer) Clear() {}
+
+func (h *haltTracer) GetResult() (interface{}, error) {
+	return nil, nil
+}
+
+func (h *haltTracer) TxStart(uint64) {}
+
+func (h *haltTracer) TxEnd(uint64) {}
+
+func (h *haltTracer) CallStart(int, types.Address, types.Address, int, uint64, *big.Int, []byte) {}
+
+func (h *haltTracer) CallEnd(int, []byte, error) {}
+
+func (h *haltTracer) CaptureState(
+	_ []byte,
+	_ []*big.Int,
+	_ int,
+	_ types.Address,
+	_ int,
+	_ tracer.RuntimeHost,
+	state tracer.VMState,
+) {
+	if atomic.LoadUint32(&h.halted) == 1 {
+		state.Halt()
+	}
+}
+
+func (h *haltTracer) ExecuteState(
+	types.Address,
+	uint64,
+	string,
+	uint64,
+	uint64,
+	[]byte,
+	int,
+	error,
+	tracer.RuntimeHost,
+) {
+}
+
 // TraceBlock traces all transactions in the given block and returns all results
 func (j *jsonRPCHub) TraceBlock(
 	block *types.Block,
@@ -694,6 +1118,29 @@ func (j *jsonRPCHub) GetSyncProgression() *progress.Progression {
 
 // SETUP //
 
+// setupAccounts sets up the manager of the node-managed accounts, using the set backend
+func (s *Server) setupAccounts(config *JSONRPCAccounts) (*accounts.Manager, error) {
+	var backend accounts.Backend
+
+	switch config.Backend {
+	case KeystoreAccountsBackend:
+		keystoreBackend, err := accounts.NewKeystoreBackend(config.KeystoreDir)
+		if err != nil {
+			return nil, err
+		}
+
+		backend = keystoreBackend
+	case SecretsAccountsBackend:
+		backend = accounts.NewSecretsBackend(s.secretsManager, config.Password)
+	default:
+		return nil, fmt.Errorf("unknown accounts backend: %s", config.Backend)
+	}
+
+	s.logger.Info("node-managed accounts enabled", "backend", config.Backend)
+
+	return accounts.NewManager(s.logger, backend, config.MaxUnlockDuration), nil
+}
+
 // setupJSONRCP sets up the JSONRPC server, using the set configuration
 func (s *Server) setupJSONRPC() error {
 	hub := &jsonRPCHub{
@@ -705,6 +1152,11 @@ func (s *Server) setupJSONRPC() error {
 		Consensus:          s.consensus,
 		Server:             s.network,
 		BridgeDataProvider: s.consensus.GetBridgeProvider(),
+		checkpointFinality: s.config.JSONRPC.CheckpointFinality,
+	}
+
+	if polybft, ok := s.consensus.(*consensusPolyBFT.Polybft); ok {
+		hub.polybft = polybft
 	}
 
 	conf := &jsonrpc.Config{
@@ -716,6 +1168,45 @@ func (s *Server) setupJSONRPC() error {
 		PriceLimit:               s.config.PriceLimit,
 		BatchLengthLimit:         s.config.JSONRPC.BatchLengthLimit,
 		BlockRangeLimit:          s.config.JSONRPC.BlockRangeLimit,
+		Namespaces:               s.config.JSONRPC.Namespaces,
+		JWTSecret:                s.config.JSONRPC.JWTSecret,
+		RateLimit:                s.config.JSONRPC.RateLimit,
+		IPCPath:                  s.config.JSONRPC.IPCPath,
+		IPCFileMode:              s.config.JSONRPC.IPCFileMode,
+		GraphQL:                  s.config.JSONRPC.GraphQL,
+		GraphiQL:                 s.config.JSONRPC.GraphiQL,
+		SimulateGasCap:           s.config.JSONRPC.SimulateGasCap,
+		SimulateTimeout:          s.config.JSONRPC.SimulateTimeout,
+		ResponseCacheSize:        s.config.JSONRPC.ResponseCacheSize,
+		SlowRequestThreshold:     s.config.JSONRPC.SlowRequestThreshold,
+	}
+
+	if accountsConfig := s.config.JSONRPC.Accounts; accountsConfig != nil {
+		manager, err := s.setupAccounts(accountsConfig)
+		if err != nil {
+			return err
+		}
+
+		conf.Accounts = manager
+	}
+
+	if private := s.config.JSONRPC.Private; private != nil {
+		conf.Private = &jsonrpc.ListenerConfig{
+			Addr:       private.Addr,
+			Namespaces: private.Namespaces,
+			JWTSecret:  private.JWTSecret,
+		}
+	}
+
+	// the test chain controls are exposed only by the dev consensus,
+	// on the listeners along with the configured namespaces
+	if dev, ok := s.consensus.(*consensusDev.Dev); ok {
+		conf.DevControls = dev
+		conf.Namespaces = withDevNamespaces(conf.Namespaces)
+
+		if conf.Private != nil {
+			conf.Private.Namespaces = withDevNamespaces(conf.Private.Namespaces)
+		}
 	}
 
 	srv, err := jsonrpc.NewJSONRPC(s.logger, conf)
@@ -728,6 +1219,16 @@ func (s *Server) setupJSONRPC() error {
 	return nil
 }
 
+// withDevNamespaces adds the namespaces of the dev consensus test chain controls to the listener's namespaces.
+// The empty namespaces enable all the namespaces, the dev ones included
+func withDevNamespaces(namespaces []string) []string {
+	if len(namespaces) == 0 {
+		return namespaces
+	}
+
+	return append(append([]string{}, namespaces...), "evm", "dev")
+}
+
 // setupGRPC sets up the grpc server and listens on tcp
 func (s *Server) setupGRPC() error {
 	proto.RegisterSystemServer(s.grpcServer, &systemService{server: s})
@@ -791,6 +1292,11 @@ func (s *Server) Close() {
 		s.stateSyncRelayer.Stop()
 	}
 
+	// Close the JSON-RPC listeners
+	if s.jsonrpcServer != nil {
+		s.jsonrpcServer.Close()
+	}
+
 	// Close the txpool's main loop
 	s.txpool.Close()
 
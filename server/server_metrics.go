@@ -5,8 +5,10 @@ import (
 	"os"
 	"time"
 
+	"github.com/0xPolygon/polygon-edge/jsonrpc"
 	"github.com/armon/go-metrics"
 	"github.com/armon/go-metrics/prometheus"
+	promclient "github.com/prometheus/client_golang/prometheus"
 	"gopkg.in/DataDog/dd-trace-go.v1/ddtrace/tracer"
 	"gopkg.in/DataDog/dd-trace-go.v1/profiler"
 )
@@ -26,7 +28,8 @@ func (s *Server) setupTelemetry() error {
 		inm, promSink,
 	})
 
-	return nil
+	// the JSON-RPC collectors are exported by the same registry the prometheus sink is registered with
+	return jsonrpc.RegisterMetrics(promclient.DefaultRegisterer)
 }
 
 // enableDataDogProfiler enables DataDog profiler. Enable it by setting DD_ENABLE env var.
@@ -40,6 +40,12 @@ type Executor struct {
 
 	PostHook        func(txn *Transition)
 	GenesisPostHook func(*Transition) error
+
+	// StoreRevertReasons keeps the revert payloads of the reverted transactions in their receipts
+	StoreRevertReasons bool
+
+	// TxPolicy restricts the senders, recipients and methods of the transactions on permissioned chains
+	TxPolicy *TxPolicy
 }
 
 // NewExecutor creates a new executor
@@ -188,6 +194,9 @@ func (e *Executor) BeginTxn(
 		evm:         evm.NewEVM(),
 		precompiles: precompiled.NewPrecompiled(),
 		PostHook:    e.PostHook,
+
+		storeRevertReasons: e.StoreRevertReasons,
+		txPolicy:           e.TxPolicy,
 	}
 
 	return txn, nil
@@ -215,6 +224,9 @@ type Transition struct {
 	// runtimes
 	evm         *evm.EVM
 	precompiles *precompiled.Precompiled
+
+	storeRevertReasons bool
+	txPolicy           *TxPolicy
 }
 
 func NewTransition(config chain.ForksInTime, snap Snapshot, radix *Txn) *Transition {
@@ -282,6 +294,14 @@ func (t *Transition) Write(txn *types.Transaction) error {
 		}
 	}
 
+	// the transaction policy restricts only the transactions written to the blocks,
+	// the calls, the gas estimations and the traces applied on top of the state are not restricted
+	if txn.Type != types.StateTx {
+		if err := t.txPolicy.Check(txn, t.state); err != nil {
+			return NewTransitionApplicationError(err, false)
+		}
+	}
+
 	// Make a local copy and apply the transaction
 	msg := txn.Copy()
 
@@ -308,6 +328,10 @@ func (t *Transition) Write(txn *types.Transaction) error {
 
 	if result.Failed() {
 		receipt.SetStatus(types.ReceiptFailed)
+
+		if result.Reverted() && t.storeRevertReasons {
+			receipt.RevertReason = result.ReturnValue
+		}
 	} else {
 		receipt.SetStatus(types.ReceiptSuccess)
 	}
@@ -325,6 +349,32 @@ func (t *Transition) Write(txn *types.Transaction) error {
 	return nil
 }
 
+// TransitionSnapshot is a point of the transition the transactions written after it can be reverted to
+type TransitionSnapshot struct {
+	state    int
+	receipts int
+	totalGas uint64
+	gasPool  uint64
+}
+
+// Snapshot takes a snapshot of the state, receipts and gas of the transition
+func (t *Transition) Snapshot() *TransitionSnapshot {
+	return &TransitionSnapshot{
+		state:    t.state.Snapshot(),
+		receipts: len(t.receipts),
+		totalGas: t.totalGas,
+		gasPool:  t.gasPool,
+	}
+}
+
+// RevertToSnapshot reverts the transactions written after the snapshot was taken
+func (t *Transition) RevertToSnapshot(snapshot *TransitionSnapshot) {
+	t.state.RevertToSnapshot(snapshot.state)
+	t.receipts = t.receipts[:snapshot.receipts]
+	t.totalGas = snapshot.totalGas
+	t.gasPool = snapshot.gasPool
+}
+
 // Commit commits the final result
 func (t *Transition) Commit() (Snapshot, types.Hash) {
 	objs := t.state.Commit(t.config.EIP155)
@@ -807,6 +857,19 @@ func (t *Transition) SetCodeDirectly(addr types.Address, code []byte) error {
 	return nil
 }
 
+// SetBlockContext moves the transition to the block, so the next transactions are applied in it.
+// NOTE: SetBlockContext is meant for the simulations spanning several blocks, which are never committed
+func (t *Transition) SetBlockContext(header *types.Header, coinbase types.Address, config chain.ForksInTime) {
+	t.ctx.Coinbase = coinbase
+	t.ctx.Timestamp = int64(header.Timestamp)
+	t.ctx.Number = int64(header.Number)
+	t.ctx.Difficulty = types.BytesToHash(new(big.Int).SetUint64(header.Difficulty).Bytes())
+	t.ctx.GasLimit = int64(header.GasLimit)
+
+	t.config = config
+	t.gasPool = header.GasLimit
+}
+
 // SetTracer sets tracer to the context in order to enable it
 func (t *Transition) SetTracer(tracer tracer.Tracer) {
 	t.ctx.Tracer = tracer
@@ -159,3 +159,27 @@ func TestTransfer(t *testing.T) {
 		})
 	}
 }
+
+func TestTransition_RevertToSnapshot(t *testing.T) {
+	t.Parallel()
+
+	transition := newTestTransition(nil)
+	transition.gasPool = 100
+	transition.receipts = []*types.Receipt{{GasUsed: 10}}
+	transition.totalGas = 10
+
+	balance := transition.GetBalance(addr1)
+	snapshot := transition.Snapshot()
+
+	transition.state.SetBalance(addr1, big.NewInt(0).Add(balance, big.NewInt(1)))
+	transition.receipts = append(transition.receipts, &types.Receipt{GasUsed: 20})
+	transition.totalGas = 30
+	transition.gasPool = 80
+
+	transition.RevertToSnapshot(snapshot)
+
+	assert.Equal(t, balance, transition.GetBalance(addr1))
+	assert.Len(t, transition.Receipts(), 1)
+	assert.Equal(t, uint64(10), transition.TotalGas())
+	assert.Equal(t, uint64(100), transition.gasPool)
+}
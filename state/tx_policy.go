@@ -0,0 +1,289 @@
+package state
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+var (
+	ErrTxPolicySenderNotAllowed    = errors.New("sender is not allowed by the transaction policy")
+	ErrTxPolicySenderDenied        = errors.New("sender is denied by the transaction policy")
+	ErrTxPolicyRecipientNotAllowed = errors.New("recipient is not allowed by the transaction policy")
+	ErrTxPolicyMethodDenied        = errors.New("method is denied by the transaction policy")
+)
+
+// Storage layout of the policy contract. The mappings follow the Solidity layout,
+// so the contract can be implemented as:
+//
+//	uint256 flags;                           // slot 0
+//	mapping(address => uint256) senders;     // slot 1
+//	mapping(address => uint256) recipients;  // slot 2
+//	mapping(bytes4 => uint256) methods;      // slot 3
+const (
+	txPolicyFlagsSlot      = 0
+	txPolicySendersSlot    = 1
+	txPolicyRecipientsSlot = 2
+	txPolicyMethodsSlot    = 3
+)
+
+// flags of the policy contract which enforce the allowlists even if they are empty in genesis
+const (
+	TxPolicySenderAllowListFlag    = 1 << 0
+	TxPolicyRecipientAllowListFlag = 1 << 1
+)
+
+// txPolicyStatus is the status of an address or a method selector in the policy contract
+type txPolicyStatus uint64
+
+const (
+	txPolicyUnset txPolicyStatus = iota
+	txPolicyAllowed
+	txPolicyDenied
+)
+
+// MethodSelector is the 4-byte identifier of the called contract method
+type MethodSelector [4]byte
+
+// String returns the hex encoding of the selector
+func (s MethodSelector) String() string {
+	return hex.EncodeToHex(s[:])
+}
+
+// PolicyStorage reads the storage of the policy contract
+type PolicyStorage interface {
+	GetState(addr types.Address, key types.Hash) types.Hash
+}
+
+// TxPolicy is the transaction admission policy of permissioned chains.
+// It is configured in genesis and can be changed at runtime through the storage of the policy contract,
+// whose entries take precedence over the genesis ones.
+type TxPolicy struct {
+	senderAllow    map[types.Address]struct{}
+	senderDeny     map[types.Address]struct{}
+	recipientAllow map[types.Address]struct{}
+	methodDeny     map[MethodSelector]struct{}
+	contract       *types.Address
+}
+
+// NewTxPolicy creates the transaction policy out of the genesis config.
+// Returns nil if the chain has no policy.
+func NewTxPolicy(config *chain.TxPolicy) (*TxPolicy, error) {
+	if config == nil {
+		return nil, nil
+	}
+
+	policy := &TxPolicy{
+		senderAllow:    toAddressSet(config.SenderAllowList),
+		senderDeny:     toAddressSet(config.SenderDenyList),
+		recipientAllow: toAddressSet(config.RecipientAllowList),
+		methodDeny:     make(map[MethodSelector]struct{}, len(config.MethodDenyList)),
+		contract:       config.Contract,
+	}
+
+	for _, raw := range config.MethodDenyList {
+		selector, err := hex.DecodeHex(raw)
+		if err != nil || len(selector) != len(MethodSelector{}) {
+			return nil, fmt.Errorf("invalid method selector %q in the transaction policy", raw)
+		}
+
+		var method MethodSelector
+
+		copy(method[:], selector)
+
+		policy.methodDeny[method] = struct{}{}
+	}
+
+	return policy, nil
+}
+
+func toAddressSet(addrs []types.Address) map[types.Address]struct{} {
+	set := make(map[types.Address]struct{}, len(addrs))
+	for _, addr := range addrs {
+		set[addr] = struct{}{}
+	}
+
+	return set
+}
+
+// Check checks if the transaction is admitted by the policy
+func (p *TxPolicy) Check(tx *types.Transaction, storage PolicyStorage) error {
+	if p == nil {
+		return nil
+	}
+
+	flags := p.flags(storage)
+
+	switch p.status(storage, txPolicySendersSlot, addressKey(tx.From), p.senderDeny, p.senderAllow, tx.From) {
+	case txPolicyDenied:
+		return ErrTxPolicySenderDenied
+	case txPolicyUnset:
+		if len(p.senderAllow) > 0 || flags&TxPolicySenderAllowListFlag != 0 {
+			return ErrTxPolicySenderNotAllowed
+		}
+	}
+
+	// contract creations are restricted by the deployment whitelist
+	if tx.To == nil {
+		return nil
+	}
+
+	switch p.status(storage, txPolicyRecipientsSlot, addressKey(*tx.To), nil, p.recipientAllow, *tx.To) {
+	case txPolicyDenied:
+		return ErrTxPolicyRecipientNotAllowed
+	case txPolicyUnset:
+		if len(p.recipientAllow) > 0 || flags&TxPolicyRecipientAllowListFlag != 0 {
+			return ErrTxPolicyRecipientNotAllowed
+		}
+	}
+
+	if len(tx.Input) < len(MethodSelector{}) {
+		return nil
+	}
+
+	var selector MethodSelector
+
+	copy(selector[:], tx.Input)
+
+	if p.methodStatus(storage, selector) == txPolicyDenied {
+		return ErrTxPolicyMethodDenied
+	}
+
+	return nil
+}
+
+// status returns the status of the address, looking it up in the policy contract first
+func (p *TxPolicy) status(
+	storage PolicyStorage,
+	slot uint64,
+	key types.Hash,
+	deny, allow map[types.Address]struct{},
+	addr types.Address,
+) txPolicyStatus {
+	if status := p.contractStatus(storage, slot, key); status != txPolicyUnset {
+		return status
+	}
+
+	if _, ok := deny[addr]; ok {
+		return txPolicyDenied
+	}
+
+	if _, ok := allow[addr]; ok {
+		return txPolicyAllowed
+	}
+
+	return txPolicyUnset
+}
+
+// methodStatus returns the status of the method selector, looking it up in the policy contract first
+func (p *TxPolicy) methodStatus(storage PolicyStorage, selector MethodSelector) txPolicyStatus {
+	var key types.Hash
+
+	// bytes4 keys are left aligned
+	copy(key[:], selector[:])
+
+	if status := p.contractStatus(storage, txPolicyMethodsSlot, key); status != txPolicyUnset {
+		return status
+	}
+
+	if _, ok := p.methodDeny[selector]; ok {
+		return txPolicyDenied
+	}
+
+	return txPolicyUnset
+}
+
+// flags returns the flags set in the policy contract
+func (p *TxPolicy) flags(storage PolicyStorage) uint64 {
+	if p.contract == nil || storage == nil {
+		return 0
+	}
+
+	flags := storage.GetState(*p.contract, types.BytesToHash(big.NewInt(txPolicyFlagsSlot).Bytes()))
+
+	return new(big.Int).SetBytes(flags.Bytes()).Uint64()
+}
+
+// contractStatus returns the status stored under the key of the policy contract mapping
+func (p *TxPolicy) contractStatus(storage PolicyStorage, slot uint64, key types.Hash) txPolicyStatus {
+	if p.contract == nil || storage == nil {
+		return txPolicyUnset
+	}
+
+	value := new(big.Int).SetBytes(storage.GetState(*p.contract, mappingSlot(key, slot)).Bytes())
+	if !value.IsUint64() || value.Uint64() > uint64(txPolicyDenied) {
+		return txPolicyUnset
+	}
+
+	return txPolicyStatus(value.Uint64())
+}
+
+// mappingSlot returns the storage slot of the key in the Solidity mapping stored at the given slot
+func mappingSlot(key types.Hash, slot uint64) types.Hash {
+	return crypto.Keccak256Hash(key.Bytes(), types.BytesToHash(new(big.Int).SetUint64(slot).Bytes()).Bytes())
+}
+
+func addressKey(addr types.Address) types.Hash {
+	return types.BytesToHash(addr.Bytes())
+}
+
+// TxPolicyInfo describes the policy in effect
+type TxPolicyInfo struct {
+	SenderAllowList            []types.Address
+	SenderDenyList             []types.Address
+	RecipientAllowList         []types.Address
+	MethodDenyList             []MethodSelector
+	Contract                   *types.Address
+	SenderAllowListEnforced    bool
+	RecipientAllowListEnforced bool
+}
+
+// Info describes the policy in effect. The entries of the policy contract
+// can't be enumerated, so only its flags are reflected.
+func (p *TxPolicy) Info(storage PolicyStorage) *TxPolicyInfo {
+	if p == nil {
+		return &TxPolicyInfo{}
+	}
+
+	flags := p.flags(storage)
+
+	info := &TxPolicyInfo{
+		SenderAllowList:            fromAddressSet(p.senderAllow),
+		SenderDenyList:             fromAddressSet(p.senderDeny),
+		RecipientAllowList:         fromAddressSet(p.recipientAllow),
+		MethodDenyList:             make([]MethodSelector, 0, len(p.methodDeny)),
+		Contract:                   p.contract,
+		SenderAllowListEnforced:    len(p.senderAllow) > 0 || flags&TxPolicySenderAllowListFlag != 0,
+		RecipientAllowListEnforced: len(p.recipientAllow) > 0 || flags&TxPolicyRecipientAllowListFlag != 0,
+	}
+
+	for selector := range p.methodDeny {
+		info.MethodDenyList = append(info.MethodDenyList, selector)
+	}
+
+	sort.Slice(info.MethodDenyList, func(i, j int) bool {
+		return bytes.Compare(info.MethodDenyList[i][:], info.MethodDenyList[j][:]) < 0
+	})
+
+	return info
+}
+
+func fromAddressSet(set map[types.Address]struct{}) []types.Address {
+	addrs := make([]types.Address, 0, len(set))
+	for addr := range set {
+		addrs = append(addrs, addr)
+	}
+
+	sort.Slice(addrs, func(i, j int) bool {
+		return bytes.Compare(addrs[i].Bytes(), addrs[j].Bytes()) < 0
+	})
+
+	return addrs
+}
@@ -0,0 +1,173 @@
+package state
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	policyContract = types.StringToAddress("1000")
+	policyAddr1    = types.StringToAddress("2")
+	policyAddr2    = types.StringToAddress("3")
+	policyMethod   = []byte{0xa9, 0x05, 0x9c, 0xbb}
+)
+
+// setPolicyStatus stores the status of the key in the policy contract mapping at the given slot
+func setPolicyStatus(txn *Txn, slot uint64, key types.Hash, status txPolicyStatus) {
+	txn.SetState(policyContract, mappingSlot(key, slot), types.BytesToHash(big.NewInt(int64(status)).Bytes()))
+}
+
+func TestNewTxPolicy(t *testing.T) {
+	t.Parallel()
+
+	policy, err := NewTxPolicy(nil)
+	require.NoError(t, err)
+	require.Nil(t, policy)
+
+	_, err = NewTxPolicy(&chain.TxPolicy{MethodDenyList: []string{"0xa9059c"}})
+	require.Error(t, err)
+
+	policy, err = NewTxPolicy(&chain.TxPolicy{MethodDenyList: []string{"0xa9059cbb"}})
+	require.NoError(t, err)
+	require.Equal(t, []MethodSelector{{0xa9, 0x05, 0x9c, 0xbb}}, policy.Info(nil).MethodDenyList)
+}
+
+func TestTxPolicy_Check(t *testing.T) {
+	t.Parallel()
+
+	call := func(from, to types.Address, input []byte) *types.Transaction {
+		return &types.Transaction{From: from, To: &to, Input: input}
+	}
+
+	tests := []struct {
+		name        string
+		config      *chain.TxPolicy
+		setup       func(txn *Txn)
+		tx          *types.Transaction
+		expectedErr error
+	}{
+		{
+			name:   "no policy",
+			config: nil,
+			tx:     call(policyAddr1, policyAddr2, policyMethod),
+		},
+		{
+			name:        "genesis sender denylist",
+			config:      &chain.TxPolicy{SenderDenyList: []types.Address{policyAddr1}},
+			tx:          call(policyAddr1, policyAddr2, nil),
+			expectedErr: ErrTxPolicySenderDenied,
+		},
+		{
+			name:        "genesis sender allowlist",
+			config:      &chain.TxPolicy{SenderAllowList: []types.Address{policyAddr2}},
+			tx:          call(policyAddr1, policyAddr2, nil),
+			expectedErr: ErrTxPolicySenderNotAllowed,
+		},
+		{
+			name:        "genesis recipient allowlist",
+			config:      &chain.TxPolicy{RecipientAllowList: []types.Address{policyAddr1}},
+			tx:          call(policyAddr1, policyAddr2, nil),
+			expectedErr: ErrTxPolicyRecipientNotAllowed,
+		},
+		{
+			name:   "contract creation skips the recipient allowlist",
+			config: &chain.TxPolicy{RecipientAllowList: []types.Address{policyAddr1}},
+			tx:     &types.Transaction{From: policyAddr1, Input: policyMethod},
+		},
+		{
+			name:        "genesis method denylist",
+			config:      &chain.TxPolicy{MethodDenyList: []string{"0xa9059cbb"}},
+			tx:          call(policyAddr1, policyAddr2, append(policyMethod, 0x1)),
+			expectedErr: ErrTxPolicyMethodDenied,
+		},
+		{
+			name: "contract allows the sender denied in genesis",
+			config: &chain.TxPolicy{
+				SenderDenyList: []types.Address{policyAddr1},
+				Contract:       &policyContract,
+			},
+			setup: func(txn *Txn) {
+				setPolicyStatus(txn, txPolicySendersSlot, addressKey(policyAddr1), txPolicyAllowed)
+			},
+			tx: call(policyAddr1, policyAddr2, nil),
+		},
+		{
+			name:   "contract denies the recipient",
+			config: &chain.TxPolicy{Contract: &policyContract},
+			setup: func(txn *Txn) {
+				setPolicyStatus(txn, txPolicyRecipientsSlot, addressKey(policyAddr2), txPolicyDenied)
+			},
+			tx:          call(policyAddr1, policyAddr2, nil),
+			expectedErr: ErrTxPolicyRecipientNotAllowed,
+		},
+		{
+			name:   "contract denies the method",
+			config: &chain.TxPolicy{Contract: &policyContract},
+			setup: func(txn *Txn) {
+				var key types.Hash
+
+				copy(key[:], policyMethod)
+				setPolicyStatus(txn, txPolicyMethodsSlot, key, txPolicyDenied)
+			},
+			tx:          call(policyAddr1, policyAddr2, policyMethod),
+			expectedErr: ErrTxPolicyMethodDenied,
+		},
+		{
+			name:   "contract enforces the empty sender allowlist",
+			config: &chain.TxPolicy{Contract: &policyContract},
+			setup: func(txn *Txn) {
+				txn.SetState(policyContract, types.Hash{}, types.BytesToHash([]byte{TxPolicySenderAllowListFlag}))
+				setPolicyStatus(txn, txPolicySendersSlot, addressKey(policyAddr2), txPolicyAllowed)
+			},
+			tx:          call(policyAddr1, policyAddr2, nil),
+			expectedErr: ErrTxPolicySenderNotAllowed,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			policy, err := NewTxPolicy(tt.config)
+			require.NoError(t, err)
+
+			txn := newTestTxn(defaultPreState)
+			if tt.setup != nil {
+				tt.setup(txn)
+			}
+
+			assert.ErrorIs(t, policy.Check(tt.tx, txn), tt.expectedErr)
+		})
+	}
+}
+
+func TestTransition_Write_TxPolicy(t *testing.T) {
+	t.Parallel()
+
+	policy, err := NewTxPolicy(&chain.TxPolicy{SenderDenyList: []types.Address{addr1}})
+	require.NoError(t, err)
+
+	transition := newTestTransition(nil)
+	transition.txPolicy = policy
+
+	tx := &types.Transaction{From: addr1, GasPrice: big.NewInt(1)}
+
+	// the denied tx is not written to the block
+	err = transition.Write(tx)
+
+	var appErr *TransitionApplicationError
+
+	require.True(t, errors.As(err, &appErr))
+	assert.False(t, appErr.IsRecoverable)
+	assert.ErrorIs(t, appErr.Err, ErrTxPolicySenderDenied)
+
+	// the calls applied on top of the state are not restricted
+	assert.NoError(t, checkAndProcessLegacyTx(tx, transition))
+}
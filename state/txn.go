@@ -319,6 +319,14 @@ func (txn *Txn) SetState(
 	})
 }
 
+// ResetStorage drops the whole storage of the address, so only the slots set afterwards are present
+func (txn *Txn) ResetStorage(addr types.Address) {
+	txn.upsertAccount(addr, true, func(object *StateObject) {
+		object.Account.Root = emptyStateHash
+		object.Txn = iradix.New().Txn()
+	})
+}
+
 // GetState returns the state of the address at a given key
 func (txn *Txn) GetState(addr types.Address, key types.Hash) types.Hash {
 	object, exists := txn.getStateObject(addr)
@@ -223,9 +223,23 @@ func (a *account) reset(nonce uint64, promoteCh chan<- promoteRequest) (
 }
 
 // enqueue attempts tp push the transaction onto the enqueued queue.
-func (a *account) enqueue(tx *types.Transaction) error {
+// The nonce lookup and the insertion are done under the same locks, so a promoted or enqueued
+// transaction with the same nonce is never duplicated, it is passed to the replace callback instead.
+func (a *account) enqueue(
+	tx *types.Transaction,
+	replace func(existing *types.Transaction, queue *accountQueue) error,
+) error {
+	a.promoted.lock(true)
 	a.enqueued.lock(true)
-	defer a.enqueued.unlock()
+
+	defer func() {
+		a.enqueued.unlock()
+		a.promoted.unlock()
+	}()
+
+	if existing, queue := a.getByNonce(tx.Nonce); existing != nil {
+		return replace(existing, queue)
+	}
 
 	if a.enqueued.length() == a.maxEnqueued {
 		return ErrMaxEnqueuedLimitReached
@@ -242,6 +256,20 @@ func (a *account) enqueue(tx *types.Transaction) error {
 	return nil
 }
 
+// getByNonce returns the promoted or enqueued transaction with the given nonce along with its queue,
+// or nil if there is none. The caller must hold the locks of both queues.
+func (a *account) getByNonce(nonce uint64) (*types.Transaction, *accountQueue) {
+	if tx := a.promoted.getByNonce(nonce); tx != nil {
+		return tx, a.promoted
+	}
+
+	if tx := a.enqueued.getByNonce(nonce); tx != nil {
+		return tx, a.enqueued
+	}
+
+	return nil, nil
+}
+
 // Promote moves eligible transactions from enqueued to promoted.
 //
 // Eligible transactions are all sequential in order of nonce
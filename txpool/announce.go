@@ -0,0 +1,847 @@
+package txpool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/network"
+	"github.com/0xPolygon/polygon-edge/network/event"
+	"github.com/0xPolygon/polygon-edge/network/grpc"
+	"github.com/0xPolygon/polygon-edge/txpool/proto"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	// txAnnounceProto is the libp2p protocol the transaction hashes are announced
+	// and the announced transactions are fetched over
+	txAnnounceProto = "/txpool/announce/0.1"
+
+	// txAnnounceInterval is the interval the queued announcements are sent to the peers in
+	txAnnounceInterval = 250 * time.Millisecond
+
+	// txAnnounceTimeout is the timeout of sending an announcement to a peer
+	txAnnounceTimeout = 5 * time.Second
+
+	// txFetchTimeout is the timeout of fetching the announced transactions from a peer
+	txFetchTimeout = 5 * time.Second
+
+	// maxAnnounceBatch is the max number of the transactions in a single announcement
+	maxAnnounceBatch = 4096
+
+	// maxFetchBatch is the max number of the transactions requested from a peer at once
+	maxFetchBatch = 256
+
+	// maxAnnouncedTxs is the max number of the announced transactions waiting to be fetched
+	maxAnnouncedTxs = 8192
+
+	// maxAnnouncedTxsPerPeer is the max number of the transactions announced by a single peer waiting to be fetched
+	maxAnnouncedTxsPerPeer = 1024
+
+	// maxFetchFailures is the number of the consecutive requests a peer can fail to deliver before it is penalized
+	maxFetchFailures = 3
+
+	// fetchFailurePenalty is the time the announcements of a penalized peer are ignored for
+	fetchFailurePenalty = time.Minute
+
+	// maxKnownTxs is the number of the transaction hashes remembered as known to a peer
+	maxKnownTxs = 16384
+)
+
+var (
+	ErrInvalidTxAnnouncement = errors.New("invalid transaction announcement")
+	ErrTooManyTxsRequested   = errors.New("too many transactions requested")
+)
+
+// txAnnounceNetwork is the network the transactions are announced and fetched over
+type txAnnounceNetwork interface {
+	privateTxNetwork
+	// Peers returns the connected peers
+	Peers() []*network.PeerConnInfo
+	// GetProtocols returns the protocols supported by the peer
+	GetProtocols(peerID peer.ID) ([]string, error)
+	// SubscribeCh returns the channel of the peer connection events
+	SubscribeCh(context.Context) (<-chan *event.PeerEvent, error)
+}
+
+// txAnnouncer announces the hashes of the new transactions to the peers
+// which support the announcement protocol, skipping the ones already known to each peer
+type txAnnouncer struct {
+	logger  hclog.Logger
+	network txAnnounceNetwork
+	lookup  func(types.Hash) (*types.Transaction, bool)
+
+	// pending are the hashes waiting for the next announcement
+	pendingLock sync.Mutex
+	pending     []types.Hash
+
+	// peers are the connected peers which support the announcement protocol,
+	// updated as the peers connect and disconnect
+	peersLock sync.RWMutex
+	peers     map[peer.ID]struct{}
+
+	// known are the hashes known to each peer
+	knownLock sync.Mutex
+	known     map[peer.ID]*lru.Cache
+}
+
+func newTxAnnouncer(
+	logger hclog.Logger,
+	network txAnnounceNetwork,
+	lookup func(types.Hash) (*types.Transaction, bool),
+) *txAnnouncer {
+	return &txAnnouncer{
+		logger:  logger,
+		network: network,
+		lookup:  lookup,
+		peers:   make(map[peer.ID]struct{}),
+		known:   make(map[peer.ID]*lru.Cache),
+	}
+}
+
+// announce queues the hashes for the next announcement
+func (a *txAnnouncer) announce(hashes ...types.Hash) {
+	a.pendingLock.Lock()
+	defer a.pendingLock.Unlock()
+
+	a.pending = append(a.pending, hashes...)
+}
+
+// markKnown remembers the hashes as known to the peer, so they are not announced to it
+func (a *txAnnouncer) markKnown(peerID peer.ID, hashes ...types.Hash) {
+	a.knownLock.Lock()
+	defer a.knownLock.Unlock()
+
+	known, ok := a.known[peerID]
+	if !ok {
+		known, _ = lru.New(maxKnownTxs)
+		a.known[peerID] = known
+	}
+
+	for _, hash := range hashes {
+		known.Add(hash, struct{}{})
+	}
+}
+
+// unknownTxs returns the transactions unknown to the peer and marks them as known
+func (a *txAnnouncer) unknownTxs(peerID peer.ID, txs []*types.Transaction) []*types.Transaction {
+	a.knownLock.Lock()
+	defer a.knownLock.Unlock()
+
+	known, ok := a.known[peerID]
+	if !ok {
+		known, _ = lru.New(maxKnownTxs)
+		a.known[peerID] = known
+	}
+
+	unknown := make([]*types.Transaction, 0, len(txs))
+
+	for _, tx := range txs {
+		if known.Contains(tx.Hash) {
+			continue
+		}
+
+		known.Add(tx.Hash, struct{}{})
+
+		unknown = append(unknown, tx)
+	}
+
+	return unknown
+}
+
+// addPeer adds the connected peer to the announced ones, if it supports the announcement protocol.
+// The protocols are known once the peer is connected, as the handshake waits for them to be identified
+func (a *txAnnouncer) addPeer(peerID peer.ID) {
+	if !a.supportsAnnouncements(peerID) {
+		return
+	}
+
+	a.peersLock.Lock()
+	defer a.peersLock.Unlock()
+
+	a.peers[peerID] = struct{}{}
+}
+
+// removePeer drops the disconnected peer along with the hashes known to it
+func (a *txAnnouncer) removePeer(peerID peer.ID) {
+	a.peersLock.Lock()
+	delete(a.peers, peerID)
+	a.peersLock.Unlock()
+
+	a.knownLock.Lock()
+	delete(a.known, peerID)
+	a.knownLock.Unlock()
+}
+
+// announcedPeers returns the connected peers which support the announcement protocol
+func (a *txAnnouncer) announcedPeers() []peer.ID {
+	a.peersLock.RLock()
+	defer a.peersLock.RUnlock()
+
+	peers := make([]peer.ID, 0, len(a.peers))
+	for peerID := range a.peers {
+		peers = append(peers, peerID)
+	}
+
+	return peers
+}
+
+// trackPeers keeps the peers which support the announcement protocol up to date,
+// until the context is canceled
+func (a *txAnnouncer) trackPeers(ctx context.Context) error {
+	eventCh, err := a.network.SubscribeCh(ctx)
+	if err != nil {
+		return err
+	}
+
+	// the peers connected before the subscription
+	for _, info := range a.network.Peers() {
+		a.addPeer(info.Info.ID)
+	}
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case e, ok := <-eventCh:
+				if !ok {
+					return
+				}
+
+				switch e.Type {
+				case event.PeerConnected:
+					a.addPeer(e.PeerID)
+				case event.PeerDisconnected:
+					a.removePeer(e.PeerID)
+				}
+			}
+		}
+	}()
+
+	return nil
+}
+
+// supportsAnnouncements checks if the peer negotiated the announcement protocol
+func (a *txAnnouncer) supportsAnnouncements(peerID peer.ID) bool {
+	protocols, err := a.network.GetProtocols(peerID)
+	if err != nil {
+		return false
+	}
+
+	for _, protocol := range protocols {
+		if protocol == txAnnounceProto {
+			return true
+		}
+	}
+
+	return false
+}
+
+// flush sends the queued announcements to the peers which support the announcement protocol.
+// The peers which don't support it yet still receive the transactions over the gossip topic.
+func (a *txAnnouncer) flush() {
+	a.pendingLock.Lock()
+	hashes := a.pending
+	a.pending = nil
+	a.pendingLock.Unlock()
+
+	if len(hashes) == 0 {
+		return
+	}
+
+	// the transactions which left the pool in the meantime are not announced
+	txs := make([]*types.Transaction, 0, len(hashes))
+
+	for _, hash := range hashes {
+		if tx, ok := a.lookup(hash); ok {
+			txs = append(txs, tx)
+		}
+	}
+
+	for _, peerID := range a.announcedPeers() {
+		unknown := a.unknownTxs(peerID, txs)
+
+		for start := 0; start < len(unknown); start += maxAnnounceBatch {
+			end := start + maxAnnounceBatch
+			if end > len(unknown) {
+				end = len(unknown)
+			}
+
+			go func(peerID peer.ID, announcement *proto.TxnAnnouncement) {
+				if err := a.sendTo(peerID, announcement); err != nil {
+					a.logger.Debug("failed to announce txs", "peer", peerID, "err", err)
+				}
+			}(peerID, newTxAnnouncement(unknown[start:end]))
+		}
+	}
+}
+
+func (a *txAnnouncer) sendTo(peerID peer.ID, announcement *proto.TxnAnnouncement) error {
+	conn, err := a.network.NewProtoConnection(txAnnounceProto, peerID)
+	if err != nil {
+		return fmt.Errorf("failed to open a stream, err %w", err)
+	}
+
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), txAnnounceTimeout)
+	defer cancel()
+
+	_, err = proto.NewTxnAnnouncerClient(conn).Announce(ctx, announcement)
+
+	return err
+}
+
+// fetch requests the announced transactions from the peer
+func (a *txAnnouncer) fetch(ctx context.Context, peerID peer.ID, hashes []types.Hash) ([]*types.Transaction, error) {
+	conn, err := a.network.NewProtoConnection(txAnnounceProto, peerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open a stream, err %w", err)
+	}
+
+	defer conn.Close()
+
+	req := &proto.GetTxnsReq{
+		Hashes: make([][]byte, len(hashes)),
+	}
+
+	for i, hash := range hashes {
+		req.Hashes[i] = hash.Bytes()
+	}
+
+	resp, err := proto.NewTxnAnnouncerClient(conn).GetTxns(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	txs := make([]*types.Transaction, 0, len(resp.Txs))
+
+	for _, raw := range resp.Txs {
+		tx := new(types.Transaction)
+		if err := tx.UnmarshalRLP(raw); err != nil {
+			return nil, fmt.Errorf("failed to decode fetched tx, err %w", err)
+		}
+
+		tx.ComputeHash()
+
+		txs = append(txs, tx)
+	}
+
+	return txs, nil
+}
+
+// newTxAnnouncement builds the announcement of the hashes, types and sizes of the transactions
+func newTxAnnouncement(txs []*types.Transaction) *proto.TxnAnnouncement {
+	announcement := &proto.TxnAnnouncement{
+		Hashes: make([][]byte, len(txs)),
+		Types:  make([]uint32, len(txs)),
+		Sizes:  make([]uint32, len(txs)),
+	}
+
+	for i, tx := range txs {
+		announcement.Hashes[i] = tx.Hash.Bytes()
+		announcement.Types[i] = uint32(tx.Type)
+		announcement.Sizes[i] = uint32(tx.Size())
+	}
+
+	return announcement
+}
+
+// announcedTx is the transaction announced by a peer, the fetched transaction has to match
+type announcedTx struct {
+	hash   types.Hash
+	txType types.TxType
+	size   uint64
+}
+
+// matches checks if the fetched transaction has the announced type and size
+func (a announcedTx) matches(tx *types.Transaction) bool {
+	return tx.Type == a.txType && tx.Size() == a.size
+}
+
+// decodeTxAnnouncement returns the announced transactions,
+// if the announcement is well-formed and all the announced transactions are acceptable
+func decodeTxAnnouncement(announcement *proto.TxnAnnouncement) ([]announcedTx, error) {
+	num := len(announcement.Hashes)
+	if num > maxAnnounceBatch || len(announcement.Types) != num || len(announcement.Sizes) != num {
+		return nil, ErrInvalidTxAnnouncement
+	}
+
+	txs := make([]announcedTx, num)
+
+	for i, hash := range announcement.Hashes {
+		if len(hash) != types.HashLength {
+			return nil, fmt.Errorf("%w: invalid hash length %d", ErrInvalidTxAnnouncement, len(hash))
+		}
+
+		// the state transactions are never added to the pool from the network, so they are not announced
+		if txType := types.TxType(announcement.Types[i]); txType != types.LegacyTx {
+			return nil, fmt.Errorf("%w: unexpected tx type %d", ErrInvalidTxAnnouncement, txType)
+		}
+
+		if announcement.Sizes[i] > txMaxSize {
+			return nil, fmt.Errorf("%w: tx size %d exceeds the limit", ErrInvalidTxAnnouncement, announcement.Sizes[i])
+		}
+
+		txs[i] = announcedTx{
+			hash:   types.BytesToHash(hash),
+			txType: types.TxType(announcement.Types[i]),
+			size:   uint64(announcement.Sizes[i]),
+		}
+	}
+
+	return txs, nil
+}
+
+// txFetcher schedules the requests of the announced transactions.
+// Each transaction is requested from a single announcer at once, and each peer serves
+// a single request at once. A transaction the announcer failed to deliver in time
+// is requested from the next announcer, until there are none left.
+// The peers which repeatedly fail to deliver the transactions they announced, or deliver
+// the transactions which don't match their announcements, are penalized by ignoring
+// their announcements for a while.
+type txFetcher struct {
+	sync.Mutex
+
+	logger hclog.Logger
+
+	// announced are the announcements of the transactions waiting to be fetched, by the announcer
+	announced map[types.Hash]map[peer.ID]announcedTx
+
+	// announcedByPeer is the number of the transactions waiting to be fetched each peer announced
+	announcedByPeer map[peer.ID]int
+
+	// failures is the number of the consecutive requests each peer failed to deliver
+	failures map[peer.ID]int
+
+	// penalized are the peers whose announcements are ignored, until the given time
+	penalized map[peer.ID]time.Time
+
+	// fetching are the peers the transactions are being fetched from
+	fetching map[types.Hash]peer.ID
+
+	// busy are the peers with a request in flight
+	busy map[peer.ID]struct{}
+
+	// known checks if the transaction is already in the pool
+	known func(types.Hash) bool
+
+	// fetch requests the transactions from the peer
+	fetch func(context.Context, peer.ID, []types.Hash) ([]*types.Transaction, error)
+
+	// deliver hands the fetched transaction over to the pool
+	deliver func(peer.ID, *types.Transaction)
+
+	timeout time.Duration
+}
+
+func newTxFetcher(
+	logger hclog.Logger,
+	known func(types.Hash) bool,
+	fetch func(context.Context, peer.ID, []types.Hash) ([]*types.Transaction, error),
+	deliver func(peer.ID, *types.Transaction),
+) *txFetcher {
+	return &txFetcher{
+		logger:          logger,
+		announced:       make(map[types.Hash]map[peer.ID]announcedTx),
+		announcedByPeer: make(map[peer.ID]int),
+		failures:        make(map[peer.ID]int),
+		penalized:       make(map[peer.ID]time.Time),
+		fetching:        make(map[types.Hash]peer.ID),
+		busy:            make(map[peer.ID]struct{}),
+		known:           known,
+		fetch:           fetch,
+		deliver:         deliver,
+		timeout:         txFetchTimeout,
+	}
+}
+
+// notify records the transactions announced by the peer and schedules fetching the unknown ones
+func (f *txFetcher) notify(peerID peer.ID, txs []announcedTx) {
+	f.Lock()
+
+	if until, ok := f.penalized[peerID]; ok {
+		if time.Now().Before(until) {
+			f.Unlock()
+
+			return
+		}
+
+		delete(f.penalized, peerID)
+	}
+
+	for _, tx := range txs {
+		if f.known(tx.hash) {
+			continue
+		}
+
+		announcers, ok := f.announced[tx.hash]
+		if ok {
+			if _, duplicate := announcers[peerID]; duplicate {
+				continue
+			}
+		}
+
+		// the announcements over the limits are dropped,
+		// the transactions are announced again by the peers which fetch them
+		if f.announcedByPeer[peerID] >= maxAnnouncedTxsPerPeer {
+			break
+		}
+
+		if !ok {
+			if len(f.announced) >= maxAnnouncedTxs {
+				continue
+			}
+
+			announcers = make(map[peer.ID]announcedTx)
+			f.announced[tx.hash] = announcers
+		}
+
+		announcers[peerID] = tx
+		f.announcedByPeer[peerID]++
+	}
+
+	f.Unlock()
+
+	f.schedule()
+}
+
+// forgetAnnouncer removes the peer from the announcers of the transaction. The caller must hold the lock
+func (f *txFetcher) forgetAnnouncer(hash types.Hash, peerID peer.ID) {
+	announcers, ok := f.announced[hash]
+	if !ok {
+		return
+	}
+
+	if _, ok := announcers[peerID]; !ok {
+		return
+	}
+
+	delete(announcers, peerID)
+	f.releaseAnnouncement(peerID)
+
+	if len(announcers) == 0 {
+		delete(f.announced, hash)
+	}
+}
+
+// forgetTx removes the transaction along with its announcers. The caller must hold the lock
+func (f *txFetcher) forgetTx(hash types.Hash) {
+	for peerID := range f.announced[hash] {
+		f.releaseAnnouncement(peerID)
+	}
+
+	delete(f.announced, hash)
+}
+
+// releaseAnnouncement decreases the number of the transactions the peer announced
+func (f *txFetcher) releaseAnnouncement(peerID peer.ID) {
+	if f.announcedByPeer[peerID]--; f.announcedByPeer[peerID] <= 0 {
+		delete(f.announcedByPeer, peerID)
+	}
+}
+
+// recordDelivery tracks the consecutive failed requests of the peer, and penalizes it
+// once it fails too many of them, dropping its announcements. The caller must hold the lock
+func (f *txFetcher) recordDelivery(peerID peer.ID, delivered bool) {
+	if delivered {
+		delete(f.failures, peerID)
+
+		return
+	}
+
+	if f.failures[peerID]++; f.failures[peerID] < maxFetchFailures {
+		return
+	}
+
+	f.penalize(peerID)
+
+	f.logger.Debug("penalized the peer which failed to deliver the announced txs", "peer", peerID)
+}
+
+// penalize ignores the announcements of the peer for a while, dropping the ones waiting to be fetched.
+// The caller must hold the lock
+func (f *txFetcher) penalize(peerID peer.ID) {
+	delete(f.failures, peerID)
+	f.penalized[peerID] = time.Now().Add(fetchFailurePenalty)
+
+	for hash := range f.announced {
+		f.forgetAnnouncer(hash, peerID)
+	}
+}
+
+// schedule requests the announced transactions, which aren't being fetched yet, from the idle announcers
+func (f *txFetcher) schedule() {
+	f.Lock()
+	defer f.Unlock()
+
+	requests := make(map[peer.ID][]announcedTx)
+
+	for hash, announcers := range f.announced {
+		if _, ok := f.fetching[hash]; ok {
+			continue
+		}
+
+		// the transaction arrived over the gossip topic in the meantime
+		if f.known(hash) {
+			f.forgetTx(hash)
+
+			continue
+		}
+
+		for peerID, announced := range announcers {
+			if _, ok := f.busy[peerID]; ok || len(requests[peerID]) >= maxFetchBatch {
+				continue
+			}
+
+			requests[peerID] = append(requests[peerID], announced)
+			f.fetching[hash] = peerID
+
+			break
+		}
+	}
+
+	for peerID, announced := range requests {
+		f.busy[peerID] = struct{}{}
+
+		go f.request(peerID, announced)
+	}
+}
+
+// request fetches the transactions from the peer, and delivers the requested ones matching the announcements
+func (f *txFetcher) request(peerID peer.ID, announced []announcedTx) {
+	ctx, cancel := context.WithTimeout(context.Background(), f.timeout)
+	defer cancel()
+
+	hashes := make([]types.Hash, len(announced))
+	missing := make(map[types.Hash]announcedTx, len(announced))
+
+	for i, tx := range announced {
+		hashes[i] = tx.hash
+		missing[tx.hash] = tx
+	}
+
+	txs, err := f.fetch(ctx, peerID, hashes)
+	if err != nil {
+		f.logger.Debug("failed to fetch announced txs", "peer", peerID, "num", len(hashes), "err", err)
+	}
+
+	mismatched := false
+
+	for _, tx := range txs {
+		// the transactions which weren't requested are ignored
+		expected, ok := missing[tx.Hash]
+		if !ok {
+			continue
+		}
+
+		// the transactions which don't match the announcements are dropped,
+		// and left to the other announcers
+		if !expected.matches(tx) {
+			f.logger.Debug("fetched tx doesn't match the announcement", "peer", peerID, "hash", tx.Hash)
+
+			mismatched = true
+
+			continue
+		}
+
+		delete(missing, tx.Hash)
+
+		f.deliver(peerID, tx)
+	}
+
+	f.complete(peerID, hashes, missing, mismatched)
+}
+
+// complete finishes the request to the peer. The missing transactions
+// are left to the other announcers, and the next requests are scheduled.
+// The request counts as failed if none of the requested transactions was delivered,
+// and the peer is penalized right away if it served a transaction which didn't match its announcement.
+func (f *txFetcher) complete(peerID peer.ID, hashes []types.Hash, missing map[types.Hash]announcedTx, mismatched bool) {
+	f.Lock()
+
+	for _, hash := range hashes {
+		delete(f.fetching, hash)
+
+		if _, ok := missing[hash]; !ok {
+			f.forgetTx(hash)
+
+			continue
+		}
+
+		f.forgetAnnouncer(hash, peerID)
+	}
+
+	delete(f.busy, peerID)
+
+	if mismatched {
+		f.penalize(peerID)
+
+		f.logger.Debug("penalized the peer which served the txs not matching its announcements", "peer", peerID)
+	} else {
+		f.recordDelivery(peerID, len(missing) < len(hashes))
+	}
+
+	f.Unlock()
+
+	f.schedule()
+}
+
+// startTxAnnounceService registers the protocol the transactions are announced and fetched over
+func (p *TxPool) startTxAnnounceService(network txAnnounceNetwork) {
+	p.announcer = newTxAnnouncer(p.logger, network, p.index.get)
+	p.fetcher = newTxFetcher(
+		p.logger,
+		func(hash types.Hash) bool {
+			_, ok := p.index.get(hash)
+
+			return ok
+		},
+		p.announcer.fetch,
+		p.addFetchedTx,
+	)
+
+	service := &txAnnounceService{
+		pool:   p,
+		stream: grpc.NewGrpcStream(),
+	}
+
+	proto.RegisterTxnAnnouncerServer(service.stream.GrpcServer(), service)
+	service.stream.Serve()
+	network.RegisterProtocol(txAnnounceProto, service.stream)
+
+	p.announceService = service
+}
+
+// startTxAnnouncer tracks the peers the transactions are announced to,
+// and runs the handler for the periodic announcements
+func (p *TxPool) startTxAnnouncer() {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	if err := p.announcer.trackPeers(ctx); err != nil {
+		p.logger.Error("failed to track the peers supporting the tx announcements", "err", err)
+	}
+
+	go func() {
+		ticker := time.NewTicker(txAnnounceInterval)
+		defer ticker.Stop()
+		defer cancel()
+
+		for {
+			select {
+			case <-p.shutdownCh:
+				return
+			case <-ticker.C:
+				p.announcer.flush()
+			}
+		}
+	}()
+}
+
+// announceTx queues the transaction for announcing to the peers (if enabled)
+func (p *TxPool) announceTx(tx *types.Transaction) {
+	if p.announcer == nil {
+		return
+	}
+
+	p.announcer.announce(tx.Hash)
+}
+
+// addFetchedTx adds the transaction fetched from the announcer to the pool
+func (p *TxPool) addFetchedTx(peerID peer.ID, tx *types.Transaction) {
+	p.announcer.markKnown(peerID, tx.Hash)
+
+	if err := p.addTx(gossip, tx); err != nil {
+		if errors.Is(err, ErrAlreadyKnown) {
+			p.logger.Debug("rejecting known tx (fetched)", "hash", tx.Hash.String())
+
+			return
+		}
+
+		p.logger.Error("failed to add fetched tx", "err", err, "hash", tx.Hash.String())
+
+		return
+	}
+
+	// the peers which don't support the announcements yet receive the transaction
+	// over the gossip topic, as published by the node which added it
+	p.announceTx(tx)
+}
+
+// txAnnounceService serves the transaction announcements and the requests of the announced transactions
+type txAnnounceService struct {
+	proto.UnimplementedTxnAnnouncerServer
+
+	pool   *TxPool
+	stream *grpc.GrpcStream
+}
+
+// Announce implements the transaction announcements protocol endpoint.
+// Schedules fetching the announced transactions unknown to the pool.
+func (s *txAnnounceService) Announce(ctx context.Context, req *proto.TxnAnnouncement) (*emptypb.Empty, error) {
+	grpcContext, ok := ctx.(*grpc.Context)
+	if !ok {
+		return nil, errors.New("invalid type assertion")
+	}
+
+	// only the sealers pick up the transactions from the network, as with the gossip topic
+	if !s.pool.getSealing() {
+		return &emptypb.Empty{}, nil
+	}
+
+	txs, err := decodeTxAnnouncement(req)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tx := range txs {
+		s.pool.announcer.markKnown(grpcContext.PeerID, tx.hash)
+	}
+
+	s.pool.fetcher.notify(grpcContext.PeerID, txs)
+
+	return &emptypb.Empty{}, nil
+}
+
+// GetTxns implements the transaction announcements protocol endpoint.
+// Returns the requested transactions present in the pool, the private ones are never served.
+func (s *txAnnounceService) GetTxns(ctx context.Context, req *proto.GetTxnsReq) (*proto.GetTxnsResp, error) {
+	grpcContext, ok := ctx.(*grpc.Context)
+	if !ok {
+		return nil, errors.New("invalid type assertion")
+	}
+
+	if len(req.Hashes) > maxFetchBatch {
+		return nil, ErrTooManyTxsRequested
+	}
+
+	resp := &proto.GetTxnsResp{
+		Txs: make([][]byte, 0, len(req.Hashes)),
+	}
+
+	for _, raw := range req.Hashes {
+		if len(raw) != types.HashLength {
+			continue
+		}
+
+		hash := types.BytesToHash(raw)
+
+		tx, ok := s.pool.index.get(hash)
+		if !ok || s.pool.privateTxs.has(hash) {
+			continue
+		}
+
+		s.pool.announcer.markKnown(grpcContext.PeerID, hash)
+
+		resp.Txs = append(resp.Txs, tx.MarshalRLP())
+	}
+
+	return resp, nil
+}
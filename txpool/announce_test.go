@@ -0,0 +1,489 @@
+package txpool
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/network"
+	"github.com/0xPolygon/polygon-edge/network/event"
+	"github.com/0xPolygon/polygon-edge/network/grpc"
+	"github.com/0xPolygon/polygon-edge/txpool/proto"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+	rawGrpc "google.golang.org/grpc"
+)
+
+// mockAnnounceNetwork is a network with the given peers and their protocols, which can't open connections
+type mockAnnounceNetwork struct {
+	protocols map[peer.ID][]string
+	eventCh   chan *event.PeerEvent
+}
+
+func (m *mockAnnounceNetwork) RegisterProtocol(string, network.Protocol) {}
+
+func (m *mockAnnounceNetwork) NewProtoConnection(string, peer.ID) (*rawGrpc.ClientConn, error) {
+	return nil, errors.New("not connected")
+}
+
+func (m *mockAnnounceNetwork) Peers() []*network.PeerConnInfo {
+	peers := make([]*network.PeerConnInfo, 0, len(m.protocols))
+	for peerID := range m.protocols {
+		peers = append(peers, &network.PeerConnInfo{Info: peer.AddrInfo{ID: peerID}})
+	}
+
+	return peers
+}
+
+func (m *mockAnnounceNetwork) GetProtocols(peerID peer.ID) ([]string, error) {
+	return m.protocols[peerID], nil
+}
+
+func (m *mockAnnounceNetwork) SubscribeCh(context.Context) (<-chan *event.PeerEvent, error) {
+	return m.eventCh, nil
+}
+
+type fetchRequest struct {
+	peerID peer.ID
+	hashes []types.Hash
+	respCh chan []*types.Transaction
+}
+
+// newTestFetcher returns a fetcher which hands the requests over to the channel,
+// and delivers the transactions to the other one
+func newTestFetcher(t *testing.T) (*txFetcher, chan fetchRequest, chan *types.Transaction) {
+	t.Helper()
+
+	requestCh := make(chan fetchRequest, 10)
+	deliveredCh := make(chan *types.Transaction, 10)
+
+	fetcher := newTxFetcher(
+		hclog.NewNullLogger(),
+		func(types.Hash) bool { return false },
+		func(ctx context.Context, peerID peer.ID, hashes []types.Hash) ([]*types.Transaction, error) {
+			req := fetchRequest{peerID: peerID, hashes: hashes, respCh: make(chan []*types.Transaction)}
+			requestCh <- req
+
+			select {
+			case txs := <-req.respCh:
+				return txs, nil
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		},
+		func(_ peer.ID, tx *types.Transaction) {
+			deliveredCh <- tx
+		},
+	)
+
+	return fetcher, requestCh, deliveredCh
+}
+
+func hashedTx(nonce uint64) *types.Transaction {
+	tx := newTx(addr1, nonce, 1)
+	tx.ComputeHash()
+
+	return tx
+}
+
+// announced returns the announcements of the transactions
+func announced(txs ...*types.Transaction) []announcedTx {
+	announcements := make([]announcedTx, len(txs))
+	for i, tx := range txs {
+		announcements[i] = announcedTx{hash: tx.Hash, txType: tx.Type, size: tx.Size()}
+	}
+
+	return announcements
+}
+
+func TestDecodeTxAnnouncement(t *testing.T) {
+	t.Parallel()
+
+	tx := hashedTx(0)
+
+	tests := []struct {
+		name     string
+		modify   func(*proto.TxnAnnouncement)
+		expected []announcedTx
+		err      error
+	}{
+		{
+			name:     "valid",
+			modify:   func(*proto.TxnAnnouncement) {},
+			expected: announced(tx),
+		},
+		{
+			name:   "mismatched lengths",
+			modify: func(a *proto.TxnAnnouncement) { a.Sizes = nil },
+			err:    ErrInvalidTxAnnouncement,
+		},
+		{
+			name:   "invalid hash",
+			modify: func(a *proto.TxnAnnouncement) { a.Hashes[0] = a.Hashes[0][1:] },
+			err:    ErrInvalidTxAnnouncement,
+		},
+		{
+			name:   "unknown type",
+			modify: func(a *proto.TxnAnnouncement) { a.Types[0] = 0x2 },
+			err:    ErrInvalidTxAnnouncement,
+		},
+		{
+			name:   "state tx",
+			modify: func(a *proto.TxnAnnouncement) { a.Types[0] = uint32(types.StateTx) },
+			err:    ErrInvalidTxAnnouncement,
+		},
+		{
+			name:   "oversized",
+			modify: func(a *proto.TxnAnnouncement) { a.Sizes[0] = txMaxSize + 1 },
+			err:    ErrInvalidTxAnnouncement,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			announcement := newTxAnnouncement([]*types.Transaction{tx})
+			tt.modify(announcement)
+
+			txs, err := decodeTxAnnouncement(announcement)
+			require.ErrorIs(t, err, tt.err)
+			require.Equal(t, tt.expected, txs)
+		})
+	}
+}
+
+func TestTxFetcher(t *testing.T) {
+	t.Parallel()
+
+	peer1, peer2 := peer.ID("peer1"), peer.ID("peer2")
+
+	t.Run("fetch each tx from a single announcer", func(t *testing.T) {
+		t.Parallel()
+
+		fetcher, requestCh, deliveredCh := newTestFetcher(t)
+		tx := hashedTx(0)
+
+		fetcher.notify(peer1, announced(tx))
+		fetcher.notify(peer2, announced(tx))
+
+		req := <-requestCh
+		require.Equal(t, []types.Hash{tx.Hash}, req.hashes)
+
+		req.respCh <- []*types.Transaction{tx}
+		require.Equal(t, tx, <-deliveredCh)
+
+		require.Eventually(t, func() bool {
+			fetcher.Lock()
+			defer fetcher.Unlock()
+
+			return len(fetcher.announced) == 0 && len(fetcher.busy) == 0
+		}, time.Second, 10*time.Millisecond)
+		require.Empty(t, requestCh)
+	})
+
+	t.Run("single request in flight per peer", func(t *testing.T) {
+		t.Parallel()
+
+		fetcher, requestCh, deliveredCh := newTestFetcher(t)
+		tx1, tx2 := hashedTx(0), hashedTx(1)
+
+		fetcher.notify(peer1, announced(tx1))
+		req := <-requestCh
+
+		fetcher.notify(peer1, announced(tx2))
+		require.Never(t, func() bool { return len(requestCh) > 0 }, 100*time.Millisecond, 10*time.Millisecond)
+
+		req.respCh <- []*types.Transaction{tx1}
+		require.Equal(t, tx1, <-deliveredCh)
+
+		req = <-requestCh
+		require.Equal(t, []types.Hash{tx2.Hash}, req.hashes)
+
+		req.respCh <- []*types.Transaction{tx2}
+		require.Equal(t, tx2, <-deliveredCh)
+	})
+
+	t.Run("refetch the undelivered tx from the other announcer", func(t *testing.T) {
+		t.Parallel()
+
+		fetcher, requestCh, deliveredCh := newTestFetcher(t)
+		tx, unrequested := hashedTx(0), hashedTx(1)
+
+		fetcher.notify(peer1, announced(tx))
+		fetcher.notify(peer2, announced(tx))
+
+		first := <-requestCh
+		first.respCh <- []*types.Transaction{unrequested}
+
+		second := <-requestCh
+		require.NotEqual(t, first.peerID, second.peerID)
+		require.Equal(t, []types.Hash{tx.Hash}, second.hashes)
+
+		second.respCh <- []*types.Transaction{tx}
+		require.Equal(t, tx, <-deliveredCh)
+		require.Empty(t, deliveredCh)
+	})
+
+	t.Run("give up after the timeout", func(t *testing.T) {
+		t.Parallel()
+
+		fetcher, requestCh, deliveredCh := newTestFetcher(t)
+		fetcher.timeout = 50 * time.Millisecond
+
+		tx := hashedTx(0)
+
+		fetcher.notify(peer1, announced(tx))
+		<-requestCh
+
+		require.Eventually(t, func() bool {
+			fetcher.Lock()
+			defer fetcher.Unlock()
+
+			return len(fetcher.announced) == 0 && len(fetcher.fetching) == 0 && len(fetcher.busy) == 0
+		}, time.Second, 10*time.Millisecond)
+		require.Empty(t, deliveredCh)
+	})
+
+	t.Run("cap the announcements per peer", func(t *testing.T) {
+		t.Parallel()
+
+		fetcher, _, _ := newTestFetcher(t)
+
+		txs := make([]*types.Transaction, maxAnnouncedTxsPerPeer+10)
+		for i := range txs {
+			txs[i] = hashedTx(uint64(i))
+		}
+
+		fetcher.notify(peer1, announced(txs...))
+		fetcher.notify(peer2, announced(txs[:10]...))
+
+		fetcher.Lock()
+		defer fetcher.Unlock()
+
+		require.Len(t, fetcher.announced, maxAnnouncedTxsPerPeer)
+		require.Equal(t, maxAnnouncedTxsPerPeer, fetcher.announcedByPeer[peer1])
+		require.Equal(t, 10, fetcher.announcedByPeer[peer2])
+	})
+
+	t.Run("penalize the peer which fails to deliver", func(t *testing.T) {
+		t.Parallel()
+
+		fetcher, requestCh, _ := newTestFetcher(t)
+		fetcher.timeout = 20 * time.Millisecond
+
+		for i := 0; i < maxFetchFailures; i++ {
+			fetcher.notify(peer1, announced(hashedTx(uint64(i))))
+			<-requestCh
+
+			require.Eventually(t, func() bool {
+				fetcher.Lock()
+				defer fetcher.Unlock()
+
+				return len(fetcher.busy) == 0
+			}, time.Second, 10*time.Millisecond)
+		}
+
+		// the announcements of the penalized peer are ignored
+		fetcher.notify(peer1, announced(hashedTx(10)))
+
+		fetcher.Lock()
+		defer fetcher.Unlock()
+
+		require.Contains(t, fetcher.penalized, peer1)
+		require.Empty(t, fetcher.announced)
+		require.Empty(t, fetcher.announcedByPeer)
+		require.Empty(t, requestCh)
+	})
+
+	t.Run("drop the tx not matching the announcement and penalize the peer", func(t *testing.T) {
+		t.Parallel()
+
+		fetcher, requestCh, deliveredCh := newTestFetcher(t)
+		tx := hashedTx(0)
+
+		// the peer announced a smaller transaction than it serves
+		understated := announced(tx)
+		understated[0].size--
+
+		fetcher.notify(peer1, understated)
+
+		req := <-requestCh
+		req.respCh <- []*types.Transaction{tx}
+
+		// the transaction is refetched from the other announcer
+		fetcher.notify(peer2, announced(tx))
+
+		req = <-requestCh
+		require.Equal(t, peer2, req.peerID)
+
+		req.respCh <- []*types.Transaction{tx}
+		require.Equal(t, tx, <-deliveredCh)
+		require.Empty(t, deliveredCh)
+
+		fetcher.Lock()
+		defer fetcher.Unlock()
+
+		require.Contains(t, fetcher.penalized, peer1)
+		require.NotContains(t, fetcher.penalized, peer2)
+	})
+}
+
+func TestTxAnnouncer_Flush(t *testing.T) {
+	t.Parallel()
+
+	supporting, legacy := peer.ID("supporting"), peer.ID("legacy")
+
+	tx := hashedTx(0)
+
+	network := &mockAnnounceNetwork{
+		protocols: map[peer.ID][]string{
+			supporting: {txAnnounceProto},
+			legacy:     {topicNameV1},
+		},
+		eventCh: make(chan *event.PeerEvent),
+	}
+
+	announcer := newTxAnnouncer(
+		hclog.NewNullLogger(),
+		network,
+		func(hash types.Hash) (*types.Transaction, bool) {
+			return tx, hash == tx.Hash
+		},
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	require.NoError(t, announcer.trackPeers(ctx))
+	require.ElementsMatch(t, []peer.ID{supporting}, announcer.announcedPeers())
+
+	announcer.announce(tx.Hash, types.StringToHash("0x1"))
+	announcer.flush()
+
+	// only the peers which negotiated the protocol receive the announcements
+	require.Empty(t, announcer.unknownTxs(supporting, []*types.Transaction{tx}))
+	require.Equal(t, []*types.Transaction{tx}, announcer.unknownTxs(legacy, []*types.Transaction{tx}))
+
+	// the disconnected peers are dropped along with the hashes known to them
+	network.eventCh <- &event.PeerEvent{PeerID: supporting, Type: event.PeerDisconnected}
+
+	require.Eventually(t, func() bool {
+		return len(announcer.announcedPeers()) == 0
+	}, time.Second, 10*time.Millisecond)
+
+	announcer.knownLock.Lock()
+	require.NotContains(t, announcer.known, supporting)
+	announcer.knownLock.Unlock()
+
+	// the peers are picked up once they connect
+	network.eventCh <- &event.PeerEvent{PeerID: supporting, Type: event.PeerConnected}
+
+	require.Eventually(t, func() bool {
+		return len(announcer.announcedPeers()) == 1
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestTxAnnounceService_GetTxns(t *testing.T) {
+	t.Parallel()
+
+	pool, err := newTestPool()
+	require.NoError(t, err)
+	pool.SetSigner(&mockSigner{})
+
+	pool.announcer = newTxAnnouncer(hclog.NewNullLogger(), &mockAnnounceNetwork{}, pool.index.get)
+
+	public, private := hashedTx(5), hashedTx(6)
+
+	pool.index.add(public)
+	pool.index.add(private)
+	pool.privateTxs.doneAdding(private.Hash, pool.privateTxs.startAdding(private.Hash, 10), true)
+
+	service := &txAnnounceService{pool: pool}
+	ctx := &grpc.Context{Context: context.Background(), PeerID: peer.ID("peer")}
+
+	resp, err := service.GetTxns(ctx, &proto.GetTxnsReq{
+		Hashes: [][]byte{public.Hash.Bytes(), private.Hash.Bytes(), types.StringToHash("0x1").Bytes()},
+	})
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{public.MarshalRLP()}, resp.Txs)
+
+	_, err = service.GetTxns(ctx, &proto.GetTxnsReq{Hashes: make([][]byte, maxFetchBatch+1)})
+	require.ErrorIs(t, err, ErrTooManyTxsRequested)
+}
+
+// newTestNetworkPool returns a started pool, connected to the others over the network
+func newTestNetworkPool(t *testing.T, sealing bool) (*TxPool, *network.Server) {
+	t.Helper()
+
+	srv, err := network.CreateServer(&network.CreateServerParams{
+		ConfigCallback: func(c *network.Config) {
+			c.NoDiscover = true
+		},
+	})
+	require.NoError(t, err)
+
+	pool, err := NewTxPool(
+		hclog.NewNullLogger(),
+		forks.At(0),
+		defaultMockStore{DefaultHeader: mockHeader},
+		nil,
+		srv,
+		&Config{
+			PriceLimit:          defaultPriceLimit,
+			MaxSlots:            defaultMaxSlots,
+			MaxAccountEnqueued:  defaultMaxAccountEnqueued,
+			DeploymentWhitelist: []types.Address{},
+		},
+	)
+	require.NoError(t, err)
+
+	pool.SetSigner(signerEIP155)
+	pool.SetSealing(sealing)
+	pool.Start()
+
+	t.Cleanup(func() {
+		pool.Close()
+		require.NoError(t, srv.Close())
+	})
+
+	return pool, srv
+}
+
+func TestTxAnnounce_MultiHop(t *testing.T) {
+	t.Parallel()
+
+	// the sentry and the relay don't seal, and only the relay is connected to the validator
+	sentry, sentrySrv := newTestNetworkPool(t, false)
+	relay, relaySrv := newTestNetworkPool(t, false)
+	validator, validatorSrv := newTestNetworkPool(t, true)
+
+	for _, pair := range [][2]*network.Server{{sentrySrv, relaySrv}, {relaySrv, validatorSrv}} {
+		require.NoError(t, network.JoinAndWait(pair[0], pair[1], network.DefaultBufferTimeout, network.DefaultJoinTimeout))
+	}
+
+	sender := new(eoa).create(t)
+	tx := sender.signTx(newTx(sender.Address, 0, 1), signerEIP155)
+
+	require.NoError(t, sentry.AddTx(tx))
+
+	// every peer of the sentry supports the announcements, but the relay doesn't pick up
+	// the transaction, so the validator receives it over the gossip topic
+	require.Eventually(t, func() bool {
+		if _, ok := validator.index.get(tx.Hash); ok {
+			return true
+		}
+
+		// the topic mesh may not be formed yet when the transaction is first published
+		sentry.publishTx(tx)
+
+		return false
+	}, 15*time.Second, 500*time.Millisecond)
+
+	_, ok := relay.index.get(tx.Hash)
+	require.False(t, ok)
+}
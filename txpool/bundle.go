@@ -0,0 +1,309 @@
+package txpool
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/armon/go-metrics"
+)
+
+const (
+	// maxBundleTxs is the max number of the transactions in a bundle
+	maxBundleTxs = 16
+
+	// maxBundles is the max number of the bundles in the pool
+	maxBundles = 1024
+
+	// maxBundlesPerSender is the max number of the bundles in the pool sent by the same account
+	// (the sender of the first bundle transaction)
+	maxBundlesPerSender = 16
+
+	// maxBundleBlockRange is the max number of blocks ahead of the head a bundle can target
+	maxBundleBlockRange = 100
+)
+
+var (
+	ErrEmptyBundle              = errors.New("bundle has no transactions")
+	ErrBundleTooLarge           = errors.New("bundle has too many transactions")
+	ErrInvalidBundleBlockRange  = errors.New("invalid bundle block range")
+	ErrBundleRevertingTxUnknown = errors.New("reverting transaction is not in the bundle")
+	ErrBundleAlreadyKnown       = errors.New("bundle already known")
+	ErrBundlePoolFull           = errors.New("bundle pool is full")
+	ErrBundleSenderLimit        = errors.New("too many bundles of the sender")
+	ErrBundleTxReverted         = errors.New("bundle transaction reverted")
+)
+
+// Bundle is an ordered group of transactions, which are included in a block
+// either all together at the top of the block, or not at all
+type Bundle struct {
+	// Hash is the hash of the concatenated hashes of the bundle's transactions
+	Hash types.Hash
+
+	// Txs are the transactions in the order they are included in
+	Txs []*types.Transaction
+
+	// MinBlock and MaxBlock are the range of the blocks the bundle can be included in
+	MinBlock uint64
+	MaxBlock uint64
+
+	// RevertingTxHashes are the transactions which are allowed to revert,
+	// the bundle is discarded if any other one reverts
+	RevertingTxHashes []types.Hash
+}
+
+// CanRevert checks if the bundle transaction is allowed to revert
+func (b *Bundle) CanRevert(hash types.Hash) bool {
+	for _, reverting := range b.RevertingTxHashes {
+		if reverting == hash {
+			return true
+		}
+	}
+
+	return false
+}
+
+// contains checks if the transaction is in the bundle
+func (b *Bundle) contains(hash types.Hash) bool {
+	for _, tx := range b.Txs {
+		if tx.Hash == hash {
+			return true
+		}
+	}
+
+	return false
+}
+
+// sender returns the account the bundle is accounted to, the sender of its first transaction
+func (b *Bundle) sender() types.Address {
+	return b.Txs[0].From
+}
+
+// targets checks if the bundle can be included in the block
+func (b *Bundle) targets(number uint64) bool {
+	return b.MinBlock <= number && number <= b.MaxBlock
+}
+
+// bundlePool keeps the bundles in the order they arrived in
+type bundlePool struct {
+	sync.RWMutex
+
+	bundles []*Bundle
+}
+
+func newBundlePool() *bundlePool {
+	return &bundlePool{
+		bundles: make([]*Bundle, 0),
+	}
+}
+
+func (p *bundlePool) add(bundle *Bundle) error {
+	p.Lock()
+	defer p.Unlock()
+
+	sent := 0
+
+	for _, known := range p.bundles {
+		if known.Hash == bundle.Hash {
+			return ErrBundleAlreadyKnown
+		}
+
+		if known.sender() == bundle.sender() {
+			sent++
+		}
+	}
+
+	if len(p.bundles) >= maxBundles {
+		return ErrBundlePoolFull
+	}
+
+	if sent >= maxBundlesPerSender {
+		return ErrBundleSenderLimit
+	}
+
+	p.bundles = append(p.bundles, bundle)
+
+	return nil
+}
+
+// forBlock returns the bundles which can be included in the block
+func (p *bundlePool) forBlock(number uint64) []*Bundle {
+	p.RLock()
+	defer p.RUnlock()
+
+	bundles := make([]*Bundle, 0)
+
+	for _, bundle := range p.bundles {
+		if bundle.targets(number) {
+			bundles = append(bundles, bundle)
+		}
+	}
+
+	return bundles
+}
+
+// remove removes the bundles the function reports for, and returns the number of the removed ones
+func (p *bundlePool) remove(drop func(*Bundle) bool) int {
+	p.Lock()
+	defer p.Unlock()
+
+	kept := p.bundles[:0]
+
+	for _, bundle := range p.bundles {
+		if !drop(bundle) {
+			kept = append(kept, bundle)
+		}
+	}
+
+	removed := len(p.bundles) - len(kept)
+
+	// release the references to the removed bundles
+	for i := len(kept); i < len(p.bundles); i++ {
+		p.bundles[i] = nil
+	}
+
+	p.bundles = kept
+
+	return removed
+}
+
+// length returns the number of the bundles in the pool
+func (p *bundlePool) length() int {
+	p.RLock()
+	defer p.RUnlock()
+
+	return len(p.bundles)
+}
+
+// AddBundle adds a new bundle of the transactions (sent from json-RPC endpoint), which are included
+// atomically at the top of a block within the range. The range starts at the next block if the min block
+// is zero, and ends at the min block if the max block is zero. Returns the hash of the bundle.
+// The bundles are kept locally (they are not gossiped), so they are only included in the blocks
+// this node proposes.
+func (p *TxPool) AddBundle(
+	txs []*types.Transaction,
+	minBlock, maxBlock uint64,
+	revertingTxHashes []types.Hash,
+) (types.Hash, error) {
+	bundle, err := p.newBundle(txs, minBlock, maxBlock, revertingTxHashes)
+	if err != nil {
+		p.logger.Error("failed to add bundle", "err", err)
+
+		return types.ZeroHash, err
+	}
+
+	if err := p.bundles.add(bundle); err != nil {
+		p.logger.Error("failed to add bundle", "err", err, "hash", bundle.Hash.String())
+
+		return types.ZeroHash, err
+	}
+
+	p.logger.Debug("add bundle",
+		"hash", bundle.Hash.String(),
+		"txs", len(bundle.Txs),
+		"min_block", bundle.MinBlock,
+		"max_block", bundle.MaxBlock,
+	)
+
+	metrics.SetGauge([]string{txPoolMetrics, "bundles"}, float32(p.bundles.length()))
+
+	return bundle.Hash, nil
+}
+
+// newBundle validates the bundle transactions (as the single transactions are validated
+// against the latest state) and its block range
+func (p *TxPool) newBundle(
+	txs []*types.Transaction,
+	minBlock, maxBlock uint64,
+	revertingTxHashes []types.Hash,
+) (*Bundle, error) {
+	if len(txs) == 0 {
+		return nil, ErrEmptyBundle
+	}
+
+	if len(txs) > maxBundleTxs {
+		return nil, ErrBundleTooLarge
+	}
+
+	head := p.store.Header().Number
+
+	if minBlock == 0 {
+		minBlock = head + 1
+	}
+
+	if maxBlock == 0 {
+		maxBlock = minBlock
+	}
+
+	if minBlock > maxBlock || maxBlock <= head || maxBlock > head+maxBundleBlockRange {
+		return nil, fmt.Errorf("%w: [%d, %d] at head %d", ErrInvalidBundleBlockRange, minBlock, maxBlock, head)
+	}
+
+	hashes := make([]byte, 0, len(txs)*types.HashLength)
+
+	for _, tx := range txs {
+		if err := p.validateTx(tx); err != nil {
+			return nil, err
+		}
+
+		tx.ComputeHash()
+
+		hashes = append(hashes, tx.Hash.Bytes()...)
+	}
+
+	bundle := &Bundle{
+		Hash:              types.BytesToHash(crypto.Keccak256(hashes)),
+		Txs:               txs,
+		MinBlock:          minBlock,
+		MaxBlock:          maxBlock,
+		RevertingTxHashes: revertingTxHashes,
+	}
+
+	for _, hash := range revertingTxHashes {
+		if !bundle.contains(hash) {
+			return nil, fmt.Errorf("%w: %s", ErrBundleRevertingTxUnknown, hash)
+		}
+	}
+
+	return bundle, nil
+}
+
+// Bundles returns the bundles which can be included in the block, in the order they arrived in
+func (p *TxPool) Bundles(number uint64) []*Bundle {
+	return p.bundles.forBlock(number)
+}
+
+// DiscardBundle removes the bundle which failed to be included in a block
+func (p *TxPool) DiscardBundle(hash types.Hash) {
+	p.bundles.remove(func(bundle *Bundle) bool {
+		return bundle.Hash == hash
+	})
+
+	metrics.SetGauge([]string{txPoolMetrics, "bundles"}, float32(p.bundles.length()))
+}
+
+// pruneBundles removes the bundles which can't be included after the given head anymore,
+// and the ones already included (any of their transactions was mined)
+func (p *TxPool) pruneBundles(head uint64, mined map[types.Hash]struct{}) {
+	removed := p.bundles.remove(func(bundle *Bundle) bool {
+		if bundle.MaxBlock <= head {
+			return true
+		}
+
+		for _, tx := range bundle.Txs {
+			if _, ok := mined[tx.Hash]; ok {
+				return true
+			}
+		}
+
+		return false
+	})
+
+	if removed > 0 {
+		p.logger.Debug("pruned bundles", "num", removed)
+
+		metrics.SetGauge([]string{txPoolMetrics, "bundles"}, float32(p.bundles.length()))
+	}
+}
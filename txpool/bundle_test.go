@@ -0,0 +1,136 @@
+package txpool
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddBundle(t *testing.T) {
+	t.Parallel()
+
+	newBundleTxs := func() []*types.Transaction {
+		return []*types.Transaction{newTx(addr1, 0, 1), newTx(addr2, 0, 1)}
+	}
+
+	t.Run("default block range", func(t *testing.T) {
+		t.Parallel()
+
+		pool, err := newTestPool()
+		require.NoError(t, err)
+		pool.SetSigner(&mockSigner{})
+
+		txs := newBundleTxs()
+
+		hash, err := pool.AddBundle(txs, 0, 0, nil)
+		require.NoError(t, err)
+
+		bundles := pool.Bundles(mockHeader.Number + 1)
+		require.Len(t, bundles, 1)
+		assert.Equal(t, hash, bundles[0].Hash)
+		assert.Equal(t, txs, bundles[0].Txs)
+		assert.Empty(t, pool.Bundles(mockHeader.Number+2))
+
+		// the bundles are not added to the pool of the single transactions
+		assert.Equal(t, uint64(0), pool.gauge.read())
+
+		_, err = pool.AddBundle(txs, 0, 0, nil)
+		assert.ErrorIs(t, err, ErrBundleAlreadyKnown)
+	})
+
+	t.Run("invalid bundles", func(t *testing.T) {
+		t.Parallel()
+
+		pool, err := newTestPool()
+		require.NoError(t, err)
+		pool.SetSigner(&mockSigner{})
+
+		_, err = pool.AddBundle(nil, 0, 0, nil)
+		assert.ErrorIs(t, err, ErrEmptyBundle)
+
+		_, err = pool.AddBundle(make([]*types.Transaction, maxBundleTxs+1), 0, 0, nil)
+		assert.ErrorIs(t, err, ErrBundleTooLarge)
+
+		_, err = pool.AddBundle(newBundleTxs(), 5, 3, nil)
+		assert.ErrorIs(t, err, ErrInvalidBundleBlockRange)
+
+		_, err = pool.AddBundle(newBundleTxs(), 0, mockHeader.Number+maxBundleBlockRange+1, nil)
+		assert.ErrorIs(t, err, ErrInvalidBundleBlockRange)
+
+		_, err = pool.AddBundle(newBundleTxs(), 0, 0, []types.Hash{types.StringToHash("0x1")})
+		assert.ErrorIs(t, err, ErrBundleRevertingTxUnknown)
+
+		// the bundle transactions are validated as the single ones
+		underpriced := newBundleTxs()
+		underpriced[1].GasPrice = big.NewInt(0)
+
+		_, err = pool.AddBundle(underpriced, 0, 0, nil)
+		assert.ErrorIs(t, err, ErrUnderpriced)
+
+		outOfGas := newBundleTxs()
+		outOfGas[0].Gas = 1
+
+		_, err = pool.AddBundle(outOfGas, 0, 0, nil)
+		assert.ErrorIs(t, err, ErrIntrinsicGas)
+
+		assert.Empty(t, pool.Bundles(mockHeader.Number+1))
+	})
+
+	t.Run("cap the bundles per sender", func(t *testing.T) {
+		t.Parallel()
+
+		pool, err := newTestPool()
+		require.NoError(t, err)
+		pool.SetSigner(&mockSigner{})
+
+		for nonce := uint64(0); nonce < maxBundlesPerSender; nonce++ {
+			_, err := pool.AddBundle([]*types.Transaction{newTx(addr1, nonce, 1)}, 0, 0, nil)
+			require.NoError(t, err)
+		}
+
+		_, err = pool.AddBundle([]*types.Transaction{newTx(addr1, maxBundlesPerSender, 1)}, 0, 0, nil)
+		assert.ErrorIs(t, err, ErrBundleSenderLimit)
+
+		// the other senders are not affected
+		_, err = pool.AddBundle([]*types.Transaction{newTx(addr2, 0, 1), newTx(addr1, 0, 1)}, 0, 0, nil)
+		assert.NoError(t, err)
+
+		assert.Len(t, pool.Bundles(mockHeader.Number+1), maxBundlesPerSender+1)
+	})
+}
+
+func TestPruneBundles(t *testing.T) {
+	t.Parallel()
+
+	pool, err := newTestPool()
+	require.NoError(t, err)
+	pool.SetSigner(&mockSigner{})
+
+	mined, expiring, pending, discarded :=
+		[]*types.Transaction{newTx(addr1, 0, 1)},
+		[]*types.Transaction{newTx(addr2, 0, 1)},
+		[]*types.Transaction{newTx(addr3, 0, 1)},
+		[]*types.Transaction{newTx(addr4, 0, 1)}
+
+	_, err = pool.AddBundle(mined, 1, 10, nil)
+	require.NoError(t, err)
+
+	_, err = pool.AddBundle(expiring, 1, 2, nil)
+	require.NoError(t, err)
+
+	pendingHash, err := pool.AddBundle(pending, 1, 10, nil)
+	require.NoError(t, err)
+
+	discardedHash, err := pool.AddBundle(discarded, 1, 10, nil)
+	require.NoError(t, err)
+
+	pool.DiscardBundle(discardedHash)
+	pool.pruneBundles(2, map[types.Hash]struct{}{mined[0].Hash: {}})
+
+	bundles := pool.Bundles(3)
+	require.Len(t, bundles, 1)
+	assert.Equal(t, pendingHash, bundles[0].Hash)
+}
@@ -33,6 +33,15 @@ type subscribeResult struct {
 
 // subscribe registers a new listener for TxPool events
 func (em *eventManager) subscribe(eventTypes []proto.EventType) *subscribeResult {
+	return em.subscribeExcluding(eventTypes, nil)
+}
+
+// subscribeExcluding registers a new listener for TxPool events,
+// which doesn't receive the events of the transactions the excluded func returns true for
+func (em *eventManager) subscribeExcluding(
+	eventTypes []proto.EventType,
+	excluded func(hash types.Hash) bool,
+) *subscribeResult {
 	em.subscriptionsLock.Lock()
 	defer em.subscriptionsLock.Unlock()
 
@@ -45,6 +54,7 @@ func (em *eventManager) subscribe(eventTypes []proto.EventType) *subscribeResult
 		eventStore: &eventQueue{
 			events: make([]*proto.TxPoolEvent, 0),
 		},
+		excluded: excluded,
 	}
 
 	em.subscriptions[subscriptionID(id)] = subscription
@@ -87,6 +97,11 @@ func (em *eventManager) Close() {
 
 // signalEvent is a helper method for alerting listeners of a new TxPool event
 func (em *eventManager) signalEvent(eventType proto.EventType, txHashes ...types.Hash) {
+	em.signalEventWithReason(eventType, "", txHashes...)
+}
+
+// signalEventWithReason alerts listeners of a new TxPool event which happened for the given reason
+func (em *eventManager) signalEventWithReason(eventType proto.EventType, reason string, txHashes ...types.Hash) {
 	if atomic.LoadInt64(&em.numSubscriptions) < 1 {
 		// No reason to lock the subscriptions map
 		// if no subscriptions exist
@@ -98,9 +113,14 @@ func (em *eventManager) signalEvent(eventType proto.EventType, txHashes ...types
 
 	for _, txHash := range txHashes {
 		for _, subscription := range em.subscriptions {
+			if subscription.eventExcluded(txHash) {
+				continue
+			}
+
 			subscription.pushEvent(&proto.TxPoolEvent{
 				Type:   eventType,
 				TxHash: txHash.String(),
+				Reason: reason,
 			})
 		}
 	}
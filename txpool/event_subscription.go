@@ -2,6 +2,7 @@ package txpool
 
 import (
 	"github.com/0xPolygon/polygon-edge/txpool/proto"
+	"github.com/0xPolygon/polygon-edge/types"
 )
 
 type subscriptionID int32
@@ -22,6 +23,14 @@ type eventSubscription struct {
 	// eventStore is used for temporary concurrent event storage,
 	// required in order to preserve the chronological order of events
 	eventStore *eventQueue
+
+	// excluded checks if the events of the transaction are hidden from the subscriber, nil if none are
+	excluded func(hash types.Hash) bool
+}
+
+// eventExcluded checks if the events of the transaction are hidden from the subscriber
+func (es *eventSubscription) eventExcluded(hash types.Hash) bool {
+	return es.excluded != nil && es.excluded(hash)
 }
 
 // eventSupported checks if the event is supported by the subscription
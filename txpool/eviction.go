@@ -0,0 +1,215 @@
+package txpool
+
+import (
+	"sort"
+
+	"github.com/0xPolygon/polygon-edge/txpool/proto"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/armon/go-metrics"
+)
+
+// evictionCandidate is a remote transaction which can be evicted
+// to make room for a better priced incoming transaction
+type evictionCandidate struct {
+	account  *account
+	tx       *types.Transaction
+	promoted bool
+}
+
+// less orders the candidates by eviction preference:
+// enqueued before promoted, cheaper before more expensive
+func (c *evictionCandidate) less(other *evictionCandidate) bool {
+	if c.promoted != other.promoted {
+		return !c.promoted
+	}
+
+	return c.tx.GasPrice.Cmp(other.tx.GasPrice) < 0
+}
+
+// evictUnderpriced makes room for the given transaction in the full pool
+// by evicting the globally cheapest remote transactions. Each account's transactions
+// are evicted from its highest nonce down, so that no nonce gaps are left in the promoted queues.
+// The slots freed by the transaction the given one replaces, if any, are accounted for.
+// Every evicted transaction must be outbid by the given one by the price bump,
+// otherwise nothing is evicted and ErrTxPoolOverflow is returned.
+func (p *TxPool) evictUnderpriced(tx *types.Transaction, freed uint64) error {
+	needed := p.gauge.read() + slotsRequired(tx)
+	if needed <= p.gauge.max+freed {
+		return nil
+	}
+
+	needed -= p.gauge.max + freed
+
+	plan := p.evictionPlan(tx, needed)
+	if plan == nil {
+		return ErrTxPoolOverflow
+	}
+
+	for _, candidate := range plan {
+		p.evict(candidate)
+	}
+
+	// the plan could have been invalidated by the concurrent pool updates
+	if p.gauge.read()+slotsRequired(tx) > p.gauge.max+freed {
+		return ErrTxPoolOverflow
+	}
+
+	return nil
+}
+
+// evictionPlan selects the transactions to evict in order to free the needed slots.
+// Returns nil if the needed slots can't be freed by evicting the transactions outbid by the given one.
+func (p *TxPool) evictionPlan(tx *types.Transaction, needed uint64) []*evictionCandidate {
+	// eviction candidates of each account in the order they can be evicted in
+	accountCandidates := make([][]*evictionCandidate, 0)
+
+	p.accounts.Range(func(key, value interface{}) bool {
+		addr, _ := key.(types.Address)
+		if addr == tx.From {
+			return true
+		}
+
+		if _, ok := p.locals.Load(addr); ok {
+			return true
+		}
+
+		account, _ := value.(*account)
+		if candidates := account.evictionCandidates(); len(candidates) > 0 {
+			accountCandidates = append(accountCandidates, candidates)
+		}
+
+		return true
+	})
+
+	var (
+		plan  = make([]*evictionCandidate, 0)
+		freed = uint64(0)
+	)
+
+	for freed < needed {
+		// pick the most preferred tail among the accounts
+		best := -1
+
+		for i, candidates := range accountCandidates {
+			if len(candidates) == 0 {
+				continue
+			}
+
+			if best == -1 || candidates[0].less(accountCandidates[best][0]) {
+				best = i
+			}
+		}
+
+		if best == -1 {
+			return nil
+		}
+
+		candidate := accountCandidates[best][0]
+		accountCandidates[best] = accountCandidates[best][1:]
+
+		if !p.exceedsPriceBump(tx, candidate.tx) {
+			return nil
+		}
+
+		plan = append(plan, candidate)
+		freed += slotsRequired(candidate.tx)
+	}
+
+	return plan
+}
+
+// evict removes the candidate transaction from the pool,
+// if it is still the tail of its account's queue
+func (p *TxPool) evict(candidate *evictionCandidate) {
+	account := candidate.account
+
+	account.promoted.lock(true)
+	account.enqueued.lock(true)
+
+	defer func() {
+		account.enqueued.unlock()
+		account.promoted.unlock()
+	}()
+
+	event := proto.EventType_PRUNED_ENQUEUED
+
+	if candidate.promoted {
+		// only the promoted tail is evicted, so that the promoted txs stay executable
+		if account.enqueued.length() != 0 || account.getNonce() != candidate.tx.Nonce+1 {
+			return
+		}
+
+		if !account.promoted.remove(candidate.tx) {
+			return
+		}
+
+		account.setNonce(candidate.tx.Nonce)
+		p.executables.remove(candidate.tx)
+		p.updatePending(-1)
+
+		event = proto.EventType_PRUNED_PROMOTED
+	} else if !account.enqueued.remove(candidate.tx) {
+		return
+	}
+
+	p.index.remove(candidate.tx)
+	p.gauge.decrease(slotsRequired(candidate.tx))
+
+	p.eventManager.signalEvent(event, candidate.tx.Hash)
+
+	metrics.IncrCounterWithLabels(
+		[]string{txPoolMetrics, "evicted_transactions"},
+		1,
+		[]metrics.Label{
+			{Name: "queue", Value: evictedQueueLabel(candidate.promoted)},
+		},
+	)
+
+	p.logger.Debug("evicted underpriced tx",
+		"hash", candidate.tx.Hash.String(),
+		"from", candidate.tx.From.String(),
+		"nonce", candidate.tx.Nonce,
+		"gas_price", candidate.tx.GasPrice.String(),
+	)
+}
+
+func evictedQueueLabel(promoted bool) string {
+	if promoted {
+		return "promoted"
+	}
+
+	return "enqueued"
+}
+
+// evictionCandidates returns the account's transactions in the order they can be evicted in:
+// the enqueued ones first, then the promoted ones, each from the highest nonce down
+func (a *account) evictionCandidates() []*evictionCandidate {
+	a.promoted.lock(false)
+	a.enqueued.lock(false)
+
+	defer func() {
+		a.enqueued.unlock()
+		a.promoted.unlock()
+	}()
+
+	candidates := make([]*evictionCandidate, 0, a.enqueued.length()+a.promoted.length())
+
+	for _, queue := range []*accountQueue{a.enqueued, a.promoted} {
+		txs := make([]*types.Transaction, len(queue.queue))
+		copy(txs, queue.queue)
+
+		sort.Slice(txs, func(i, j int) bool {
+			return txs[i].Nonce > txs[j].Nonce
+		})
+
+		for _, tx := range txs {
+			candidates = append(candidates, &evictionCandidate{
+				account:  a,
+				tx:       tx,
+				promoted: queue == a.promoted,
+			})
+		}
+	}
+
+	return candidates
+}
@@ -0,0 +1,198 @@
+package txpool
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvictUnderpriced_SpamResistance(t *testing.T) {
+	t.Parallel()
+
+	withGasPrice := func(tx *types.Transaction, gasPrice uint64) *types.Transaction {
+		tx.GasPrice = new(big.Int).SetUint64(gasPrice)
+
+		return tx
+	}
+
+	pool, err := newTestPoolWithSlots(10)
+	require.NoError(t, err)
+	pool.SetSigner(&mockSigner{})
+
+	pool.priceBump = 10
+
+	pool.Start()
+	defer pool.Close()
+
+	waitForGauge := func(slots uint64) {
+		t.Helper()
+
+		require.Eventually(t, func() bool {
+			return pool.gauge.read() == slots
+		}, 5*time.Second, 10*time.Millisecond)
+	}
+
+	// the spammers fill the pool with the minimum price txs
+	for i := uint64(0); i < 10; i++ {
+		addr, nonce := addr1, i
+		if i >= 6 {
+			addr, nonce = addr2, i-6
+		}
+
+		require.NoError(t, pool.addTx(gossip, newTx(addr, nonce, 1)))
+		waitForGauge(i + 1)
+	}
+
+	// a tx which doesn't outbid the spam by the price bump is still rejected
+	require.ErrorIs(t, pool.addTx(gossip, withGasPrice(newTx(addr3, 0, 1), 1)), ErrTxPoolOverflow)
+
+	// a well paying tx evicts the spam
+	require.NoError(t, pool.addTx(gossip, withGasPrice(newTx(addr3, 0, 1), 100)))
+	waitForGauge(10)
+
+	require.Equal(t, uint64(1), pool.accounts.get(addr3).promoted.length())
+
+	require.NoError(t, pool.addTx(gossip, withGasPrice(newTx(addr3, 1, 3), 100)))
+	waitForGauge(10)
+
+	require.Equal(t, uint64(6), pool.accounts.get(addr1).promoted.length()+pool.accounts.get(addr2).promoted.length())
+	require.Equal(t, uint64(2), pool.accounts.get(addr3).promoted.length())
+	require.Len(t, pool.index.all, 8)
+
+	// the promoted queues are left without nonce gaps
+	for _, addr := range []types.Address{addr1, addr2} {
+		account := pool.accounts.get(addr)
+		require.Equal(t, account.promoted.length(), account.getNonce())
+	}
+}
+
+func TestEvictionPlan_PrefersEnqueued(t *testing.T) {
+	t.Parallel()
+
+	pool, err := newTestPool()
+	require.NoError(t, err)
+
+	withGasPrice := func(tx *types.Transaction, gasPrice uint64) *types.Transaction {
+		tx.GasPrice = new(big.Int).SetUint64(gasPrice)
+
+		return tx
+	}
+
+	var (
+		promoted1 = withGasPrice(newTx(addr1, 0, 1), 1)
+		promoted2 = withGasPrice(newTx(addr1, 1, 1), 2)
+		enqueued  = withGasPrice(newTx(addr1, 5, 1), 50)
+		promoted3 = withGasPrice(newTx(addr2, 0, 1), 1)
+	)
+
+	account1 := pool.accounts.initOnce(addr1, 2)
+	account1.promoted.push(promoted1)
+	account1.promoted.push(promoted2)
+	account1.enqueued.push(enqueued)
+
+	account2 := pool.accounts.initOnce(addr2, 1)
+	account2.promoted.push(promoted3)
+
+	planned := func(plan []*evictionCandidate) []*types.Transaction {
+		txs := make([]*types.Transaction, len(plan))
+		for i, candidate := range plan {
+			txs[i] = candidate.tx
+		}
+
+		return txs
+	}
+
+	incoming := withGasPrice(newTx(addr3, 0, 1), 100)
+
+	// the enqueued tx goes first, then the cheapest promoted tails
+	require.Equal(t, []*types.Transaction{enqueued}, planned(pool.evictionPlan(incoming, 1)))
+	require.Equal(t,
+		[]*types.Transaction{enqueued, promoted3, promoted2},
+		planned(pool.evictionPlan(incoming, 3)),
+	)
+
+	// not enough txs to evict
+	require.Nil(t, pool.evictionPlan(incoming, 5))
+
+	// the incoming tx doesn't outbid the enqueued one
+	require.Nil(t, pool.evictionPlan(withGasPrice(newTx(addr3, 0, 1), 40), 1))
+
+	// the incoming tx doesn't evict its sender's txs
+	require.Equal(t,
+		[]*types.Transaction{promoted3},
+		planned(pool.evictionPlan(withGasPrice(newTx(addr1, 2, 1), 100), 1)),
+	)
+}
+
+func TestEvictUnderpriced_KeepsLocalTransactions(t *testing.T) {
+	t.Parallel()
+
+	pool, err := newTestPoolWithSlots(2)
+	require.NoError(t, err)
+	pool.SetSigner(&mockSigner{})
+
+	pool.Start()
+	defer pool.Close()
+
+	require.NoError(t, pool.AddTx(newTx(addr1, 0, 1)))
+	require.NoError(t, pool.AddTx(newTx(addr1, 1, 1)))
+
+	require.Eventually(t, func() bool {
+		return pool.gauge.read() == 2
+	}, 5*time.Second, 10*time.Millisecond)
+
+	tx := newTx(addr2, 0, 1)
+	tx.GasPrice = big.NewInt(100)
+
+	require.ErrorIs(t, pool.addTx(gossip, tx), ErrTxPoolOverflow)
+	require.Equal(t, uint64(2), pool.accounts.get(addr1).promoted.length())
+}
+
+func TestReplaceTx_FullPool(t *testing.T) {
+	t.Parallel()
+
+	pool, err := newTestPoolWithSlots(4)
+	require.NoError(t, err)
+	pool.SetSigner(&mockSigner{})
+
+	pool.priceBump = 10
+
+	pool.Start()
+	defer pool.Close()
+
+	waitForGauge := func(slots uint64) {
+		t.Helper()
+
+		require.Eventually(t, func() bool {
+			return pool.gauge.read() == slots
+		}, 5*time.Second, 10*time.Millisecond)
+	}
+
+	withGasPrice := func(tx *types.Transaction, gasPrice uint64) *types.Transaction {
+		tx.GasPrice = new(big.Int).SetUint64(gasPrice)
+
+		return tx
+	}
+
+	require.NoError(t, pool.AddTx(withGasPrice(newTx(addr1, 0, 1), 10)))
+	require.NoError(t, pool.addTx(gossip, withGasPrice(newTx(addr2, 0, 1), 100)))
+	require.NoError(t, pool.addTx(gossip, withGasPrice(newTx(addr2, 1, 2), 100)))
+	waitForGauge(4)
+
+	// the replacement doesn't fit the full pool, unless the cheaper remote txs are evicted
+	require.ErrorIs(t, pool.addTx(local, withGasPrice(newTx(addr1, 0, 2), 20)), ErrTxPoolOverflow)
+	require.Equal(t, uint64(4), pool.gauge.read())
+
+	replacement := withGasPrice(newTx(addr1, 0, 2), 200)
+
+	// the highest nonce remote tx is evicted to free the missing slot
+	require.NoError(t, pool.addTx(local, replacement))
+	require.Equal(t, uint64(3), pool.gauge.read())
+
+	_, ok := pool.index.get(replacement.Hash)
+	require.True(t, ok)
+	require.Equal(t, uint64(1), pool.accounts.get(addr2).promoted.length())
+}
@@ -0,0 +1,188 @@
+package txpool
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+var (
+	errNoActiveJournal = errors.New("no active journal")
+)
+
+// journal is an append-only file of the local transactions,
+// which is replayed into the pool on startup so that they survive node restarts.
+// Each entry is the RLP encoded transaction prefixed with its length.
+type journal struct {
+	path string
+
+	lock   sync.Mutex
+	writer *os.File
+}
+
+func newJournal(path string) *journal {
+	return &journal{
+		path: path,
+	}
+}
+
+// load reads the transactions from the journal and passes them to the add callback.
+// A truncated trailing entry (e.g. left by a crash) is skipped.
+// Returns the number of the loaded and dropped transactions.
+func (j *journal) load(add func(*types.Transaction) error) (int, int, error) {
+	file, err := os.Open(j.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, 0, nil
+	}
+
+	if err != nil {
+		return 0, 0, err
+	}
+
+	defer file.Close()
+
+	var (
+		reader  = bufio.NewReader(file)
+		total   = 0
+		dropped = 0
+	)
+
+	for {
+		tx, err := readJournalEntry(reader)
+		if errors.Is(err, io.EOF) {
+			return total, dropped, nil
+		}
+
+		if err != nil {
+			return total, dropped, err
+		}
+
+		total++
+
+		if err := add(tx); err != nil {
+			dropped++
+		}
+	}
+}
+
+// insert appends the transaction to the journal
+func (j *journal) insert(tx *types.Transaction) error {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	if j.writer == nil {
+		return errNoActiveJournal
+	}
+
+	return writeJournalEntry(j.writer, tx)
+}
+
+// rotate regenerates the journal out of the given transactions,
+// dropping the entries of the transactions which are no longer in the pool
+func (j *journal) rotate(txs []*types.Transaction) error {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	if j.writer != nil {
+		if err := j.writer.Close(); err != nil {
+			return err
+		}
+
+		j.writer = nil
+	}
+
+	replacement, err := os.OpenFile(j.path+".new", os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+
+	for _, tx := range txs {
+		if err := writeJournalEntry(replacement, tx); err != nil {
+			replacement.Close()
+
+			return err
+		}
+	}
+
+	if err := replacement.Close(); err != nil {
+		return err
+	}
+
+	if err := os.Rename(j.path+".new", j.path); err != nil {
+		return err
+	}
+
+	writer, err := os.OpenFile(j.path, os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return err
+	}
+
+	j.writer = writer
+
+	return nil
+}
+
+// close flushes the journal and closes the underlying file
+func (j *journal) close() error {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	if j.writer == nil {
+		return nil
+	}
+
+	err := j.writer.Close()
+	j.writer = nil
+
+	return err
+}
+
+func writeJournalEntry(w io.Writer, tx *types.Transaction) error {
+	raw := tx.MarshalRLP()
+
+	entry := make([]byte, 4, 4+len(raw))
+	binary.BigEndian.PutUint32(entry, uint32(len(raw)))
+	entry = append(entry, raw...)
+
+	_, err := w.Write(entry)
+
+	return err
+}
+
+func readJournalEntry(r io.Reader) (*types.Transaction, error) {
+	var size [4]byte
+	if _, err := io.ReadFull(r, size[:]); err != nil {
+		if errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil, io.EOF
+		}
+
+		return nil, err
+	}
+
+	length := binary.BigEndian.Uint32(size[:])
+	if length > txMaxSize {
+		return nil, fmt.Errorf("journal entry of %d bytes exceeds the max transaction size", length)
+	}
+
+	raw := make([]byte, length)
+	if _, err := io.ReadFull(r, raw); err != nil {
+		if errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil, io.EOF
+		}
+
+		return nil, err
+	}
+
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalRLP(raw); err != nil {
+		return nil, fmt.Errorf("invalid journal entry: %w", err)
+	}
+
+	return tx, nil
+}
@@ -0,0 +1,143 @@
+package txpool
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/txpool/proto"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJournal_RotateAndLoad(t *testing.T) {
+	t.Parallel()
+
+	j := newJournal(filepath.Join(t.TempDir(), "transactions.rlp"))
+
+	// nothing to load and no active journal before the first rotation
+	loaded, dropped, err := j.load(func(*types.Transaction) error { return nil })
+	require.NoError(t, err)
+	require.Zero(t, loaded)
+	require.Zero(t, dropped)
+
+	require.ErrorIs(t, j.insert(newTx(addr1, 0, 1)), errNoActiveJournal)
+
+	require.NoError(t, j.rotate([]*types.Transaction{newTx(addr1, 0, 1)}))
+	require.NoError(t, j.insert(newTx(addr1, 1, 2)))
+	require.NoError(t, j.insert(newTx(addr2, 0, 1)))
+	require.NoError(t, j.close())
+
+	// the sender is not part of the encoding, so the entries are told apart by their order
+	nonces := make([]uint64, 0)
+
+	loaded, dropped, err = j.load(func(tx *types.Transaction) error {
+		nonces = append(nonces, tx.Nonce)
+
+		if len(nonces) == 3 {
+			return ErrNonceTooLow
+		}
+
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, loaded)
+	require.Equal(t, 1, dropped)
+	require.Equal(t, []uint64{0, 1, 0}, nonces)
+
+	// rotation drops the entries which are not passed in
+	require.NoError(t, j.rotate([]*types.Transaction{newTx(addr1, 1, 1)}))
+	require.NoError(t, j.close())
+
+	loaded, _, err = j.load(func(*types.Transaction) error { return nil })
+	require.NoError(t, err)
+	require.Equal(t, 1, loaded)
+}
+
+func TestJournal_TruncatedEntry(t *testing.T) {
+	t.Parallel()
+
+	j := newJournal(filepath.Join(t.TempDir(), "transactions.rlp"))
+
+	require.NoError(t, j.rotate([]*types.Transaction{newTx(addr1, 0, 1), newTx(addr1, 1, 1)}))
+	require.NoError(t, j.close())
+
+	info, err := os.Stat(j.path)
+	require.NoError(t, err)
+
+	// cut the last entry in half, as if the node crashed while writing it
+	require.NoError(t, os.Truncate(j.path, info.Size()-10))
+
+	loaded, _, err := j.load(func(*types.Transaction) error { return nil })
+	require.NoError(t, err)
+	require.Equal(t, 1, loaded)
+}
+
+func TestTxPool_JournalLocalTransactions(t *testing.T) {
+	t.Parallel()
+
+	var (
+		journalPath = filepath.Join(t.TempDir(), "transactions.rlp")
+		localEOA    = new(eoa).create(t)
+		remoteEOA   = new(eoa).create(t)
+	)
+
+	newPool := func() *TxPool {
+		t.Helper()
+
+		pool, err := NewTxPool(
+			hclog.NewNullLogger(),
+			chain.AllForksEnabled.At(0),
+			defaultMockStore{DefaultHeader: mockHeader},
+			nil,
+			nil,
+			&Config{
+				PriceLimit:         defaultPriceLimit,
+				MaxSlots:           defaultMaxSlots,
+				MaxAccountEnqueued: defaultMaxAccountEnqueued,
+				JournalPath:        journalPath,
+			},
+		)
+		require.NoError(t, err)
+
+		pool.SetSigner(signerEIP155)
+
+		return pool
+	}
+
+	pool := newPool()
+	pool.Start()
+
+	subscription := pool.eventManager.subscribe([]proto.EventType{proto.EventType_PROMOTED})
+
+	localTx := localEOA.signTx(newTx(localEOA.Address, 0, 1), signerEIP155)
+
+	require.NoError(t, pool.AddTx(localTx))
+	require.NoError(t, pool.addTx(gossip, remoteEOA.signTx(newTx(remoteEOA.Address, 0, 1), signerEIP155)))
+
+	ctx, cancelFn := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancelFn()
+
+	require.Len(t, waitForEvents(ctx, subscription, 2), 2)
+	pool.Close()
+
+	// only the local transaction survives the restart
+	pool = newPool()
+	pool.Start()
+
+	defer pool.Close()
+
+	require.Eventually(t, func() bool {
+		account := pool.accounts.get(localEOA.Address)
+
+		return account != nil && account.promoted.length() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	_, ok := pool.index.get(localTx.Hash)
+	require.True(t, ok)
+	require.Nil(t, pool.accounts.get(remoteEOA.Address))
+}
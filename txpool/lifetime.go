@@ -0,0 +1,84 @@
+package txpool
+
+import (
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/txpool/proto"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/armon/go-metrics"
+)
+
+const (
+	// maxLifetimeSweepInterval is the max interval of the expired transactions sweep
+	maxLifetimeSweepInterval = time.Minute
+
+	// dropReasonExpired is the reason of the DROPPED events of the expired transactions
+	dropReasonExpired = "expired"
+)
+
+// lifetimeSweepInterval returns the interval the expired transactions are swept at
+func (p *TxPool) lifetimeSweepInterval() time.Duration {
+	if p.lifetime < maxLifetimeSweepInterval {
+		return p.lifetime
+	}
+
+	return maxLifetimeSweepInterval
+}
+
+// expireTransactions drops the transactions which have been in the pool for longer than the configured lifetime
+func (p *TxPool) expireTransactions() {
+	cutoff := time.Now().Add(-p.lifetime)
+
+	p.accounts.Range(func(key, value interface{}) bool {
+		addr, _ := key.(types.Address)
+
+		if !p.lifetimeLocals {
+			if _, ok := p.locals.Load(addr); ok {
+				return true
+			}
+		}
+
+		account, _ := value.(*account)
+		p.expireAccountTxs(account, cutoff)
+
+		return true
+	})
+}
+
+// expireAccountTxs drops the account's enqueued transactions which arrived before the cutoff,
+// and the promoted ones too if configured
+func (p *TxPool) expireAccountTxs(account *account, cutoff time.Time) {
+	expired, demoted := p.dropAccountTxs(account, p.lifetimePromoted, func(tx *types.Transaction) bool {
+		return p.isExpired(tx, cutoff)
+	})
+
+	if len(expired) == 0 {
+		return
+	}
+
+	hashes := make([]types.Hash, len(expired))
+	for i, tx := range expired {
+		hashes[i] = tx.Hash
+	}
+
+	p.eventManager.signalEventWithReason(proto.EventType_DROPPED, dropReasonExpired, hashes...)
+
+	for _, tx := range demoted {
+		p.eventManager.signalEvent(proto.EventType_DEMOTED, tx.Hash)
+	}
+
+	metrics.IncrCounter([]string{txPoolMetrics, "expired_transactions"}, float32(len(expired)))
+
+	p.logger.Debug("dropped expired txs",
+		"num", len(expired),
+		"demoted", len(demoted),
+		"next_nonce", account.getNonce(),
+	)
+}
+
+// isExpired checks if the transaction arrived in the pool before the cutoff
+func (p *TxPool) isExpired(tx *types.Transaction, cutoff time.Time) bool {
+	arrival, ok := p.index.arrival(tx.Hash)
+
+	return ok && arrival.Before(cutoff)
+}
@@ -0,0 +1,165 @@
+package txpool
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/txpool/proto"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/require"
+)
+
+// expire backdates the arrival of the given transactions past the pool's lifetime
+func expire(pool *TxPool, txs ...*types.Transaction) {
+	pool.index.Lock()
+	defer pool.index.Unlock()
+
+	for _, tx := range txs {
+		pool.index.arrivals[tx.Hash] = time.Now().Add(-2 * pool.lifetime)
+	}
+}
+
+func TestExpireTransactions(t *testing.T) {
+	t.Parallel()
+
+	newPool := func(t *testing.T) *TxPool {
+		t.Helper()
+
+		pool, err := newTestPool()
+		require.NoError(t, err)
+		pool.SetSigner(&mockSigner{})
+
+		pool.lifetime = time.Minute
+
+		return pool
+	}
+
+	enqueue := func(t *testing.T, pool *TxPool, tx *types.Transaction) {
+		t.Helper()
+
+		go func() {
+			require.NoError(t, pool.addTx(gossip, tx))
+		}()
+		pool.handleEnqueueRequest(<-pool.enqueueReqCh)
+	}
+
+	promote := func(t *testing.T, pool *TxPool, tx *types.Transaction) {
+		t.Helper()
+
+		go func() {
+			require.NoError(t, pool.addTx(gossip, tx))
+		}()
+		go pool.handleEnqueueRequest(<-pool.enqueueReqCh)
+		pool.handlePromoteRequest(<-pool.promoteReqCh)
+	}
+
+	t.Run("drop expired enqueued tx", func(t *testing.T) {
+		t.Parallel()
+
+		pool := newPool(t)
+
+		expiredTx, freshTx := newTx(addr1, 10, 1), newTx(addr1, 11, 1)
+		enqueue(t, pool, expiredTx)
+		enqueue(t, pool, freshTx)
+
+		subscription := pool.eventManager.subscribe([]proto.EventType{proto.EventType_DROPPED})
+		defer pool.eventManager.cancelSubscription(subscription.subscriptionID)
+
+		expire(pool, expiredTx)
+		pool.expireTransactions()
+
+		require.Equal(t, uint64(1), pool.gauge.read())
+		require.Equal(t, freshTx, pool.accounts.get(addr1).enqueued.peek())
+
+		_, ok := pool.index.get(expiredTx.Hash)
+		require.False(t, ok)
+
+		ctx, cancelFn := context.WithTimeout(context.Background(), time.Second)
+		defer cancelFn()
+
+		events := waitForEvents(ctx, subscription, 1)
+		require.Len(t, events, 1)
+		require.Equal(t, expiredTx.Hash.String(), events[0].TxHash)
+		require.Equal(t, dropReasonExpired, events[0].Reason)
+	})
+
+	t.Run("keep expired promoted tx by default", func(t *testing.T) {
+		t.Parallel()
+
+		pool := newPool(t)
+
+		tx := newTx(addr1, 0, 1)
+		promote(t, pool, tx)
+
+		expire(pool, tx)
+		pool.expireTransactions()
+
+		require.Equal(t, uint64(1), pool.accounts.get(addr1).promoted.length())
+	})
+
+	t.Run("drop expired promoted tx and demote the following ones", func(t *testing.T) {
+		t.Parallel()
+
+		pool := newPool(t)
+		pool.lifetimePromoted = true
+
+		txs := []*types.Transaction{newTx(addr1, 0, 1), newTx(addr1, 1, 1), newTx(addr1, 2, 1)}
+		for _, tx := range txs {
+			promote(t, pool, tx)
+		}
+
+		expire(pool, txs[1])
+		pool.expireTransactions()
+
+		account := pool.accounts.get(addr1)
+
+		require.Equal(t, uint64(2), pool.gauge.read())
+		require.Equal(t, uint64(1), account.getNonce())
+		require.Equal(t, txs[0], account.promoted.peek())
+		require.Equal(t, uint64(1), account.promoted.length())
+		require.Equal(t, txs[2], account.enqueued.peek())
+	})
+
+	t.Run("exempt local txs", func(t *testing.T) {
+		t.Parallel()
+
+		pool := newPool(t)
+
+		tx := newTx(addr1, 10, 1)
+		enqueue(t, pool, tx)
+
+		pool.locals.Store(addr1, struct{}{})
+
+		expire(pool, tx)
+		pool.expireTransactions()
+
+		require.Equal(t, uint64(1), pool.accounts.get(addr1).enqueued.length())
+
+		pool.lifetimeLocals = true
+		pool.expireTransactions()
+
+		require.Equal(t, uint64(0), pool.accounts.get(addr1).enqueued.length())
+	})
+}
+
+func TestExpireTransactions_Sweep(t *testing.T) {
+	t.Parallel()
+
+	pool, err := newTestPool()
+	require.NoError(t, err)
+	pool.SetSigner(&mockSigner{})
+
+	pool.lifetime = 50 * time.Millisecond
+
+	pool.Start()
+	defer pool.Close()
+
+	require.NoError(t, pool.addTx(gossip, newTx(addr1, 10, 1)))
+
+	require.Eventually(t, func() bool {
+		account := pool.accounts.get(addr1)
+
+		return account != nil && account.enqueued.length() == 0 && pool.gauge.read() == 0
+	}, 5*time.Second, 10*time.Millisecond)
+}
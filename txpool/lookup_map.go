@@ -2,6 +2,7 @@ package txpool
 
 import (
 	"sync"
+	"time"
 
 	"github.com/0xPolygon/polygon-edge/types"
 )
@@ -10,6 +11,9 @@ import (
 type lookupMap struct {
 	sync.RWMutex
 	all map[types.Hash]*types.Transaction
+
+	// arrivals are the times the transactions were added to the pool at
+	arrivals map[types.Hash]time.Time
 }
 
 // add inserts the given transaction into the map. Returns false
@@ -24,6 +28,12 @@ func (m *lookupMap) add(tx *types.Transaction) bool {
 
 	m.all[tx.Hash] = tx
 
+	if m.arrivals == nil {
+		m.arrivals = make(map[types.Hash]time.Time)
+	}
+
+	m.arrivals[tx.Hash] = time.Now()
+
 	return true
 }
 
@@ -34,6 +44,7 @@ func (m *lookupMap) remove(txs ...*types.Transaction) {
 
 	for _, tx := range txs {
 		delete(m.all, tx.Hash)
+		delete(m.arrivals, tx.Hash)
 	}
 }
 
@@ -49,3 +60,13 @@ func (m *lookupMap) get(hash types.Hash) (*types.Transaction, bool) {
 
 	return tx, true
 }
+
+// arrival returns the time the transaction was added to the pool at. [thread-safe]
+func (m *lookupMap) arrival(hash types.Hash) (time.Time, bool) {
+	m.RLock()
+	defer m.RUnlock()
+
+	arrival, ok := m.arrivals[hash]
+
+	return arrival, ok
+}
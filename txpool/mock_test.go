@@ -41,6 +41,10 @@ func (m defaultMockStore) GetBalance(types.Hash, types.Address) (*big.Int, error
 	return balance, nil
 }
 
+func (m defaultMockStore) GetStorage(types.Hash, types.Address, types.Hash) (types.Hash, error) {
+	return types.ZeroHash, nil
+}
+
 type faultyMockStore struct {
 }
 
@@ -60,6 +64,10 @@ func (fms faultyMockStore) GetBalance(root types.Hash, addr types.Address) (*big
 	return nil, fmt.Errorf("unable to fetch account state")
 }
 
+func (fms faultyMockStore) GetStorage(root types.Hash, addr types.Address, slot types.Hash) (types.Hash, error) {
+	return types.ZeroHash, fmt.Errorf("unable to fetch account state")
+}
+
 type mockSigner struct {
 }
 
@@ -38,7 +38,11 @@ func (p *TxPool) AddTxn(ctx context.Context, raw *proto.AddTxnReq) (*proto.AddTx
 		txn.From = from
 	}
 
-	if err := p.AddTx(txn); err != nil {
+	if raw.Private {
+		if err := p.AddPrivateTx(txn, raw.MaxBlock); err != nil {
+			return nil, err
+		}
+	} else if err := p.AddTx(txn); err != nil {
 		return nil, err
 	}
 
@@ -78,3 +82,53 @@ func (p *TxPool) Subscribe(
 		}
 	}
 }
+
+// TxPoolSubscribe subscribes to the given event types in the tx pool.
+// The events of the private transactions are not published to the subscription.
+// It returns the event channel and a function that cancels the subscription
+func (p *TxPool) TxPoolSubscribe(request *proto.SubscribeRequest) (<-chan *proto.TxPoolEvent, func(), error) {
+	if len(request.Types) == 0 {
+		return nil, nil, fmt.Errorf("no event types provided")
+	}
+
+	subscription := p.eventManager.subscribeExcluding(request.Types, p.privateTxs.has)
+
+	cancel := func() {
+		p.eventManager.cancelSubscription(subscription.subscriptionID)
+	}
+
+	return subscription.subscriptionChannel, cancel, nil
+}
+
+// GetPolicy implements the operator endpoint. Returns the transaction policy in effect at the latest block
+func (p *TxPool) GetPolicy(ctx context.Context, req *empty.Empty) (*proto.TxPolicyResp, error) {
+	info := p.txPolicy.Info(&policyStorage{store: p.store, root: p.store.Header().StateRoot})
+
+	resp := &proto.TxPolicyResp{
+		SenderAllowList:            addressStrings(info.SenderAllowList),
+		SenderDenyList:             addressStrings(info.SenderDenyList),
+		RecipientAllowList:         addressStrings(info.RecipientAllowList),
+		MethodDenyList:             make([]string, len(info.MethodDenyList)),
+		SenderAllowListEnforced:    info.SenderAllowListEnforced,
+		RecipientAllowListEnforced: info.RecipientAllowListEnforced,
+	}
+
+	for i, selector := range info.MethodDenyList {
+		resp.MethodDenyList[i] = selector.String()
+	}
+
+	if info.Contract != nil {
+		resp.Contract = info.Contract.String()
+	}
+
+	return resp, nil
+}
+
+func addressStrings(addrs []types.Address) []string {
+	strs := make([]string, len(addrs))
+	for i, addr := range addrs {
+		strs[i] = addr.String()
+	}
+
+	return strs
+}
@@ -0,0 +1,136 @@
+package txpool
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+const (
+	// PriceOrdering picks the transactions with the highest gas price first
+	PriceOrdering = "price"
+
+	// FIFOOrdering picks the transactions in the order they arrived in the pool
+	FIFOOrdering = "fifo"
+
+	// RoundRobinOrdering picks a transaction of each sender in turn, up to the sender cap per block
+	RoundRobinOrdering = "round-robin"
+
+	// defaultRoundRobinSenderCap is the max number of a single sender's transactions in a block,
+	// if the round-robin policy cap is not configured
+	defaultRoundRobinSenderCap = 16
+)
+
+var (
+	ErrUnknownTxOrderingPolicy = errors.New("unknown transaction ordering policy")
+)
+
+// ExecutableTx is an executable transaction (the head of an account's promoted queue)
+// along with the info the transactions are ordered by
+type ExecutableTx struct {
+	Tx *types.Transaction
+
+	// Arrival is the time the transaction was added to the pool at
+	Arrival time.Time
+
+	// Picked is the number of the sender's transactions already picked for the block being built
+	Picked uint64
+}
+
+// TxOrderingPolicy determines the order the executable transactions are picked for the block building in.
+// The nonce order of each sender's transactions is always preserved.
+type TxOrderingPolicy interface {
+	// Name returns the name the policy is selected by in the chain config
+	Name() string
+
+	// Less reports whether the transaction x is picked before y
+	Less(x, y *ExecutableTx) bool
+
+	// SenderCap returns the max number of a single sender's transactions in a block (0 if unlimited)
+	SenderCap() uint64
+}
+
+// NewTxOrderingPolicy returns the ordering policy selected in the chain config,
+// the price priority is used if there is none
+func NewTxOrderingPolicy(config *chain.TxOrdering) (TxOrderingPolicy, error) {
+	if config == nil {
+		return &priceOrdering{}, nil
+	}
+
+	switch config.Policy {
+	case "", PriceOrdering:
+		return &priceOrdering{}, nil
+	case FIFOOrdering:
+		return &fifoOrdering{}, nil
+	case RoundRobinOrdering:
+		senderCap := config.SenderCap
+		if senderCap == 0 {
+			senderCap = defaultRoundRobinSenderCap
+		}
+
+		return &roundRobinOrdering{senderCap: senderCap}, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownTxOrderingPolicy, config.Policy)
+	}
+}
+
+// priceOrdering picks the transactions by the gas price (descending),
+// the earlier arrived transaction goes first if the prices are the same
+type priceOrdering struct{}
+
+func (o *priceOrdering) Name() string {
+	return PriceOrdering
+}
+
+func (o *priceOrdering) Less(x, y *ExecutableTx) bool {
+	if cmp := x.Tx.GasPrice.Cmp(y.Tx.GasPrice); cmp != 0 {
+		return cmp > 0
+	}
+
+	return x.Arrival.Before(y.Arrival)
+}
+
+func (o *priceOrdering) SenderCap() uint64 {
+	return 0
+}
+
+// fifoOrdering picks the transactions by the arrival time (ascending) regardless of the gas price
+type fifoOrdering struct{}
+
+func (o *fifoOrdering) Name() string {
+	return FIFOOrdering
+}
+
+func (o *fifoOrdering) Less(x, y *ExecutableTx) bool {
+	return x.Arrival.Before(y.Arrival)
+}
+
+func (o *fifoOrdering) SenderCap() uint64 {
+	return 0
+}
+
+// roundRobinOrdering picks the transactions of the senders with the fewest transactions picked so far first,
+// so each sender gets a transaction in before any sender gets the next one. The transactions
+// of the same round go by the arrival time, and no sender gets more than the cap in a block.
+type roundRobinOrdering struct {
+	senderCap uint64
+}
+
+func (o *roundRobinOrdering) Name() string {
+	return RoundRobinOrdering
+}
+
+func (o *roundRobinOrdering) Less(x, y *ExecutableTx) bool {
+	if x.Picked != y.Picked {
+		return x.Picked < y.Picked
+	}
+
+	return x.Arrival.Before(y.Arrival)
+}
+
+func (o *roundRobinOrdering) SenderCap() uint64 {
+	return o.senderCap
+}
@@ -0,0 +1,121 @@
+package txpool
+
+import (
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTxOrderingPolicy(t *testing.T) {
+	t.Parallel()
+
+	policy, err := NewTxOrderingPolicy(nil)
+	require.NoError(t, err)
+	assert.Equal(t, PriceOrdering, policy.Name())
+
+	policy, err = NewTxOrderingPolicy(&chain.TxOrdering{Policy: FIFOOrdering})
+	require.NoError(t, err)
+	assert.Equal(t, FIFOOrdering, policy.Name())
+	assert.Equal(t, uint64(0), policy.SenderCap())
+
+	policy, err = NewTxOrderingPolicy(&chain.TxOrdering{Policy: RoundRobinOrdering})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(defaultRoundRobinSenderCap), policy.SenderCap())
+
+	_, err = NewTxOrderingPolicy(&chain.TxOrdering{Policy: "random"})
+	assert.ErrorIs(t, err, ErrUnknownTxOrderingPolicy)
+}
+
+func TestTxOrderingPolicies(t *testing.T) {
+	t.Parallel()
+
+	newPricedTx := func(addr types.Address, nonce, gasPrice uint64) *types.Transaction {
+		tx := newTx(addr, nonce, 1)
+		tx.GasPrice.SetUint64(gasPrice)
+
+		return tx
+	}
+
+	// the txs are added to the pool in this order
+	txs := []*types.Transaction{
+		newPricedTx(addr1, 0, 1),
+		newPricedTx(addr1, 1, 1),
+		newPricedTx(addr1, 2, 1),
+		newPricedTx(addr2, 0, 3),
+		newPricedTx(addr3, 0, 2),
+	}
+
+	testCases := []struct {
+		config   *chain.TxOrdering
+		expected []*types.Transaction
+	}{
+		{
+			config:   &chain.TxOrdering{Policy: PriceOrdering},
+			expected: []*types.Transaction{txs[3], txs[4], txs[0], txs[1], txs[2]},
+		},
+		{
+			config:   &chain.TxOrdering{Policy: FIFOOrdering},
+			expected: []*types.Transaction{txs[0], txs[1], txs[2], txs[3], txs[4]},
+		},
+		{
+			config:   &chain.TxOrdering{Policy: RoundRobinOrdering, SenderCap: 2},
+			expected: []*types.Transaction{txs[0], txs[3], txs[4], txs[1]},
+		},
+	}
+
+	for _, test := range testCases {
+		test := test
+		t.Run(test.config.Policy, func(t *testing.T) {
+			t.Parallel()
+
+			ordering, err := NewTxOrderingPolicy(test.config)
+			require.NoError(t, err)
+
+			pool, err := NewTxPool(
+				hclog.NewNullLogger(),
+				forks.At(0),
+				defaultMockStore{DefaultHeader: mockHeader},
+				nil,
+				nil,
+				&Config{
+					PriceLimit:          defaultPriceLimit,
+					MaxSlots:            defaultMaxSlots,
+					MaxAccountEnqueued:  defaultMaxAccountEnqueued,
+					DeploymentWhitelist: []types.Address{},
+					TxOrdering:          ordering,
+				},
+			)
+			require.NoError(t, err)
+			pool.SetSigner(&mockSigner{})
+
+			for _, tx := range txs {
+				go func(tx *types.Transaction) {
+					assert.NoError(t, pool.addTx(local, tx))
+				}(tx)
+				go pool.handleEnqueueRequest(<-pool.enqueueReqCh)
+				pool.handlePromoteRequest(<-pool.promoteReqCh)
+			}
+
+			var picked []*types.Transaction
+
+			pool.Prepare()
+
+			for tx := pool.Peek(); tx != nil; tx = pool.Peek() {
+				pool.Pop(tx)
+				picked = append(picked, tx)
+			}
+
+			assert.Equal(t, test.expected, picked)
+
+			// the capped sender's txs are picked again for the next block
+			if test.config.Policy == RoundRobinOrdering {
+				pool.Prepare()
+				assert.Equal(t, txs[2], pool.Peek())
+			}
+		})
+	}
+}
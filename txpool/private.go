@@ -0,0 +1,319 @@
+package txpool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/network"
+	"github.com/0xPolygon/polygon-edge/network/grpc"
+	"github.com/0xPolygon/polygon-edge/txpool/proto"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/armon/go-metrics"
+	"github.com/libp2p/go-libp2p/core/peer"
+	rawGrpc "google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	// privateTxProto is the libp2p protocol the private transactions are forwarded to the trusted peers over
+	privateTxProto = "/txpool/private/0.1"
+
+	// defaultPrivateTxMaxBlocks is the number of blocks a private transaction without a max block
+	// can be included in, if not configured
+	defaultPrivateTxMaxBlocks = 25
+
+	// privateTxForwardTimeout is the timeout of forwarding a private transaction to a trusted peer
+	privateTxForwardTimeout = 5 * time.Second
+
+	// dropReasonPrivateMaxBlock is the reason of the DROPPED events of the private transactions
+	// which were not included until their max block
+	dropReasonPrivateMaxBlock = "private max block reached"
+)
+
+var (
+	ErrPrivateTxMaxBlock      = errors.New("private transaction max block already reached")
+	ErrPrivateTxUntrustedPeer = errors.New("private transaction forwarded by an untrusted peer")
+)
+
+// privateTxNetwork is the network the private transactions are forwarded over
+type privateTxNetwork interface {
+	// RegisterProtocol registers the gRPC service of the protocol
+	RegisterProtocol(string, network.Protocol)
+	// NewProtoConnection opens a connection to the peer over the protocol
+	NewProtoConnection(protocol string, peerID peer.ID) (*rawGrpc.ClientConn, error)
+}
+
+// privateTxs tracks the private transactions in the pool
+// along with the max block number they can be included in
+type privateTxs struct {
+	sync.RWMutex
+
+	maxBlocks map[types.Hash]uint64
+
+	// adding counts the additions in progress of the private transactions,
+	// which are not pruned until they are done
+	adding map[types.Hash]int
+}
+
+func newPrivateTxs() *privateTxs {
+	return &privateTxs{
+		maxBlocks: make(map[types.Hash]uint64),
+		adding:    make(map[types.Hash]int),
+	}
+}
+
+// startAdding marks the transaction as private before it is added to the pool.
+// Returns false if the transaction was already marked
+func (t *privateTxs) startAdding(hash types.Hash, maxBlock uint64) bool {
+	t.Lock()
+	defer t.Unlock()
+
+	_, marked := t.maxBlocks[hash]
+	t.maxBlocks[hash] = maxBlock
+	t.adding[hash]++
+
+	return !marked
+}
+
+// doneAdding ends the addition of the private transaction,
+// the mark set by startAdding is removed if the transaction was not added
+func (t *privateTxs) doneAdding(hash types.Hash, marked, added bool) {
+	t.Lock()
+	defer t.Unlock()
+
+	if t.adding[hash]--; t.adding[hash] == 0 {
+		delete(t.adding, hash)
+	}
+
+	if marked && !added {
+		delete(t.maxBlocks, hash)
+	}
+}
+
+// has checks if the transaction is private
+func (t *privateTxs) has(hash types.Hash) bool {
+	t.RLock()
+	defer t.RUnlock()
+
+	_, ok := t.maxBlocks[hash]
+
+	return ok
+}
+
+// length returns the number of the tracked private transactions
+func (t *privateTxs) length() int {
+	t.RLock()
+	defer t.RUnlock()
+
+	return len(t.maxBlocks)
+}
+
+// maxBlock returns the max block number the private transaction can be included in
+func (t *privateTxs) maxBlock(hash types.Hash) (uint64, bool) {
+	t.RLock()
+	defer t.RUnlock()
+
+	maxBlock, ok := t.maxBlocks[hash]
+
+	return maxBlock, ok
+}
+
+// prune stops tracking the transactions which are no longer in the pool
+func (t *privateTxs) prune(known func(hash types.Hash) bool) {
+	t.Lock()
+	defer t.Unlock()
+
+	for hash := range t.maxBlocks {
+		if _, ok := t.adding[hash]; !ok && !known(hash) {
+			delete(t.maxBlocks, hash)
+		}
+	}
+}
+
+// AddPrivateTx adds a new transaction to the pool (sent from json-RPC/gRPC endpoints)
+// without broadcasting it to the network. The transaction is forwarded to the trusted peers only,
+// so it is included in a block proposed either by this node or by one of the trusted peers.
+// It is dropped once the max block is reached, the configured default applies if the max block is zero.
+func (p *TxPool) AddPrivateTx(tx *types.Transaction, maxBlock uint64) error {
+	head := p.store.Header().Number
+
+	if maxBlock == 0 {
+		maxBlock = head + p.privateTxMaxBlocks
+	}
+
+	if err := p.addPrivateTx(tx, maxBlock, head); err != nil {
+		p.logger.Error("failed to add private tx", "err", err)
+
+		return err
+	}
+
+	p.forwardPrivateTx(tx, maxBlock)
+
+	return nil
+}
+
+// addPrivateTx adds the private transaction to the pool, if it can be included after the given head
+func (p *TxPool) addPrivateTx(tx *types.Transaction, maxBlock, head uint64) error {
+	if maxBlock <= head {
+		return ErrPrivateTxMaxBlock
+	}
+
+	// the tx is marked as private before it is added to the pool,
+	// so that it is never exposed by the queries and the events of the pool
+	tx.ComputeHash()
+
+	marked := p.privateTxs.startAdding(tx.Hash, maxBlock)
+
+	err := p.addTx(private, tx)
+	p.privateTxs.doneAdding(tx.Hash, marked, err == nil)
+
+	if err != nil {
+		return err
+	}
+
+	metrics.IncrCounter([]string{txPoolMetrics, "private_transactions"}, 1)
+
+	return nil
+}
+
+// forwardPrivateTx hands the private transaction over to the trusted peers
+func (p *TxPool) forwardPrivateTx(tx *types.Transaction, maxBlock uint64) {
+	if p.privateNetwork == nil {
+		return
+	}
+
+	req := &proto.ForwardTxnReq{
+		Raw:      tx.MarshalRLP(),
+		MaxBlock: maxBlock,
+	}
+
+	for _, peerID := range p.privateTxPeers {
+		go func(peerID peer.ID) {
+			if err := p.forwardPrivateTxTo(peerID, req); err != nil {
+				p.logger.Error("failed to forward private tx", "peer", peerID, "hash", tx.Hash.String(), "err", err)
+			}
+		}(peerID)
+	}
+}
+
+func (p *TxPool) forwardPrivateTxTo(peerID peer.ID, req *proto.ForwardTxnReq) error {
+	conn, err := p.privateNetwork.NewProtoConnection(privateTxProto, peerID)
+	if err != nil {
+		return fmt.Errorf("failed to open a stream, err %w", err)
+	}
+
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), privateTxForwardTimeout)
+	defer cancel()
+
+	_, err = proto.NewPrivateTxnClient(conn).Forward(ctx, req)
+
+	return err
+}
+
+// isTrustedPeer checks if the private transactions forwarded by the peer are accepted
+func (p *TxPool) isTrustedPeer(peerID peer.ID) bool {
+	for _, trusted := range p.privateTxPeers {
+		if trusted == peerID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// dropPrivateTxs drops the private transactions which can't be included after the given head anymore
+func (p *TxPool) dropPrivateTxs(head uint64) {
+	p.privateTxs.prune(func(hash types.Hash) bool {
+		_, ok := p.index.get(hash)
+
+		return ok
+	})
+
+	if p.privateTxs.length() == 0 {
+		return
+	}
+
+	p.accounts.Range(func(_, value interface{}) bool {
+		account, _ := value.(*account)
+
+		dropped, demoted := p.dropAccountTxs(account, true, func(tx *types.Transaction) bool {
+			maxBlock, ok := p.privateTxs.maxBlock(tx.Hash)
+
+			return ok && maxBlock <= head
+		})
+
+		if len(dropped) == 0 {
+			return true
+		}
+
+		hashes := make([]types.Hash, len(dropped))
+		for i, tx := range dropped {
+			hashes[i] = tx.Hash
+		}
+
+		p.eventManager.signalEventWithReason(proto.EventType_DROPPED, dropReasonPrivateMaxBlock, hashes...)
+
+		for _, tx := range demoted {
+			p.eventManager.signalEvent(proto.EventType_DEMOTED, tx.Hash)
+		}
+
+		p.logger.Debug("dropped private txs past their max block",
+			"num", len(dropped),
+			"demoted", len(demoted),
+			"next_nonce", account.getNonce(),
+		)
+
+		return true
+	})
+}
+
+// privateTxService serves the private transactions forwarded by the trusted peers
+type privateTxService struct {
+	proto.UnimplementedPrivateTxnServer
+
+	pool   *TxPool
+	stream *grpc.GrpcStream
+}
+
+// startPrivateTxService registers the protocol the private transactions are forwarded over
+func (p *TxPool) startPrivateTxService() {
+	service := &privateTxService{
+		pool:   p,
+		stream: grpc.NewGrpcStream(),
+	}
+
+	proto.RegisterPrivateTxnServer(service.stream.GrpcServer(), service)
+	service.stream.Serve()
+	p.privateNetwork.RegisterProtocol(privateTxProto, service.stream)
+
+	p.privateService = service
+}
+
+// Forward implements the private transactions protocol endpoint.
+// Adds the private transaction forwarded by a trusted peer to the pool, without forwarding it any further.
+func (s *privateTxService) Forward(ctx context.Context, req *proto.ForwardTxnReq) (*emptypb.Empty, error) {
+	grpcContext, ok := ctx.(*grpc.Context)
+	if !ok {
+		return nil, errors.New("invalid type assertion")
+	}
+
+	if !s.pool.isTrustedPeer(grpcContext.PeerID) {
+		return nil, ErrPrivateTxUntrustedPeer
+	}
+
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalRLP(req.Raw); err != nil {
+		return nil, err
+	}
+
+	if err := s.pool.addPrivateTx(tx, req.MaxBlock, s.pool.store.Header().Number); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
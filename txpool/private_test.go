@@ -0,0 +1,197 @@
+package txpool
+
+import (
+	"context"
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/network/grpc"
+	"github.com/0xPolygon/polygon-edge/txpool/proto"
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddPrivateTx(t *testing.T) {
+	t.Parallel()
+
+	newPool := func(t *testing.T) *TxPool {
+		t.Helper()
+
+		pool, err := newTestPool()
+		require.NoError(t, err)
+		pool.SetSigner(&mockSigner{})
+
+		return pool
+	}
+
+	addPrivate := func(t *testing.T, pool *TxPool, tx *types.Transaction, maxBlock uint64) {
+		t.Helper()
+
+		errCh := make(chan error, 1)
+
+		go func() {
+			errCh <- pool.AddPrivateTx(tx, maxBlock)
+		}()
+		pool.handleEnqueueRequest(<-pool.enqueueReqCh)
+
+		require.NoError(t, <-errCh)
+	}
+
+	t.Run("track the max block", func(t *testing.T) {
+		t.Parallel()
+
+		pool := newPool(t)
+
+		tx, defaultTx := newTx(addr1, 5, 1), newTx(addr2, 5, 1)
+		addPrivate(t, pool, tx, 10)
+		addPrivate(t, pool, defaultTx, 0)
+
+		maxBlock, ok := pool.privateTxs.maxBlock(tx.Hash)
+		require.True(t, ok)
+		require.Equal(t, uint64(10), maxBlock)
+
+		maxBlock, ok = pool.privateTxs.maxBlock(defaultTx.Hash)
+		require.True(t, ok)
+		require.Equal(t, mockHeader.Number+defaultPrivateTxMaxBlocks, maxBlock)
+
+		// the private txs are not journaled
+		pool.locals.Store(addr1, struct{}{})
+		require.Empty(t, pool.localTxs())
+	})
+
+	t.Run("reject the reached max block", func(t *testing.T) {
+		t.Parallel()
+
+		pool := newPool(t)
+
+		require.ErrorIs(t, pool.addPrivateTx(newTx(addr1, 0, 1), 5, 5), ErrPrivateTxMaxBlock)
+		require.Equal(t, uint64(0), pool.gauge.read())
+	})
+
+	t.Run("drop the txs past their max block", func(t *testing.T) {
+		t.Parallel()
+
+		pool := newPool(t)
+
+		txs := []*types.Transaction{newTx(addr1, 0, 1), newTx(addr1, 1, 1), newTx(addr1, 2, 1)}
+
+		for i, tx := range txs {
+			maxBlock := uint64(20)
+			if i == 1 {
+				maxBlock = 10
+			}
+
+			errCh := make(chan error, 1)
+
+			go func(tx *types.Transaction, maxBlock uint64) {
+				errCh <- pool.AddPrivateTx(tx, maxBlock)
+			}(tx, maxBlock)
+			go pool.handleEnqueueRequest(<-pool.enqueueReqCh)
+			pool.handlePromoteRequest(<-pool.promoteReqCh)
+
+			require.NoError(t, <-errCh)
+		}
+
+		subscription := pool.eventManager.subscribe([]proto.EventType{proto.EventType_DROPPED})
+		defer pool.eventManager.cancelSubscription(subscription.subscriptionID)
+
+		pool.dropPrivateTxs(9)
+		require.Equal(t, uint64(3), pool.accounts.get(addr1).promoted.length())
+
+		pool.dropPrivateTxs(10)
+
+		account := pool.accounts.get(addr1)
+
+		require.Equal(t, uint64(2), pool.gauge.read())
+		require.Equal(t, uint64(1), account.getNonce())
+		require.Equal(t, txs[0], account.promoted.peek())
+		require.Equal(t, txs[2], account.enqueued.peek())
+
+		_, ok := pool.index.get(txs[1].Hash)
+		require.False(t, ok)
+
+		event := <-subscription.subscriptionChannel
+		require.Equal(t, txs[1].Hash.String(), event.TxHash)
+		require.Equal(t, dropReasonPrivateMaxBlock, event.Reason)
+	})
+
+	t.Run("hide the private txs from the queries and the subscriptions", func(t *testing.T) {
+		t.Parallel()
+
+		pool := newPool(t)
+
+		events, cancel, err := pool.TxPoolSubscribe(&proto.SubscribeRequest{
+			Types: []proto.EventType{proto.EventType_ADDED, proto.EventType_ENQUEUED},
+		})
+		require.NoError(t, err)
+
+		defer cancel()
+
+		privateTx, publicTx := newTx(addr1, 5, 1), newTx(addr2, 5, 1)
+		addPrivate(t, pool, privateTx, 10)
+
+		errCh := make(chan error, 1)
+
+		go func() {
+			errCh <- pool.addTx(local, publicTx)
+		}()
+		pool.handleEnqueueRequest(<-pool.enqueueReqCh)
+
+		require.NoError(t, <-errCh)
+
+		_, ok := pool.GetPendingTx(privateTx.Hash)
+		require.False(t, ok)
+
+		_, ok = pool.GetPendingTx(publicTx.Hash)
+		require.True(t, ok)
+
+		_, enqueued := pool.GetTxs(true)
+		require.Equal(t, map[types.Address][]*types.Transaction{addr2: {publicTx}}, enqueued)
+
+		// only the events of the public tx are published
+		for i := 0; i < 2; i++ {
+			event := <-events
+			require.Equal(t, publicTx.Hash.String(), event.TxHash)
+		}
+	})
+}
+
+func TestPrivateTxService_Forward(t *testing.T) {
+	t.Parallel()
+
+	trusted := peer.ID("trusted")
+
+	pool, err := newTestPool()
+	require.NoError(t, err)
+	pool.SetSigner(&mockSigner{})
+
+	pool.privateTxPeers = []peer.ID{trusted}
+
+	service := &privateTxService{pool: pool}
+
+	tx := newTx(addr1, 5, 1)
+	tx.ComputeHash()
+
+	req := &proto.ForwardTxnReq{
+		Raw:      tx.MarshalRLP(),
+		MaxBlock: 10,
+	}
+
+	_, err = service.Forward(&grpc.Context{Context: context.Background(), PeerID: peer.ID("untrusted")}, req)
+	require.ErrorIs(t, err, ErrPrivateTxUntrustedPeer)
+
+	errCh := make(chan error, 1)
+
+	go func() {
+		_, err := service.Forward(&grpc.Context{Context: context.Background(), PeerID: trusted}, req)
+		errCh <- err
+	}()
+	pool.handleEnqueueRequest(<-pool.enqueueReqCh)
+
+	require.NoError(t, <-errCh)
+
+	// the sender is not RLP encoded, so the forwarded tx is looked up by hash
+	_, ok := pool.index.get(tx.Hash)
+	require.True(t, ok)
+	require.True(t, pool.privateTxs.has(tx.Hash))
+}
@@ -28,8 +28,13 @@ func (p *TxPool) GetCapacity() (uint64, uint64) {
 	return p.gauge.read(), p.gauge.max
 }
 
-// GetPendingTx returns the transaction by hash in the TxPool (pending txn) [Thread-safe]
+// GetPendingTx returns the transaction by hash in the TxPool (pending txn) [Thread-safe].
+// The private transactions are not returned
 func (p *TxPool) GetPendingTx(txHash types.Hash) (*types.Transaction, bool) {
+	if p.privateTxs.has(txHash) {
+		return nil, false
+	}
+
 	tx, ok := p.index.get(txHash)
 	if !ok {
 		return nil, false
@@ -38,11 +43,35 @@ func (p *TxPool) GetPendingTx(txHash types.Hash) (*types.Transaction, bool) {
 	return tx, true
 }
 
-// GetTxs gets pending and queued transactions
+// GetTxs gets pending and queued transactions, except for the private ones
 func (p *TxPool) GetTxs(inclQueued bool) (
 	allPromoted, allEnqueued map[types.Address][]*types.Transaction,
 ) {
 	allPromoted, allEnqueued = p.accounts.allTxs(inclQueued)
 
+	if p.privateTxs.length() != 0 {
+		p.removePrivateTxs(allPromoted)
+		p.removePrivateTxs(allEnqueued)
+	}
+
 	return
 }
+
+// removePrivateTxs removes the private transactions from the given account transactions
+func (p *TxPool) removePrivateTxs(txs map[types.Address][]*types.Transaction) {
+	for addr, accountTxs := range txs {
+		public := make([]*types.Transaction, 0, len(accountTxs))
+
+		for _, tx := range accountTxs {
+			if !p.privateTxs.has(tx.Hash) {
+				public = append(public, tx)
+			}
+		}
+
+		if len(public) == 0 {
+			delete(txs, addr)
+		} else {
+			txs[addr] = public
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"container/heap"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/0xPolygon/polygon-edge/types"
 )
@@ -105,6 +106,46 @@ func (q *accountQueue) length() uint64 {
 	return uint64(q.queue.Len())
 }
 
+// getByNonce returns the transaction with the given nonce, or nil if there is none in the queue.
+func (q *accountQueue) getByNonce(nonce uint64) *types.Transaction {
+	for _, tx := range q.queue {
+		if tx.Nonce == nonce {
+			return tx
+		}
+	}
+
+	return nil
+}
+
+// replace swaps the transaction with the same nonce as the given one for it.
+// Returns the replaced transaction, or nil if there is none in the queue.
+func (q *accountQueue) replace(tx *types.Transaction) *types.Transaction {
+	for i, old := range q.queue {
+		if old.Nonce == tx.Nonce {
+			q.queue[i] = tx
+			heap.Fix(&q.queue, i)
+
+			return old
+		}
+	}
+
+	return nil
+}
+
+// remove removes the given transaction from the queue.
+// Returns false if the transaction is not in the queue.
+func (q *accountQueue) remove(tx *types.Transaction) bool {
+	for i, queued := range q.queue {
+		if queued == tx {
+			heap.Remove(&q.queue, i)
+
+			return true
+		}
+	}
+
+	return false
+}
+
 // transactions sorted by nonce (ascending)
 type minNonceQueue []*types.Transaction
 
@@ -153,13 +194,22 @@ func (q *minNonceQueue) Pop() interface{} {
 	return x
 }
 
-type pricedQueue struct {
-	queue maxPriceQueue
+// A thread-safe queue of the executable transactions sorted by the ordering policy.
+type executablesQueue struct {
+	lock  sync.Mutex
+	queue orderedQueue
+
+	// picked are the numbers of the senders' transactions picked since the queue was cleared
+	picked map[types.Address]uint64
 }
 
-func newPricedQueue() *pricedQueue {
-	q := pricedQueue{
-		queue: make(maxPriceQueue, 0),
+func newExecutablesQueue(policy TxOrderingPolicy) *executablesQueue {
+	q := executablesQueue{
+		queue: orderedQueue{
+			policy: policy,
+			txs:    make([]*ExecutableTx, 0),
+		},
+		picked: make(map[types.Address]uint64),
 	}
 
 	heap.Init(&q.queue)
@@ -167,75 +217,135 @@ func newPricedQueue() *pricedQueue {
 	return &q
 }
 
-// clear empties the underlying queue.
-func (q *pricedQueue) clear() {
-	q.queue = q.queue[:0]
+// clear empties the underlying queue and resets the picked transactions counts.
+func (q *executablesQueue) clear() {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	q.queue.txs = q.queue.txs[:0]
+	q.picked = make(map[types.Address]uint64)
 }
 
-// Pushes the given transactions onto the queue.
-func (q *pricedQueue) push(tx *types.Transaction) {
-	heap.Push(&q.queue, tx)
+// Pushes the given transaction, which arrived in the pool at the given time, onto the queue.
+func (q *executablesQueue) push(tx *types.Transaction, arrival time.Time) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	heap.Push(&q.queue, &ExecutableTx{
+		Tx:      tx,
+		Arrival: arrival,
+		Picked:  q.picked[tx.From],
+	})
+}
+
+// pick counts the sender's transaction as picked, and reports whether the sender's next one can be picked.
+func (q *executablesQueue) pick(from types.Address) bool {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	q.picked[from]++
+
+	senderCap := q.queue.policy.SenderCap()
+
+	return senderCap == 0 || q.picked[from] < senderCap
+}
+
+// replace swaps the given old transaction for the new one, if the old one is in the queue.
+func (q *executablesQueue) replace(old, tx *types.Transaction, arrival time.Time) bool {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	for i, queued := range q.queue.txs {
+		if queued.Tx == old {
+			q.queue.txs[i] = &ExecutableTx{
+				Tx:      tx,
+				Arrival: arrival,
+				Picked:  queued.Picked,
+			}
+			heap.Fix(&q.queue, i)
+
+			return true
+		}
+	}
+
+	return false
+}
+
+// remove removes the given transaction from the queue, if it is in the queue.
+func (q *executablesQueue) remove(tx *types.Transaction) bool {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	for i, queued := range q.queue.txs {
+		if queued.Tx == tx {
+			heap.Remove(&q.queue, i)
+
+			return true
+		}
+	}
+
+	return false
 }
 
 // Pop removes the first transaction from the queue
 // or nil if the queue is empty.
-func (q *pricedQueue) pop() *types.Transaction {
-	if q.length() == 0 {
+func (q *executablesQueue) pop() *types.Transaction {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	if q.queue.Len() == 0 {
 		return nil
 	}
 
-	transaction, ok := heap.Pop(&q.queue).(*types.Transaction)
+	executable, ok := heap.Pop(&q.queue).(*ExecutableTx)
 	if !ok {
 		return nil
 	}
 
-	return transaction
+	return executable.Tx
 }
 
 // length returns the number of transactions in the queue.
-func (q *pricedQueue) length() uint64 {
+func (q *executablesQueue) length() uint64 {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
 	return uint64(q.queue.Len())
 }
 
-// transactions sorted by gas price (descending)
-type maxPriceQueue []*types.Transaction
+// executable transactions sorted by the ordering policy
+type orderedQueue struct {
+	policy TxOrderingPolicy
+	txs    []*ExecutableTx
+}
 
 /* Queue methods required by the heap interface */
 
-func (q *maxPriceQueue) Peek() *types.Transaction {
-	if q.Len() == 0 {
-		return nil
-	}
-
-	return (*q)[0]
+func (q *orderedQueue) Len() int {
+	return len(q.txs)
 }
 
-func (q *maxPriceQueue) Len() int {
-	return len(*q)
+func (q *orderedQueue) Swap(i, j int) {
+	q.txs[i], q.txs[j] = q.txs[j], q.txs[i]
 }
 
-func (q *maxPriceQueue) Swap(i, j int) {
-	(*q)[i], (*q)[j] = (*q)[j], (*q)[i]
+func (q *orderedQueue) Less(i, j int) bool {
+	return q.policy.Less(q.txs[i], q.txs[j])
 }
 
-func (q *maxPriceQueue) Less(i, j int) bool {
-	return (*q)[i].GasPrice.Uint64() > (*q)[j].GasPrice.Uint64()
-}
-
-func (q *maxPriceQueue) Push(x interface{}) {
-	transaction, ok := x.(*types.Transaction)
+func (q *orderedQueue) Push(x interface{}) {
+	executable, ok := x.(*ExecutableTx)
 	if !ok {
 		return
 	}
 
-	*q = append(*q, transaction)
+	q.txs = append(q.txs, executable)
 }
 
-func (q *maxPriceQueue) Pop() interface{} {
-	old := q
-	n := len(*old)
-	x := (*old)[n-1]
-	*q = (*old)[0 : n-1]
+func (q *orderedQueue) Pop() interface{} {
+	n := len(q.txs)
+	x := q.txs[n-1]
+	q.txs = q.txs[0 : n-1]
 
 	return x
 }
@@ -4,6 +4,8 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"sort"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -35,6 +37,9 @@ const (
 
 	pruningCooldown = 5000 * time.Millisecond
 
+	// defaultRejournalInterval is the journal regeneration interval used if none is configured
+	defaultRejournalInterval = time.Hour
+
 	// txPoolMetrics is a prefix used for txpool-related metrics
 	txPoolMetrics = "txpool"
 )
@@ -56,14 +61,16 @@ var (
 	ErrMaxEnqueuedLimitReached = errors.New("maximum number of enqueued transactions reached")
 	ErrRejectFutureTx          = errors.New("rejected future tx due to low slots")
 	ErrSmartContractRestricted = errors.New("smart contract deployment restricted")
+	ErrReplacementUnderpriced  = errors.New("replacement transaction underpriced")
 )
 
 // indicates origin of a transaction
 type txOrigin int
 
 const (
-	local  txOrigin = iota // json-RPC/gRPC endpoints
-	gossip                 // gossip protocol
+	local   txOrigin = iota // json-RPC/gRPC endpoints
+	gossip                  // gossip protocol
+	private                 // private json-RPC/gRPC endpoints and trusted peers
 )
 
 func (o txOrigin) String() (s string) {
@@ -72,6 +79,8 @@ func (o txOrigin) String() (s string) {
 		s = "local"
 	case gossip:
 		s = "gossip"
+	case private:
+		s = "private"
 	}
 
 	return
@@ -83,6 +92,7 @@ type store interface {
 	GetNonce(root types.Hash, addr types.Address) uint64
 	GetBalance(root types.Hash, addr types.Address) (*big.Int, error)
 	GetBlockByHash(types.Hash, bool) (*types.Block, bool)
+	GetStorage(root types.Hash, addr types.Address, slot types.Hash) (types.Hash, error)
 }
 
 type signer interface {
@@ -94,6 +104,37 @@ type Config struct {
 	MaxSlots            uint64
 	MaxAccountEnqueued  uint64
 	DeploymentWhitelist []types.Address
+
+	// PriceBump is the minimum gas price increase, in percent,
+	// required for a transaction to replace the pending one with the same nonce
+	PriceBump uint64
+
+	// JournalPath is the path of the local transactions journal, the journal is disabled if empty
+	JournalPath string
+
+	// RejournalInterval is the interval of the journal regeneration
+	RejournalInterval time.Duration
+
+	// Lifetime is the max time the enqueued transactions stay in the pool for, the expiry is disabled if zero
+	Lifetime time.Duration
+
+	// LifetimePromoted applies the lifetime to the promoted transactions as well
+	LifetimePromoted bool
+
+	// LifetimeLocals applies the lifetime to the local transactions as well
+	LifetimeLocals bool
+
+	// TxPolicy restricts the senders, recipients and methods of the transactions on permissioned chains
+	TxPolicy *state.TxPolicy
+
+	// TxOrdering is the order the transactions are picked for the block building in (price priority if nil)
+	TxOrdering TxOrderingPolicy
+
+	// PrivateTxMaxBlocks is the number of blocks a private transaction without a max block can be included in
+	PrivateTxMaxBlocks uint64
+
+	// PrivateTxPeers are the trusted peers the private transactions are forwarded to and accepted from
+	PrivateTxPeers []peer.ID
 }
 
 /* All requests are passed to the main loop
@@ -142,8 +183,9 @@ type TxPool struct {
 	// map of all accounts registered by the pool
 	accounts accountsMap
 
-	// all the primaries sorted by max gas price
-	executables *pricedQueue
+	// all the primaries sorted by the ordering policy
+	executables *executablesQueue
+	ordering    TxOrderingPolicy
 
 	// lookup map keeping track of all
 	// transactions present in the pool
@@ -158,6 +200,21 @@ type TxPool struct {
 	// priceLimit is a lower threshold for gas price
 	priceLimit uint64
 
+	// priceBump is the minimum gas price increase (%) of the same nonce replacement transactions
+	priceBump uint64
+
+	// journal persists the local transactions across restarts (nil if disabled)
+	journal           *journal
+	rejournalInterval time.Duration
+
+	// locals are the accounts which sent the transactions through the local endpoints
+	locals sync.Map
+
+	// lifetime is the max time the transactions stay in the pool for (0 if disabled)
+	lifetime         time.Duration
+	lifetimePromoted bool
+	lifetimeLocals   bool
+
 	// channels on which the pool's event loop
 	// does dispatching/handling requests.
 	enqueueReqCh chan enqueueRequest
@@ -177,6 +234,24 @@ type TxPool struct {
 	// deploymentWhitelist map
 	deploymentWhitelist deploymentWhitelist
 
+	// txPolicy restricts the admitted transactions, nil if the chain has no policy
+	txPolicy *state.TxPolicy
+
+	// private transactions, which are never gossiped
+	privateTxs         *privateTxs
+	privateTxMaxBlocks uint64
+	privateTxPeers     []peer.ID
+	privateNetwork     privateTxNetwork
+	privateService     *privateTxService
+
+	// bundles of the transactions included in the blocks atomically
+	bundles *bundlePool
+
+	// transaction announcements, run alongside the gossip topic
+	announcer       *txAnnouncer
+	fetcher         *txFetcher
+	announceService *txAnnounceService
+
 	// indicates which txpool operator commands should be implemented
 	proto.UnimplementedTxnPoolOperatorServer
 
@@ -220,6 +295,22 @@ func newDeploymentWhitelist(deploymentWhitelistRaw []types.Address) deploymentWh
 	return deploymentWhitelist
 }
 
+// policyStorage reads the storage of the transaction policy contract at the given state root
+type policyStorage struct {
+	store store
+	root  types.Hash
+}
+
+// GetState returns the value of the storage slot, or the zero hash if it can't be read
+func (s *policyStorage) GetState(addr types.Address, key types.Hash) types.Hash {
+	value, err := s.store.GetStorage(s.root, addr, key)
+	if err != nil {
+		return types.ZeroHash
+	}
+
+	return value
+}
+
 // NewTxPool returns a new pool for processing incoming transactions.
 func NewTxPool(
 	logger hclog.Logger,
@@ -229,15 +320,33 @@ func NewTxPool(
 	network *network.Server,
 	config *Config,
 ) (*TxPool, error) {
+	ordering := config.TxOrdering
+	if ordering == nil {
+		ordering = &priceOrdering{}
+	}
+
 	pool := &TxPool{
 		logger:      logger.Named("txpool"),
 		forks:       forks,
 		store:       store,
-		executables: newPricedQueue(),
+		executables: newExecutablesQueue(ordering),
 		accounts:    accountsMap{maxEnqueuedLimit: config.MaxAccountEnqueued},
 		index:       lookupMap{all: make(map[types.Hash]*types.Transaction)},
 		gauge:       slotGauge{height: 0, max: config.MaxSlots},
 		priceLimit:  config.PriceLimit,
+		priceBump:   config.PriceBump,
+
+		lifetime:         config.Lifetime,
+		lifetimePromoted: config.LifetimePromoted,
+		lifetimeLocals:   config.LifetimeLocals,
+
+		txPolicy: config.TxPolicy,
+		ordering: ordering,
+
+		privateTxs:         newPrivateTxs(),
+		bundles:            newBundlePool(),
+		privateTxMaxBlocks: config.PrivateTxMaxBlocks,
+		privateTxPeers:     config.PrivateTxPeers,
 
 		//	main loop channels
 		enqueueReqCh: make(chan enqueueRequest),
@@ -249,6 +358,19 @@ func NewTxPool(
 	// Attach the event manager
 	pool.eventManager = newEventManager(pool.logger)
 
+	if pool.privateTxMaxBlocks == 0 {
+		pool.privateTxMaxBlocks = defaultPrivateTxMaxBlocks
+	}
+
+	if config.JournalPath != "" {
+		pool.journal = newJournal(config.JournalPath)
+		pool.rejournalInterval = config.RejournalInterval
+
+		if pool.rejournalInterval <= 0 {
+			pool.rejournalInterval = defaultRejournalInterval
+		}
+	}
+
 	if network != nil {
 		// subscribe to the gossip protocol
 		topic, err := network.NewTopic(topicNameV1, &proto.Txn{})
@@ -261,6 +383,13 @@ func NewTxPool(
 		}
 
 		pool.topic = topic
+
+		// register the protocol the private transactions are forwarded over
+		pool.privateNetwork = network
+		pool.startPrivateTxService()
+
+		// register the protocol the transactions are announced and fetched over
+		pool.startTxAnnounceService(network)
 	}
 
 	// initialize deployment whitelist
@@ -303,6 +432,16 @@ func (p *TxPool) Start() {
 
 	//	run the handler for the tx pipeline
 	go func() {
+		// the expired transactions are swept only if the lifetime is set
+		var expiryCh <-chan time.Time
+
+		if p.lifetime > 0 {
+			ticker := time.NewTicker(p.lifetimeSweepInterval())
+			defer ticker.Stop()
+
+			expiryCh = ticker.C
+		}
+
 		for {
 			select {
 			case <-p.shutdownCh:
@@ -311,15 +450,237 @@ func (p *TxPool) Start() {
 				go p.handleEnqueueRequest(req)
 			case req := <-p.promoteReqCh:
 				go p.handlePromoteRequest(req)
+			case <-expiryCh:
+				go p.expireTransactions()
 			}
 		}
 	}()
+
+	if p.journal != nil {
+		p.startJournal()
+	}
+
+	if p.announcer != nil {
+		p.startTxAnnouncer()
+	}
 }
 
 // Close shuts down the pool's main loop.
 func (p *TxPool) Close() {
 	p.eventManager.Close()
-	p.shutdownCh <- struct{}{}
+	close(p.shutdownCh)
+
+	if p.privateService != nil {
+		if err := p.privateService.stream.Close(); err != nil {
+			p.logger.Error("failed to close the private transactions stream", "err", err)
+		}
+	}
+
+	if p.announceService != nil {
+		if err := p.announceService.stream.Close(); err != nil {
+			p.logger.Error("failed to close the transaction announcements stream", "err", err)
+		}
+	}
+}
+
+// startJournal replays the journaled local transactions into the pool
+// and runs the handler for the periodic journal regeneration.
+func (p *TxPool) startJournal() {
+	loaded, dropped, err := p.journal.load(func(tx *types.Transaction) error {
+		return p.addTx(local, tx)
+	})
+	if err != nil {
+		p.logger.Error("failed to load the transaction journal", "err", err)
+	}
+
+	p.logger.Info("loaded the transaction journal", "transactions", loaded, "dropped", dropped)
+
+	p.rotateJournal()
+
+	go func() {
+		ticker := time.NewTicker(p.rejournalInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-p.shutdownCh:
+				if err := p.journal.close(); err != nil {
+					p.logger.Error("failed to close the transaction journal", "err", err)
+				}
+
+				return
+			case <-ticker.C:
+				p.rotateJournal()
+			}
+		}
+	}()
+}
+
+// rotateJournal regenerates the journal out of the local transactions currently in the pool
+func (p *TxPool) rotateJournal() {
+	txs := p.localTxs()
+
+	if err := p.journal.rotate(txs); err != nil {
+		p.logger.Error("failed to rotate the transaction journal", "err", err)
+
+		return
+	}
+
+	p.logger.Debug("rotated the transaction journal", "transactions", len(txs))
+}
+
+// journalTx marks the sender of the local transaction as local
+// and appends the transaction to the journal (if enabled)
+func (p *TxPool) journalTx(origin txOrigin, tx *types.Transaction) {
+	if origin != local {
+		return
+	}
+
+	p.locals.Store(tx.From, struct{}{})
+
+	if p.journal == nil {
+		return
+	}
+
+	if err := p.journal.insert(tx); err != nil && !errors.Is(err, errNoActiveJournal) {
+		p.logger.Error("failed to journal the local transaction", "hash", tx.Hash.String(), "err", err)
+	}
+}
+
+// dropAccountTxs drops the account's enqueued transactions matching the predicate,
+// and the promoted ones too if includePromoted is set. The promoted transactions following
+// the dropped one are demoted back to the enqueued queue, so that no nonce gap is left.
+// Returns the dropped and the demoted transactions.
+func (p *TxPool) dropAccountTxs(
+	account *account,
+	includePromoted bool,
+	drop func(tx *types.Transaction) bool,
+) (dropped []*types.Transaction, demoted []*types.Transaction) {
+	account.promoted.lock(true)
+	account.enqueued.lock(true)
+
+	defer func() {
+		account.enqueued.unlock()
+		account.promoted.unlock()
+	}()
+
+	for _, tx := range filterTxs(account.enqueued, drop) {
+		account.enqueued.remove(tx)
+
+		dropped = append(dropped, tx)
+	}
+
+	if includePromoted {
+		var first *types.Transaction
+
+		for _, tx := range filterTxs(account.promoted, drop) {
+			if first == nil || tx.Nonce < first.Nonce {
+				first = tx
+			}
+		}
+
+		if first != nil {
+			promotedDropped, promotedDemoted := p.dropPromotedFrom(account, first.Nonce, drop)
+
+			dropped = append(dropped, promotedDropped...)
+			demoted = promotedDemoted
+		}
+	}
+
+	if len(dropped) == 0 {
+		return nil, nil
+	}
+
+	p.index.remove(dropped...)
+	p.gauge.decrease(slotsRequired(dropped...))
+
+	return dropped, demoted
+}
+
+// dropPromotedFrom removes the account's promoted transactions starting from the given nonce,
+// dropping the ones matching the predicate and demoting the rest. Returns the dropped and the demoted transactions.
+func (p *TxPool) dropPromotedFrom(account *account, nonce uint64, drop func(tx *types.Transaction) bool) (
+	dropped []*types.Transaction,
+	demoted []*types.Transaction,
+) {
+	removed := make([]*types.Transaction, 0)
+
+	for _, tx := range account.promoted.queue {
+		if tx.Nonce >= nonce {
+			removed = append(removed, tx)
+		}
+	}
+
+	for _, tx := range removed {
+		account.promoted.remove(tx)
+		p.executables.remove(tx)
+
+		if drop(tx) {
+			dropped = append(dropped, tx)
+		} else {
+			account.enqueued.push(tx)
+			demoted = append(demoted, tx)
+		}
+	}
+
+	account.setNonce(nonce)
+	p.updatePending(-1 * int64(len(removed)))
+
+	return dropped, demoted
+}
+
+// filterTxs returns the transactions of the queue matching the predicate
+func filterTxs(queue *accountQueue, match func(tx *types.Transaction) bool) []*types.Transaction {
+	matched := make([]*types.Transaction, 0)
+
+	for _, tx := range queue.queue {
+		if match(tx) {
+			matched = append(matched, tx)
+		}
+	}
+
+	return matched
+}
+
+// localTxs returns the promoted and enqueued transactions of the local accounts, sorted by nonce
+func (p *TxPool) localTxs() []*types.Transaction {
+	txs := make([]*types.Transaction, 0)
+
+	p.locals.Range(func(key, _ interface{}) bool {
+		addr, _ := key.(types.Address)
+
+		account := p.accounts.get(addr)
+		if account == nil {
+			return true
+		}
+
+		account.promoted.lock(false)
+		account.enqueued.lock(false)
+
+		accountTxs := make([]*types.Transaction, 0, account.promoted.length()+account.enqueued.length())
+
+		// the private txs are not journaled, as their max block is not persisted
+		for _, queue := range []*accountQueue{account.promoted, account.enqueued} {
+			for _, tx := range queue.queue {
+				if !p.privateTxs.has(tx.Hash) {
+					accountTxs = append(accountTxs, tx)
+				}
+			}
+		}
+
+		account.enqueued.unlock()
+		account.promoted.unlock()
+
+		sort.Slice(accountTxs, func(i, j int) bool {
+			return accountTxs[i].Nonce < accountTxs[j].Nonce
+		})
+
+		txs = append(txs, accountTxs...)
+
+		return true
+	})
+
+	return txs
 }
 
 // SetSigner sets the signer the pool will use
@@ -356,49 +717,69 @@ func (p *TxPool) AddTx(tx *types.Transaction) error {
 		return err
 	}
 
-	// broadcast the transaction only if a topic
-	// subscription is present
-	if p.topic != nil {
-		tx := &proto.Txn{
-			Raw: &any.Any{
-				Value: tx.MarshalRLP(),
-			},
-		}
+	p.publishTx(tx)
 
-		if err := p.topic.Publish(tx); err != nil {
-			p.logger.Error("failed to topic tx", "err", err)
-		}
-	}
+	// announce the transaction to the peers which support the announcements
+	p.announceTx(tx)
 
 	return nil
 }
 
+// publishTx broadcasts the transaction over the gossip topic, only if a topic subscription is present.
+// The topic keeps running alongside the announcements while the nodes migrate to them, as it relays
+// the transactions over the nodes which don't pick them up, such as the sentries which don't have a sealer as a peer
+func (p *TxPool) publishTx(tx *types.Transaction) {
+	if p.topic == nil {
+		return
+	}
+
+	msg := &proto.Txn{
+		Raw: &any.Any{
+			Value: tx.MarshalRLP(),
+		},
+	}
+
+	if err := p.topic.Publish(msg); err != nil {
+		p.logger.Error("failed to topic tx", "err", err)
+	}
+}
+
 // Prepare generates all the transactions
 // ready for execution. (primaries)
 func (p *TxPool) Prepare() {
 	// clear from previous round
-	if p.executables.length() != 0 {
-		p.executables.clear()
-	}
+	p.executables.clear()
 
 	// fetch primary from each account
 	primaries := p.accounts.getPrimaries()
 
 	// push primaries to the executables queue
 	for _, tx := range primaries {
-		p.executables.push(tx)
+		p.pushExecutable(tx)
 	}
 }
 
-// Peek returns the best-price selected
-// transaction ready for execution.
+// pushExecutable pushes the primary onto the executables queue
+func (p *TxPool) pushExecutable(tx *types.Transaction) {
+	arrival, _ := p.index.arrival(tx.Hash)
+
+	p.executables.push(tx, arrival)
+}
+
+// OrderingPolicy returns the name of the policy the transactions are picked for the block building by
+func (p *TxPool) OrderingPolicy() string {
+	return p.ordering.Name()
+}
+
+// Peek returns the first transaction ready for execution,
+// selected by the ordering policy.
 func (p *TxPool) Peek() *types.Transaction {
 	// Popping the executables queue
 	// does not remove the actual tx
 	// from the pool.
 	// The executables queue just provides
-	// insight into which account has the
-	// highest priced tx (head of promoted queue)
+	// insight into which account's tx
+	// (head of promoted queue) goes next
 	return p.executables.pop()
 }
 
@@ -414,20 +795,37 @@ func (p *TxPool) Pop(tx *types.Transaction) {
 	defer account.promoted.unlock()
 
 	// pop the top most promoted tx
-	account.promoted.pop()
+	popped := account.promoted.pop()
+	if popped == nil {
+		// the tx has been evicted in the meantime
+		return
+	}
 
 	// successfully popping an account resets its demotions count to 0
 	account.resetDemotions()
 
+	// the executed tx has been replaced in the meantime,
+	// so its replacement with the same nonce is stale now
+	if popped.Hash != tx.Hash {
+		p.index.remove(popped)
+
+		tx = popped
+	}
+
 	// update state
 	p.gauge.decrease(slotsRequired(tx))
 
 	// update metrics
 	p.updatePending(-1)
 
+	// the sender's next tx waits for the next block, once the sender cap of the ordering policy is reached
+	if !p.executables.pick(tx.From) {
+		return
+	}
+
 	// update executables
 	if tx := account.promoted.peek(); tx != nil {
-		p.executables.push(tx)
+		p.pushExecutable(tx)
 	}
 }
 
@@ -514,12 +912,53 @@ func (p *TxPool) ResetWithHeaders(headers ...*types.Header) {
 	})
 }
 
+// Rewind drops all the transactions and bundles from the pool, and sets the accounts' nonces
+// to the ones at the current head. It is meant for the dev chains rewound to an earlier block,
+// where the nonces may have gone back
+func (p *TxPool) Rewind() {
+	stateRoot := p.store.Header().StateRoot
+
+	p.accounts.Range(func(key, value interface{}) bool {
+		addr, _ := key.(types.Address)
+		account, _ := value.(*account)
+
+		dropped, _ := p.dropAccountTxs(account, true, func(*types.Transaction) bool {
+			return true
+		})
+
+		account.setNonce(p.store.GetNonce(stateRoot, addr))
+
+		if len(dropped) == 0 {
+			return true
+		}
+
+		hashes := make([]types.Hash, len(dropped))
+		for i, tx := range dropped {
+			hashes[i] = tx.Hash
+		}
+
+		p.eventManager.signalEvent(proto.EventType_DROPPED, hashes...)
+
+		return true
+	})
+
+	p.dropPrivateTxs(p.store.Header().Number)
+	p.bundles.remove(func(*Bundle) bool {
+		return true
+	})
+
+	metrics.SetGauge([]string{txPoolMetrics, "bundles"}, 0)
+
+	p.logger.Debug("rewound pool", "head", p.store.Header().Number)
+}
+
 // processEvent collects the latest nonces for each account containted
 // in the received event. Resets all known accounts with the new nonce.
 func (p *TxPool) processEvent(event *blockchain.Event) {
 	// Grab the latest state root now that the block has been inserted
 	stateRoot := p.store.Header().StateRoot
 	stateNonces := make(map[types.Address]uint64)
+	mined := make(map[types.Hash]struct{})
 
 	// discover latest (next) nonces for all accounts
 	for _, header := range event.NewChain {
@@ -537,6 +976,8 @@ func (p *TxPool) processEvent(event *blockchain.Event) {
 		for _, tx := range block.Transactions {
 			var err error
 
+			mined[tx.Hash] = struct{}{}
+
 			addr := tx.From
 			if addr == types.ZeroAddress {
 				// From field is not set, extract the signer
@@ -565,6 +1006,12 @@ func (p *TxPool) processEvent(event *blockchain.Event) {
 	// reset accounts with the new state
 	p.resetAccounts(stateNonces)
 
+	// drop the private txs which can't be included anymore
+	p.dropPrivateTxs(p.store.Header().Number)
+
+	// drop the bundles which are mined or can't be included anymore
+	p.pruneBundles(p.store.Header().Number, mined)
+
 	if !p.getSealing() {
 		// only non-validator cleanup inactive accounts
 		p.updateAccountSkipsCounts(stateNonces)
@@ -623,6 +1070,11 @@ func (p *TxPool) validateTx(tx *types.Transaction) error {
 	// Grab the state root for the latest block
 	stateRoot := p.store.Header().StateRoot
 
+	// Check if the transaction is admitted by the transaction policy
+	if err := p.txPolicy.Check(tx, &policyStorage{store: p.store, root: stateRoot}); err != nil {
+		return err
+	}
+
 	// Check nonce ordering
 	if p.store.GetNonce(stateRoot, tx.From) > tx.Nonce {
 		return ErrNonceTooLow
@@ -718,12 +1170,23 @@ func (p *TxPool) addTx(origin txOrigin, tx *types.Transaction) error {
 		}
 	}
 
-	// check for overflow
-	if p.gauge.read()+slotsRequired(tx) > p.gauge.max {
-		return ErrTxPoolOverflow
+	tx.ComputeHash()
+
+	// replace the pending tx with the same nonce, if there is any
+	if replacing, err := p.replaceTx(tx); replacing {
+		if err == nil {
+			p.journalTx(origin, tx)
+		}
+
+		return err
 	}
 
-	tx.ComputeHash()
+	// check for overflow, making room by evicting the cheaper remote txs if possible
+	if p.gauge.read()+slotsRequired(tx) > p.gauge.max {
+		if err := p.evictUnderpriced(tx, 0); err != nil {
+			return err
+		}
+	}
 
 	// add to index
 	if ok := p.index.add(tx); !ok {
@@ -733,6 +1196,8 @@ func (p *TxPool) addTx(origin txOrigin, tx *types.Transaction) error {
 	// initialize account for this address once
 	p.createAccountOnce(tx.From)
 
+	p.journalTx(origin, tx)
+
 	// send request [BLOCKING]
 	p.enqueueReqCh <- enqueueRequest{tx: tx}
 	p.eventManager.signalEvent(proto.EventType_ADDED, tx.Hash)
@@ -740,6 +1205,126 @@ func (p *TxPool) addTx(origin txOrigin, tx *types.Transaction) error {
 	return nil
 }
 
+// replaceTx swaps the promoted or enqueued transaction with the same sender and nonce
+// for the given one, if the given one's gas price exceeds it by the configured price bump.
+// If the replacement requires more slots than the full pool has left, the cheaper remote txs are evicted.
+// Returns false if there is no transaction to replace.
+func (p *TxPool) replaceTx(tx *types.Transaction) (bool, error) {
+	account := p.accounts.get(tx.From)
+	if account == nil {
+		return false, nil
+	}
+
+	// make room for the replacement before the account is locked,
+	// since the eviction locks the queues of the other accounts
+	account.promoted.lock(false)
+	account.enqueued.lock(false)
+	existing, _ := account.getByNonce(tx.Nonce)
+	account.enqueued.unlock()
+	account.promoted.unlock()
+
+	if existing == nil {
+		return false, nil
+	}
+
+	if err := p.checkReplacement(tx, existing); err != nil {
+		return true, err
+	}
+
+	if err := p.evictUnderpriced(tx, slotsRequired(existing)); err != nil {
+		return true, err
+	}
+
+	account.promoted.lock(true)
+	account.enqueued.lock(true)
+
+	defer func() {
+		account.enqueued.unlock()
+		account.promoted.unlock()
+	}()
+
+	// the tx to replace could have been replaced or removed in the meantime
+	existing, queue := account.getByNonce(tx.Nonce)
+	if existing == nil {
+		return false, nil
+	}
+
+	if err := p.checkReplacement(tx, existing); err != nil {
+		return true, err
+	}
+
+	if p.gauge.read()+slotsRequired(tx) > p.gauge.max+slotsRequired(existing) {
+		return true, ErrTxPoolOverflow
+	}
+
+	if ok := p.index.add(tx); !ok {
+		return true, ErrAlreadyKnown
+	}
+
+	p.eventManager.signalEvent(proto.EventType_ADDED, tx.Hash)
+	p.swapTx(account, queue, existing, tx)
+
+	return true, nil
+}
+
+// checkReplacement checks the given transaction can replace the existing one with the same nonce
+func (p *TxPool) checkReplacement(tx, existing *types.Transaction) error {
+	if existing.Hash == tx.Hash {
+		return ErrAlreadyKnown
+	}
+
+	if !p.exceedsPriceBump(tx, existing) {
+		return ErrReplacementUnderpriced
+	}
+
+	return nil
+}
+
+// swapTx replaces the existing transaction in the given account queue for the given one,
+// which must be already indexed. The caller must hold the locks of the account queues.
+func (p *TxPool) swapTx(account *account, queue *accountQueue, existing, tx *types.Transaction) {
+	event := proto.EventType_ENQUEUED
+	if queue == account.promoted {
+		event = proto.EventType_PROMOTED
+	}
+
+	queue.replace(tx)
+
+	// the replaced tx leaves the pool
+	p.index.remove(existing)
+	p.gauge.decrease(slotsRequired(existing))
+	p.gauge.increase(slotsRequired(tx))
+
+	// the replaced tx might be already selected for the block building
+	if queue == account.promoted {
+		arrival, _ := p.index.arrival(tx.Hash)
+
+		p.executables.replace(existing, tx, arrival)
+	}
+
+	p.logger.Debug("replace tx",
+		"replaced", existing.Hash.String(),
+		"hash", tx.Hash.String(),
+		"nonce", tx.Nonce,
+	)
+
+	p.eventManager.signalEvent(proto.EventType_REPLACED, existing.Hash)
+	p.eventManager.signalEvent(event, tx.Hash)
+}
+
+// exceedsPriceBump checks if the gas price of the replacement transaction
+// is higher than the existing one's by at least the price bump percentage.
+func (p *TxPool) exceedsPriceBump(replacement, existing *types.Transaction) bool {
+	if replacement.GasPrice.Cmp(existing.GasPrice) <= 0 {
+		return false
+	}
+
+	threshold := new(big.Int).Mul(existing.GasPrice, new(big.Int).SetUint64(100+p.priceBump))
+	threshold.Div(threshold, big.NewInt(100))
+
+	return replacement.GasPrice.Cmp(threshold) >= 0
+}
+
 // handleEnqueueRequest attempts to enqueue the transaction
 // contained in the given request to the associated account.
 // If, afterwards, the account is eligible for promotion,
@@ -751,8 +1336,20 @@ func (p *TxPool) handleEnqueueRequest(req enqueueRequest) {
 	// fetch account
 	account := p.accounts.get(addr)
 
-	// enqueue tx
-	if err := account.enqueue(tx); err != nil {
+	replaced := false
+
+	// enqueue tx, replacing the same nonce tx added since the replacement check of addTx
+	if err := account.enqueue(tx, func(existing *types.Transaction, queue *accountQueue) error {
+		if err := p.checkReplacement(tx, existing); err != nil {
+			return err
+		}
+
+		p.swapTx(account, queue, existing, tx)
+
+		replaced = true
+
+		return nil
+	}); err != nil {
 		p.logger.Error("enqueue request", "err", err)
 
 		p.index.remove(tx)
@@ -760,6 +1357,10 @@ func (p *TxPool) handleEnqueueRequest(req enqueueRequest) {
 		return
 	}
 
+	if replaced {
+		return
+	}
+
 	p.logger.Debug("enqueue request", "hash", tx.Hash.String())
 
 	p.gauge.increase(slotsRequired(tx))
@@ -797,7 +1398,7 @@ func (p *TxPool) handlePromoteRequest(req promoteRequest) {
 
 // addGossipTx handles receiving transactions
 // gossiped by the network.
-func (p *TxPool) addGossipTx(obj interface{}, _ peer.ID) {
+func (p *TxPool) addGossipTx(obj interface{}, peerID peer.ID) {
 	if !p.getSealing() {
 		return
 	}
@@ -834,6 +1435,14 @@ func (p *TxPool) addGossipTx(obj interface{}, _ peer.ID) {
 		}
 
 		p.logger.Error("failed to add broadcast tx", "err", err, "hash", tx.Hash.String())
+
+		return
+	}
+
+	// the transaction is relayed to the other subscribers by the gossip topic itself,
+	// so it is not announced
+	if p.announcer != nil {
+		p.announcer.markKnown(peerID, tx.Hash)
 	}
 }
 
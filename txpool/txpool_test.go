@@ -327,6 +327,48 @@ func TestAddTxErrors(t *testing.T) {
 			ErrInsufficientFunds,
 		)
 	})
+
+	t.Run("ErrTxPolicySenderDenied", func(t *testing.T) {
+		t.Parallel()
+		pool := setupPool()
+
+		txPolicy, err := state.NewTxPolicy(&chain.TxPolicy{
+			SenderDenyList: []types.Address{defaultAddr},
+		})
+		assert.NoError(t, err)
+
+		pool.txPolicy = txPolicy
+
+		tx := newTx(defaultAddr, 0, 1)
+		tx = signTx(tx)
+
+		assert.ErrorIs(t,
+			pool.addTx(local, tx),
+			state.ErrTxPolicySenderDenied,
+		)
+	})
+
+	t.Run("ErrTxPolicyMethodDenied", func(t *testing.T) {
+		t.Parallel()
+		pool := setupPool()
+
+		txPolicy, err := state.NewTxPolicy(&chain.TxPolicy{
+			MethodDenyList: []string{"0xa9059cbb"},
+		})
+		assert.NoError(t, err)
+
+		pool.txPolicy = txPolicy
+
+		tx := newTx(defaultAddr, 0, 1)
+		tx.To = &addr2
+		tx.Input = []byte{0xa9, 0x05, 0x9c, 0xbb}
+		tx = signTx(tx)
+
+		assert.ErrorIs(t,
+			pool.addTx(local, tx),
+			state.ErrTxPolicyMethodDenied,
+		)
+	})
 }
 
 func TestPruneAccountsWithNonceHoles(t *testing.T) {
@@ -864,7 +906,7 @@ func TestPromoteHandler(t *testing.T) {
 	})
 
 	t.Run(
-		"promote handler discards cheaper tx",
+		"enqueue handler replaces cheaper same nonce tx",
 		func(t *testing.T) {
 			t.Parallel()
 
@@ -926,25 +968,13 @@ func TestPromoteHandler(t *testing.T) {
 			// check the account nonce before promoting
 			assert.Equal(t, uint64(0), pool.accounts.get(addr1).getNonce())
 
-			//	execute the enqueue handlers
-			promReq1 := handleEnqueueRequest(enqTx1)
-			promReq2 := handleEnqueueRequest(enqTx2)
+			//	execute the enqueue handlers, the second tx replaces the first one
+			promReq := handleEnqueueRequest(enqTx1)
+			pool.handleEnqueueRequest(enqTx2)
 
 			assert.Equal(t, uint64(0), pool.accounts.get(addr1).getNonce())
-			assert.Equal(t, uint64(2), pool.accounts.get(addr1).enqueued.length())
+			assert.Equal(t, uint64(1), pool.accounts.get(addr1).enqueued.length())
 			assert.Equal(t, uint64(0), pool.accounts.get(addr1).promoted.length())
-			assert.Equal(
-				t,
-				slotsRequired(tx1)+slotsRequired(tx2),
-				pool.gauge.read(),
-			)
-
-			// promote the second Tx and remove the first Tx
-			pool.handlePromoteRequest(promReq1)
-
-			assert.Equal(t, uint64(1), pool.accounts.get(addr1).getNonce())
-			assert.Equal(t, uint64(0), pool.accounts.get(addr1).enqueued.length()) // should be empty
-			assert.Equal(t, uint64(1), pool.accounts.get(addr1).promoted.length())
 			assertTxExists(t, tx1, false)
 			assertTxExists(t, tx2, true)
 			assert.Equal(
@@ -953,8 +983,8 @@ func TestPromoteHandler(t *testing.T) {
 				pool.gauge.read(),
 			)
 
-			// should do nothing in the 2nd promotion
-			pool.handlePromoteRequest(promReq2)
+			// promote the second Tx
+			pool.handlePromoteRequest(promReq)
 
 			assert.Equal(t, uint64(1), pool.accounts.get(addr1).getNonce())
 			assert.Equal(t, uint64(0), pool.accounts.get(addr1).enqueued.length())
@@ -1438,6 +1468,260 @@ func TestDrop(t *testing.T) {
 	assert.Equal(t, uint64(0), pool.accounts.get(addr1).promoted.length())
 }
 
+func TestRewind(t *testing.T) {
+	t.Parallel()
+
+	pool, err := newTestPool()
+	assert.NoError(t, err)
+	pool.SetSigner(&mockSigner{})
+
+	// send 1 promoted and 1 enqueued tx
+	go func() {
+		err := pool.addTx(local, newTx(addr1, 0, 1))
+		assert.NoError(t, err)
+	}()
+	go pool.handleEnqueueRequest(<-pool.enqueueReqCh)
+	pool.handlePromoteRequest(<-pool.promoteReqCh)
+
+	go func() {
+		err := pool.addTx(local, newTx(addr1, 2, 1))
+		assert.NoError(t, err)
+	}()
+	pool.handleEnqueueRequest(<-pool.enqueueReqCh)
+
+	_, err = pool.AddBundle([]*types.Transaction{newTx(addr2, 0, 1)}, 0, 0, nil)
+	assert.NoError(t, err)
+
+	assert.Equal(t, uint64(2), pool.gauge.read())
+	assert.Equal(t, uint64(1), pool.accounts.get(addr1).getNonce())
+
+	// the nonces are taken from the state of the head again
+	pool.Rewind()
+
+	assert.Equal(t, uint64(0), pool.gauge.read())
+	assert.Equal(t, uint64(0), pool.accounts.get(addr1).getNonce())
+	assert.Equal(t, uint64(0), pool.accounts.get(addr1).promoted.length())
+	assert.Equal(t, uint64(0), pool.accounts.get(addr1).enqueued.length())
+	assert.Empty(t, pool.Bundles(mockHeader.Number+1))
+}
+
+func TestReplaceTx(t *testing.T) {
+	t.Parallel()
+
+	withGasPrice := func(tx *types.Transaction, gasPrice uint64) *types.Transaction {
+		tx.GasPrice = new(big.Int).SetUint64(gasPrice)
+
+		return tx
+	}
+
+	t.Run(
+		"replace enqueued tx",
+		func(t *testing.T) {
+			t.Parallel()
+
+			pool, err := newTestPool()
+			assert.NoError(t, err)
+			pool.SetSigner(&mockSigner{})
+
+			original := newTx(addr1, 10, 1)
+
+			go func() {
+				err := pool.addTx(local, original)
+				assert.NoError(t, err)
+			}()
+			pool.handleEnqueueRequest(<-pool.enqueueReqCh)
+
+			replacement := withGasPrice(newTx(addr1, 10, 2), 2)
+			assert.NoError(t, pool.addTx(local, replacement))
+
+			_, ok := pool.index.get(original.Hash)
+			assert.False(t, ok)
+
+			_, ok = pool.index.get(replacement.Hash)
+			assert.True(t, ok)
+
+			assert.Equal(t, uint64(2), pool.gauge.read())
+			assert.Equal(t, uint64(1), pool.accounts.get(addr1).enqueued.length())
+			assert.Equal(t, replacement, pool.accounts.get(addr1).enqueued.peek())
+		},
+	)
+
+	t.Run(
+		"replace promoted tx selected for the block",
+		func(t *testing.T) {
+			t.Parallel()
+
+			pool, err := newTestPool()
+			assert.NoError(t, err)
+			pool.SetSigner(&mockSigner{})
+
+			go func() {
+				err := pool.addTx(local, newTx(addr1, 0, 1))
+				assert.NoError(t, err)
+			}()
+			go pool.handleEnqueueRequest(<-pool.enqueueReqCh)
+			pool.handlePromoteRequest(<-pool.promoteReqCh)
+
+			pool.Prepare()
+
+			replacement := withGasPrice(newTx(addr1, 0, 1), 2)
+			assert.NoError(t, pool.addTx(local, replacement))
+
+			assert.Equal(t, uint64(1), pool.gauge.read())
+			assert.Equal(t, uint64(1), pool.accounts.get(addr1).promoted.length())
+
+			// the replacement is executed instead of the original tx
+			tx := pool.Peek()
+			assert.Equal(t, replacement.Hash, tx.Hash)
+
+			pool.Pop(tx)
+
+			assert.Equal(t, uint64(0), pool.gauge.read())
+			assert.Equal(t, uint64(0), pool.accounts.get(addr1).promoted.length())
+		},
+	)
+
+	t.Run(
+		"pop tx replaced during the block building",
+		func(t *testing.T) {
+			t.Parallel()
+
+			pool, err := newTestPool()
+			assert.NoError(t, err)
+			pool.SetSigner(&mockSigner{})
+
+			go func() {
+				err := pool.addTx(local, newTx(addr1, 0, 1))
+				assert.NoError(t, err)
+			}()
+			go pool.handleEnqueueRequest(<-pool.enqueueReqCh)
+			pool.handlePromoteRequest(<-pool.promoteReqCh)
+
+			pool.Prepare()
+			tx := pool.Peek()
+
+			assert.NoError(t, pool.addTx(local, withGasPrice(newTx(addr1, 0, 1), 2)))
+
+			// the original tx was executed, so its replacement is stale
+			pool.Pop(tx)
+
+			assert.Equal(t, uint64(0), pool.gauge.read())
+			assert.Equal(t, uint64(0), pool.accounts.get(addr1).promoted.length())
+			assert.Len(t, pool.index.all, 0)
+		},
+	)
+
+	t.Run(
+		"reject underpriced replacement",
+		func(t *testing.T) {
+			t.Parallel()
+
+			pool, err := newTestPool()
+			assert.NoError(t, err)
+			pool.SetSigner(&mockSigner{})
+
+			pool.priceBump = 10
+
+			original := withGasPrice(newTx(addr1, 10, 1), 100)
+
+			go func() {
+				err := pool.addTx(local, original)
+				assert.NoError(t, err)
+			}()
+			pool.handleEnqueueRequest(<-pool.enqueueReqCh)
+
+			assert.ErrorIs(t,
+				pool.addTx(local, withGasPrice(newTx(addr1, 10, 1), 109)),
+				ErrReplacementUnderpriced,
+			)
+
+			assert.ErrorIs(t,
+				pool.addTx(local, original.Copy()),
+				ErrAlreadyKnown,
+			)
+
+			assert.Equal(t, uint64(1), pool.gauge.read())
+			assert.Equal(t, original, pool.accounts.get(addr1).enqueued.peek())
+
+			assert.NoError(t, pool.addTx(local, withGasPrice(newTx(addr1, 10, 1), 110)))
+		},
+	)
+
+	t.Run(
+		"replace same nonce tx added concurrently",
+		func(t *testing.T) {
+			t.Parallel()
+
+			pool, err := newTestPool()
+			assert.NoError(t, err)
+			pool.SetSigner(&mockSigner{})
+
+			var (
+				first       = newTx(addr1, 10, 1)
+				replacement = withGasPrice(newTx(addr1, 10, 1), 2)
+				underpriced = withGasPrice(newTx(addr1, 10, 1), 2)
+			)
+
+			// all the txs pass the replacement check of addTx before any of them is enqueued
+			requests := make([]enqueueRequest, 0, 3)
+
+			for _, tx := range []*types.Transaction{first, replacement, underpriced} {
+				go func(tx *types.Transaction) {
+					assert.NoError(t, pool.addTx(local, tx))
+				}(tx)
+
+				requests = append(requests, <-pool.enqueueReqCh)
+			}
+
+			for _, req := range requests {
+				pool.handleEnqueueRequest(req)
+			}
+
+			assert.Equal(t, uint64(1), pool.gauge.read())
+			assert.Equal(t, uint64(1), pool.accounts.get(addr1).enqueued.length())
+			assert.Len(t, pool.index.all, 1)
+
+			_, ok := pool.index.get(replacement.Hash)
+			assert.True(t, ok)
+		},
+	)
+
+	t.Run(
+		"signal replaced event",
+		func(t *testing.T) {
+			t.Parallel()
+
+			pool, err := newTestPool()
+			assert.NoError(t, err)
+			pool.SetSigner(&mockSigner{})
+
+			original := newTx(addr1, 10, 1)
+
+			go func() {
+				err := pool.addTx(local, original)
+				assert.NoError(t, err)
+			}()
+			pool.handleEnqueueRequest(<-pool.enqueueReqCh)
+
+			subscription := pool.eventManager.subscribe(
+				[]proto.EventType{
+					proto.EventType_REPLACED,
+				},
+			)
+			defer pool.eventManager.cancelSubscription(subscription.subscriptionID)
+
+			assert.NoError(t, pool.addTx(local, withGasPrice(newTx(addr1, 10, 1), 2)))
+
+			ctx, cancelFn := context.WithTimeout(context.Background(), time.Second*5)
+			defer cancelFn()
+
+			events := waitForEvents(ctx, subscription, 1)
+			assert.Len(t, events, 1)
+			assert.Equal(t, original.Hash.String(), events[0].TxHash)
+		},
+	)
+}
+
 func TestDemote(t *testing.T) {
 	t.Parallel()
 
@@ -29,6 +29,9 @@ type Receipt struct {
 	ContractAddress *Address
 	TxHash          Hash
 
+	// RevertReason is the revert payload of the reverted transaction, kept only if the node stores it
+	RevertReason []byte
+
 	TransactionType TxType
 }
 
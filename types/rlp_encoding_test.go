@@ -95,6 +95,27 @@ func TestRLPStorage_Marshall_And_Unmarshall_Receipt(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"Marshal receipt with revert reason",
+			&Receipt{
+				CumulativeGasUsed: 10,
+				GasUsed:           100,
+				TxHash:            hash,
+				RevertReason:      []byte{0x4e, 0x48, 0x7b, 0x71},
+			},
+			true,
+		},
+		{
+			"Marshal typed receipt with revert reason",
+			&Receipt{
+				CumulativeGasUsed: 10,
+				GasUsed:           100,
+				TxHash:            hash,
+				TransactionType:   StateTx,
+				RevertReason:      []byte{0x01},
+			},
+			true,
+		},
 	}
 
 	for _, testCase := range testTable {
@@ -95,5 +95,10 @@ func (r *Receipt) marshalStoreRLPWith(a *fastrlp.Arena) *fastrlp.Value {
 	// TxHash
 	vv.Set(a.NewBytes(r.TxHash.Bytes()))
 
+	// the revert reason is optional, so the receipts without it keep the previous format
+	if len(r.RevertReason) != 0 {
+		vv.Set(a.NewCopyBytes(r.RevertReason))
+	}
+
 	return vv
 }
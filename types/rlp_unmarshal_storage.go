@@ -153,7 +153,7 @@ func (r *Receipt) unmarshalStoreRLPFrom(p *fastrlp.Parser, v *fastrlp.Value) err
 	}
 
 	// come TransactionType first if exist
-	if len(elems) == 5 {
+	if elems[0].Type() == fastrlp.TypeBytes {
 		if err = r.TransactionType.unmarshalRLPFrom(p, elems[0]); err != nil {
 			return err
 		}
@@ -183,7 +183,7 @@ func (r *Receipt) unmarshalStoreRLPFrom(p *fastrlp.Parser, v *fastrlp.Value) err
 
 	// tx hash
 	// backwards compatibility, old receipts did not marshal a TxHash
-	if len(elems) == 4 {
+	if len(elems) >= 4 {
 		vv, err = elems[3].Bytes()
 		if err != nil {
 			return err
@@ -192,5 +192,14 @@ func (r *Receipt) unmarshalStoreRLPFrom(p *fastrlp.Parser, v *fastrlp.Value) err
 		r.TxHash = BytesToHash(vv)
 	}
 
+	// revert reason, stored only if present
+	if len(elems) >= 5 {
+		if r.RevertReason, err = elems[4].Bytes(); err != nil {
+			return err
+		}
+
+		r.RevertReason = append([]byte{}, r.RevertReason...)
+	}
+
 	return nil
 }